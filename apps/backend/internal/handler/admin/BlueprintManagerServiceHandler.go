@@ -5,12 +5,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"gorm.io/datatypes"
 	"panelium/backend/internal/db"
 	"panelium/backend/internal/model"
+	"panelium/common/blueprint"
 	"panelium/common/id"
 	"panelium/proto_gen_go"
 	"panelium/proto_gen_go/backend/admin"
 	"panelium/proto_gen_go/backend/admin/adminconnect"
+	"slices"
 )
 
 type BlueprintManagerServiceHandler struct {
@@ -80,6 +83,10 @@ func (h *BlueprintManagerServiceHandler) CreateBlueprint(ctx context.Context, re
 		return connect.NewResponse(&admin.CreateBlueprintResponse{Success: false}), nil
 	}
 
+	if err := ValidateBlueprint(blueprint); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	if blueprint.BID == "" {
 		var err error
 		blueprint.BID, err = id.New()
@@ -108,12 +115,57 @@ func (h *BlueprintManagerServiceHandler) UpdateBlueprint(ctx context.Context, re
 	} else {
 		return connect.NewResponse(&admin.UpdateBlueprintResponse{Success: false}), nil
 	}
+
+	if err := ValidateBlueprint(blueprint); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	if err := dbInst.Model(&model.Blueprint{}).Where("bid = ?", blueprint.BID).Updates(blueprint).Error; err != nil {
 		return nil, err
 	}
+	if err := pruneApprovedRelaxations(blueprint.BID); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&admin.UpdateBlueprintResponse{Success: true}), nil
 }
 
+// pruneApprovedRelaxations drops the approvals of relaxations the blueprint no longer requests, so requesting them
+// again needs a new approval.
+func pruneApprovedRelaxations(bid string) error {
+	var b model.Blueprint
+	if err := db.Instance().Where("bid = ?", bid).First(&b).Error; err != nil {
+		return err
+	}
+
+	var requested, approved []string
+	_ = json.Unmarshal(b.SecurityRelaxations, &requested)
+	_ = json.Unmarshal(b.ApprovedRelaxations, &approved)
+	applied, _ := json.Marshal(blueprint.AppliedRelaxations(requested, approved))
+
+	return db.Instance().Model(&model.Blueprint{}).Where("bid = ?", bid).Update("approved_relaxations", datatypes.JSON(applied)).Error
+}
+
+func (h *BlueprintManagerServiceHandler) ApproveBlueprintRelaxations(ctx context.Context, req *connect.Request[admin.ApproveBlueprintRelaxationsRequest]) (*connect.Response[admin.ApproveBlueprintRelaxationsResponse], error) {
+	dbInst := db.Instance()
+	var b model.Blueprint
+	if err := dbInst.Where("bid = ?", req.Msg.Bid).First(&b).Error; err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("blueprint not found"))
+	}
+
+	var requested []string
+	_ = json.Unmarshal(b.SecurityRelaxations, &requested)
+	for _, relaxation := range req.Msg.Relaxations {
+		if !slices.Contains(requested, relaxation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("security relaxation %q is not requested by the blueprint", relaxation))
+		}
+	}
+
+	approved, _ := json.Marshal(req.Msg.Relaxations)
+	if err := dbInst.Model(&model.Blueprint{}).Where("bid = ?", req.Msg.Bid).Update("approved_relaxations", datatypes.JSON(approved)).Error; err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&admin.ApproveBlueprintRelaxationsResponse{Success: true}), nil
+}
+
 func (h *BlueprintManagerServiceHandler) DeleteBlueprint(ctx context.Context, req *connect.Request[admin.DeleteBlueprintRequest]) (*connect.Response[admin.DeleteBlueprintResponse], error) {
 	dbInst := db.Instance()
 	if err := dbInst.Unscoped().Where("bid = ?", req.Msg.Bid).Delete(&model.Blueprint{}).Error; err != nil {
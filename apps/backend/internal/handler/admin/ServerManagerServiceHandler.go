@@ -4,12 +4,15 @@ import (
 	"connectrpc.com/connect"
 	"context"
 	"fmt"
+	"log"
 	"panelium/backend/internal/db"
 	"panelium/backend/internal/model"
 	"panelium/common/id"
 	"panelium/proto_gen_go"
 	"panelium/proto_gen_go/backend/admin"
 	"panelium/proto_gen_go/backend/admin/adminconnect"
+	"panelium/proto_gen_go/daemon"
+	"slices"
 )
 
 type ServerManagerServiceHandler struct {
@@ -89,6 +92,9 @@ func (h *ServerManagerServiceHandler) CreateServer(ctx context.Context, req *con
 
 	dbInst := db.Instance()
 	server := ServerProtoToModel(req.Msg.Server)
+	if err := ValidateServerVariables(server); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	// Find owner by UID
 	var owner model.User
 	if err := dbInst.Where("uid = ?", req.Msg.Server.OwnerUid).First(&owner).Error; err != nil {
@@ -117,6 +123,9 @@ func (h *ServerManagerServiceHandler) CreateServer(ctx context.Context, req *con
 func (h *ServerManagerServiceHandler) UpdateServer(ctx context.Context, req *connect.Request[admin.UpdateServerRequest]) (*connect.Response[admin.UpdateServerResponse], error) {
 	dbInst := db.Instance()
 	server := ServerProtoToModel(req.Msg.Server)
+	if err := ValidateServerVariables(server); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	// Find owner by UID
 	var owner model.User
 	if err := dbInst.Where("uid = ?", req.Msg.Server.OwnerUid).First(&owner).Error; err != nil {
@@ -132,6 +141,10 @@ func (h *ServerManagerServiceHandler) UpdateServer(ctx context.Context, req *con
 	if err := dbInst.Model(&model.Server{}).Where("sid = ?", server.SID).Updates(server).Error; err != nil {
 		return nil, err
 	}
+	// Updates skips zero values, RESTART_POLICY_NEVER has to be written explicitly
+	if err := dbInst.Model(&model.Server{}).Where("sid = ?", server.SID).Update("restart_policy", server.RestartPolicy).Error; err != nil {
+		return nil, err
+	}
 	// Update users
 	dbInst.Where("server_id = ?", server.ID).Delete(&model.ServerUser{})
 	for _, uid := range req.Msg.Server.Uids {
@@ -150,3 +163,45 @@ func (h *ServerManagerServiceHandler) DeleteServer(ctx context.Context, req *con
 	}
 	return connect.NewResponse(&admin.DeleteServerResponse{Success: true}), nil
 }
+
+func (h *ServerManagerServiceHandler) ListTerminalSessions(ctx context.Context, req *connect.Request[admin.ListTerminalSessionsRequest]) (*connect.Response[admin.ListTerminalSessionsResponse], error) {
+	client, token, err := serverBackendClient(req.Msg.Sid)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := serverTerminalSessions(ctx, client, token, req.Msg.Sid)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admin.ListTerminalSessionsResponse{
+		Sessions: make([]*admin.TerminalSession, 0, len(sessions)),
+	}
+	for _, t := range sessions {
+		resp.Sessions = append(resp.Sessions, TerminalSessionToProto(t))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *ServerManagerServiceHandler) KillTerminalSession(ctx context.Context, req *connect.Request[admin.KillTerminalSessionRequest]) (*connect.Response[admin.KillTerminalSessionResponse], error) {
+	client, token, err := serverBackendClient(req.Msg.Sid)
+	if err != nil {
+		return nil, err
+	}
+	// listed first, so only sessions of the given server can be killed
+	sessions, err := serverTerminalSessions(ctx, client, token, req.Msg.Sid)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(sessions, func(t *daemon.TerminalSession) bool { return t.Id == req.Msg.SessionId }) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("terminal session not found"))
+	}
+
+	killReq := connect.NewRequest(&proto_gen_go.SimpleIDMessage{Id: req.Msg.SessionId})
+	killReq.Header().Add("Authorization", token)
+	if _, err := client.KillTerminalSession(ctx, killReq); err != nil {
+		log.Printf("Failed to kill terminal session on daemon: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to kill terminal session on daemon"))
+	}
+	return connect.NewResponse(&admin.KillTerminalSessionResponse{Success: true}), nil
+}
@@ -2,8 +2,14 @@ package admin
 
 import (
 	"encoding/json"
+	"fmt"
 	"panelium/backend/internal/model"
+	"panelium/common/blueprint"
+	"panelium/proto_gen_go"
 	"panelium/proto_gen_go/backend/admin"
+	"regexp"
+	"slices"
+	"strings"
 )
 
 func BlueprintModelToProto(b *model.Blueprint) *admin.Blueprint {
@@ -16,6 +22,14 @@ func BlueprintModelToProto(b *model.Blueprint) *admin.Blueprint {
 	_ = json.Unmarshal(b.DockerImages, &dockerImages)
 	var blockedFiles []*admin.BlockedFile
 	_ = json.Unmarshal(b.BlockedFiles, &blockedFiles)
+	var ports []*proto_gen_go.BlueprintPort
+	_ = json.Unmarshal(b.Ports, &ports)
+	var variables []*proto_gen_go.BlueprintVariable
+	_ = json.Unmarshal(b.Variables, &variables)
+	var relaxations []string
+	_ = json.Unmarshal(b.SecurityRelaxations, &relaxations)
+	var approved []string
+	_ = json.Unmarshal(b.ApprovedRelaxations, &approved)
 	return &admin.Blueprint{
 		FormatVersion:          uint32(b.FormatVersion),
 		Bid:                    b.BID,
@@ -35,6 +49,13 @@ func BlueprintModelToProto(b *model.Blueprint) *admin.Blueprint {
 		SetupScriptBase64:      b.SetupScriptBase64,
 		SetupDockerImage:       b.SetupDockerImage,
 		SetupScriptInterpreter: b.SetupScriptInterpreter,
+		StartupDonePattern:     b.StartupDonePattern,
+		Ports:                  ports,
+		Variables:              variables,
+		EntrypointShell:        b.EntrypointShell,
+		TerminalShell:          b.TerminalShell,
+		SecurityRelaxations:    relaxations,
+		ApprovedRelaxations:    blueprint.AppliedRelaxations(relaxations, approved),
 	}
 }
 
@@ -45,6 +66,9 @@ func BlueprintProtoToModel(b *admin.Blueprint) *model.Blueprint {
 	flags, _ := json.Marshal(b.Flags)
 	dockerImages, _ := json.Marshal(b.DockerImages)
 	blockedFiles, _ := json.Marshal(b.BlockedFiles)
+	ports, _ := json.Marshal(b.Ports)
+	variables, _ := json.Marshal(b.Variables)
+	relaxations, _ := json.Marshal(b.SecurityRelaxations)
 	return &model.Blueprint{
 		FormatVersion:          uint(b.FormatVersion),
 		BID:                    b.Bid,
@@ -64,5 +88,105 @@ func BlueprintProtoToModel(b *admin.Blueprint) *model.Blueprint {
 		SetupScriptBase64:      b.SetupScriptBase64,
 		SetupDockerImage:       b.SetupDockerImage,
 		SetupScriptInterpreter: b.SetupScriptInterpreter,
+		StartupDonePattern:     b.StartupDonePattern,
+		Ports:                  ports,
+		Variables:              variables,
+		EntrypointShell:        b.EntrypointShell,
+		TerminalShell:          b.TerminalShell,
+		SecurityRelaxations:    relaxations,
 	}
 }
+
+var portNamePattern = regexp.MustCompile("^[A-Za-z0-9_]+$")
+
+// ValidateBlueprint checks the parts of a blueprint that would otherwise only fail once a daemon tries to use them.
+func ValidateBlueprint(b *model.Blueprint) error {
+	if b.StartupDonePattern != "" {
+		if _, err := regexp.Compile(b.StartupDonePattern); err != nil {
+			return fmt.Errorf("invalid startup done pattern: %w", err)
+		}
+	}
+
+	var flags []string
+	if len(b.Flags) > 0 {
+		if err := json.Unmarshal(b.Flags, &flags); err != nil {
+			return fmt.Errorf("invalid flags: %w", err)
+		}
+	}
+	if err := blueprint.ValidateFlags(flags); err != nil {
+		return err
+	}
+
+	var relaxations []string
+	if len(b.SecurityRelaxations) > 0 {
+		if err := json.Unmarshal(b.SecurityRelaxations, &relaxations); err != nil {
+			return fmt.Errorf("invalid security relaxations: %w", err)
+		}
+	}
+	if err := blueprint.ValidateRelaxations(relaxations); err != nil {
+		return err
+	}
+
+	var ports []*proto_gen_go.BlueprintPort
+	if len(b.Ports) > 0 {
+		if err := json.Unmarshal(b.Ports, &ports); err != nil {
+			return fmt.Errorf("invalid ports: %w", err)
+		}
+	}
+
+	names := make(map[string]bool, len(ports))
+	for _, port := range ports {
+		if !portNamePattern.MatchString(port.Name) {
+			return fmt.Errorf("invalid port name %q, only letters, digits and underscores are allowed", port.Name)
+		}
+		if names[strings.ToLower(port.Name)] {
+			return fmt.Errorf("duplicate port name %q", port.Name)
+		}
+		names[strings.ToLower(port.Name)] = true
+
+		if port.Port < 1 || port.Port > 65535 {
+			return fmt.Errorf("port %d of %q is out of range (1-65535)", port.Port, port.Name)
+		}
+		if _, ok := proto_gen_go.PortProtocol_name[int32(port.Protocol)]; !ok {
+			return fmt.Errorf("invalid protocol of port %q", port.Name)
+		}
+	}
+
+	var variables []*proto_gen_go.BlueprintVariable
+	if len(b.Variables) > 0 {
+		if err := json.Unmarshal(b.Variables, &variables); err != nil {
+			return fmt.Errorf("invalid variables: %w", err)
+		}
+	}
+	if err := blueprint.ValidateVariables(variables); err != nil {
+		return err
+	}
+
+	// the daemon provides the reserved keys, their values depend on the server and are only known there
+	lookup := func(key string) (string, bool) {
+		known := blueprint.IsReservedKey(key) || slices.ContainsFunc(variables, func(v *proto_gen_go.BlueprintVariable) bool { return v.EnvKey == key })
+		return "", known
+	}
+
+	if b.EntrypointShell != "" {
+		if strings.TrimSpace(b.StartCommand) == "" {
+			return fmt.Errorf("invalid start command: empty command")
+		}
+		keys, err := blueprint.Keys(b.StartCommand)
+		if err != nil {
+			return fmt.Errorf("invalid start command: %w", err)
+		}
+		for _, key := range keys {
+			if _, ok := lookup(key); !ok {
+				return fmt.Errorf("invalid start command: unknown template key %s", key)
+			}
+		}
+		return nil
+	}
+
+	if _, err := blueprint.SplitCommand(b.StartCommand, lookup); err != nil {
+		return fmt.Errorf("invalid start command: %w", err)
+	}
+
+	return nil
+}
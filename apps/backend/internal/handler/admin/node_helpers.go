@@ -1,8 +1,13 @@
 package admin
 
 import (
+	"connectrpc.com/connect"
+	"fmt"
+	"net/http"
 	"panelium/backend/internal/model"
+	"panelium/common/util"
 	"panelium/proto_gen_go/backend/admin"
+	"panelium/proto_gen_go/daemon/daemonconnect"
 )
 
 func NodeModelToProto(n *model.Node) *admin.Node {
@@ -39,3 +44,14 @@ func NodeProtoToModel(n *admin.Node) *model.Node {
 		MaxStorage: uint(n.MaxStorage),
 	}
 }
+
+// NodeBackendClient returns a client for the backend service of the node's daemon and the token its requests have to
+// carry in the Authorization header.
+func NodeBackendClient(n *model.Node) (daemonconnect.BackendServiceClient, string, error) {
+	if n.EncryptedNodeTokenBase64 == nil || *n.EncryptedNodeTokenBase64 == "" {
+		return nil, "", connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("node %s not properly set up", n.NID))
+	}
+
+	client := daemonconnect.NewBackendServiceClient(http.DefaultClient, fmt.Sprintf("%s://%s:%d", util.IfElse(n.HTTPS, "https", "http"), n.FQDN, n.DaemonPort))
+	return client, *n.EncryptedNodeTokenBase64, nil
+}
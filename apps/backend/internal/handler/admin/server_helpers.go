@@ -1,9 +1,18 @@
 package admin
 
 import (
+	"connectrpc.com/connect"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"panelium/backend/internal/db"
 	"panelium/backend/internal/model"
+	"panelium/common/blueprint"
 	"panelium/proto_gen_go"
 	"panelium/proto_gen_go/backend/admin"
+	"panelium/proto_gen_go/daemon"
+	"panelium/proto_gen_go/daemon/daemonconnect"
 )
 
 func ServerModelToProto(s *model.Server) *admin.Server {
@@ -14,6 +23,8 @@ func ServerModelToProto(s *model.Server) *admin.Server {
 	for i, u := range s.Users {
 		uids[i] = u.User.UID
 	}
+	var variables []*proto_gen_go.ServerVariable
+	_ = json.Unmarshal(s.Variables, &variables)
 	return &admin.Server{
 		Sid:         s.SID,
 		Name:        s.Name,
@@ -27,8 +38,10 @@ func ServerModelToProto(s *model.Server) *admin.Server {
 			Swap:    uint32(s.ResourceLimit.SWAP),
 			Storage: uint32(s.ResourceLimit.Storage),
 		},
-		DockerImage: s.DockerImage,
-		Bid:         s.BID,
+		DockerImage:   s.DockerImage,
+		Bid:           s.BID,
+		RestartPolicy: proto_gen_go.RestartPolicy(s.RestartPolicy),
+		Variables:     variables,
 	}
 }
 
@@ -36,12 +49,15 @@ func ServerProtoToModel(s *admin.Server) *model.Server {
 	if s == nil || s.ResourceLimit == nil {
 		return nil
 	}
+	variables, _ := json.Marshal(s.Variables)
 	return &model.Server{
-		SID:         s.Sid,
-		Name:        s.Name,
-		Description: s.Description,
-		DockerImage: s.DockerImage,
-		BID:         s.Bid,
+		SID:           s.Sid,
+		Name:          s.Name,
+		Description:   s.Description,
+		DockerImage:   s.DockerImage,
+		BID:           s.Bid,
+		RestartPolicy: uint(s.RestartPolicy),
+		Variables:     variables,
 		ResourceLimit: model.ResourceLimit{
 			CPU:     uint(s.ResourceLimit.Cpu),
 			RAM:     uint(s.ResourceLimit.Ram),
@@ -50,3 +66,66 @@ func ServerProtoToModel(s *admin.Server) *model.Server {
 		},
 	}
 }
+
+// ValidateServerVariables checks the variable values of the server against the variables of its blueprint.
+func ValidateServerVariables(s *model.Server) error {
+	var b model.Blueprint
+	if err := db.Instance().Where("bid = ?", s.BID).First(&b).Error; err != nil {
+		return fmt.Errorf("blueprint %s not found", s.BID)
+	}
+
+	var variables []*proto_gen_go.BlueprintVariable
+	_ = json.Unmarshal(b.Variables, &variables)
+	var values []*proto_gen_go.ServerVariable
+	if err := json.Unmarshal(s.Variables, &values); err != nil {
+		return fmt.Errorf("invalid variables: %w", err)
+	}
+
+	if err := blueprint.CheckKnownVariables(variables, values); err != nil {
+		return err
+	}
+	_, err := blueprint.ResolveVariables(variables, values)
+	return err
+}
+
+// serverBackendClient returns a client for the backend service of the daemon the server is on and the token its
+// requests have to carry.
+func serverBackendClient(sid string) (daemonconnect.BackendServiceClient, string, error) {
+	var server model.Server
+	if err := db.Instance().Preload("Node").Where("sid = ?", sid).First(&server).Error; err != nil {
+		return nil, "", connect.NewError(connect.CodeNotFound, fmt.Errorf("server not found"))
+	}
+
+	return NodeBackendClient(&server.Node)
+}
+
+// serverTerminalSessions returns the terminal sessions of the server, the daemon lists the ones of all its servers.
+func serverTerminalSessions(ctx context.Context, client daemonconnect.BackendServiceClient, token string, sid string) ([]*daemon.TerminalSession, error) {
+	listReq := connect.NewRequest(&proto_gen_go.Empty{})
+	listReq.Header().Add("Authorization", token)
+	listRes, err := client.ListTerminalSessions(ctx, listReq)
+	if err != nil {
+		log.Printf("Failed to list terminal sessions on daemon: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list terminal sessions on daemon"))
+	}
+
+	var sessions []*daemon.TerminalSession
+	for _, t := range listRes.Msg.Sessions {
+		if t.ServerId == sid {
+			sessions = append(sessions, t)
+		}
+	}
+	return sessions, nil
+}
+
+func TerminalSessionToProto(t *daemon.TerminalSession) *admin.TerminalSession {
+	return &admin.TerminalSession{
+		Id:           t.Id,
+		Sid:          t.ServerId,
+		OwnerUid:     t.OwnerId,
+		Shell:        t.Shell,
+		Created:      t.Created,
+		LastActivity: t.LastActivity,
+		Attached:     t.Attached,
+	}
+}
@@ -176,6 +176,18 @@ func (s *ClientServiceHandler) NewServer(
 
 	dockerImage := availableDockerImages[0].Image // For now, just use the first available image
 
+	var blueprintVariables []*proto_gen_go.BlueprintVariable
+	_ = json.Unmarshal(blueprint.Variables, &blueprintVariables)
+	if err := checkUserVariables(blueprintVariables, req.Msg.Variables); err != nil {
+		tx.Rollback()
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	variables, err := json.Marshal(req.Msg.Variables)
+	if err != nil {
+		tx.Rollback()
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create server (variables)"))
+	}
+
 	resourceLimit := model.ResourceLimit{
 		CPU:     150,  // 50% CPU
 		RAM:     3072, // 1 GB
@@ -198,6 +210,7 @@ func (s *ClientServiceHandler) NewServer(
 		ResourceLimit: resourceLimit,
 		DockerImage:   dockerImage,
 		BID:           req.Msg.Bid,
+		Variables:     variables,
 	}
 	if err := tx.Create(server).Error; err != nil {
 		tx.Rollback()
@@ -239,6 +252,7 @@ func (s *ClientServiceHandler) NewServer(
 		},
 		DockerImage: dockerImage,
 		Bid:         req.Msg.Bid,
+		Variables:   req.Msg.Variables,
 	})
 
 	if node.EncryptedNodeTokenBase64 == nil || *node.EncryptedNodeTokenBase64 == "" {
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"encoding/json"
+	"fmt"
+	"panelium/backend/internal/db"
+	"panelium/backend/internal/middleware"
+	"panelium/backend/internal/model"
+	"panelium/common/blueprint"
+	"panelium/common/errors"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/backend"
+)
+
+func (s *ClientServiceHandler) GetServerVariables(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[backend.ServerVariables], error) {
+	sessionInfoData := ctx.Value("panelium_session_info")
+	sessionInfo, ok := sessionInfoData.(*middleware.SessionInfo)
+	if !ok || sessionInfo == nil || sessionInfo.SessionID == "" || sessionInfo.UserID == "" {
+		return nil, errors.ConnectInvalidCredentials
+	}
+
+	server, err := accessibleServer(sessionInfo.UserID, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var variables []*proto_gen_go.BlueprintVariable
+	_ = json.Unmarshal(server.Blueprint.Variables, &variables)
+	var values []*proto_gen_go.ServerVariable
+	_ = json.Unmarshal(server.Variables, &values)
+
+	// values made invalid by a blueprint update are shown as they are, so the user can see what to fix
+	current := make(map[string]string, len(values))
+	for _, value := range values {
+		current[value.EnvKey] = value.Value
+	}
+
+	res := &backend.ServerVariables{}
+	for _, variable := range variables {
+		if !variable.UserEditable {
+			continue
+		}
+
+		value, ok := current[variable.EnvKey]
+		if !ok {
+			value = variable.DefaultValue
+		}
+		res.Variables = append(res.Variables, &backend.ServerVariableInfo{
+			Variable: variable,
+			Value:    value,
+		})
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// accessibleServer loads the server together with its blueprint if the user owns it or was given access to it.
+func accessibleServer(uid string, sid string) (*model.Server, error) {
+	var user *model.User
+	tx := db.Instance().First(&user, "uid = ?", uid)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.UserNotFound)
+	}
+
+	var server model.Server
+	tx = db.Instance().Preload("Blueprint").Preload("Users").Where("sid = ?", sid).First(&server)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("server not found"))
+	}
+
+	if server.OwnerID != user.ID {
+		found := false
+		for _, serverUser := range server.Users {
+			if serverUser.UserID == user.ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("user does not have access to this server"))
+		}
+	}
+
+	return &server, nil
+}
+
+// checkUserVariables checks that the values only set user editable variables of the blueprint and are valid.
+func checkUserVariables(variables []*proto_gen_go.BlueprintVariable, values []*proto_gen_go.ServerVariable) error {
+	err := blueprint.CheckKnownVariables(variables, values)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		for _, variable := range variables {
+			if variable.EnvKey == value.EnvKey && !variable.UserEditable {
+				return fmt.Errorf("variable %s cannot be changed", value.EnvKey)
+			}
+		}
+	}
+
+	_, err = blueprint.ResolveVariables(variables, values)
+	return err
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"panelium/proto_gen_go"
+	"testing"
+)
+
+func TestCheckUserVariables(t *testing.T) {
+	variables := []*proto_gen_go.BlueprintVariable{
+		{EnvKey: "MAX_PLAYERS", Type: proto_gen_go.VariableType_VARIABLE_TYPE_INTEGER, DefaultValue: "20", UserEditable: true},
+		{EnvKey: "SERVER_JAR", DefaultValue: "server.jar", UserEditable: false},
+	}
+
+	tests := []struct {
+		name   string
+		values []*proto_gen_go.ServerVariable
+		ok     bool
+	}{
+		{"nothing changed", nil, true},
+		{"editable variable", []*proto_gen_go.ServerVariable{{EnvKey: "MAX_PLAYERS", Value: "10"}}, true},
+		{"not editable variable", []*proto_gen_go.ServerVariable{{EnvKey: "SERVER_JAR", Value: "other.jar"}}, false},
+		{"not editable among editable ones", []*proto_gen_go.ServerVariable{{EnvKey: "MAX_PLAYERS", Value: "10"}, {EnvKey: "SERVER_JAR", Value: "server.jar"}}, false},
+		{"unknown variable", []*proto_gen_go.ServerVariable{{EnvKey: "UNKNOWN", Value: "x"}}, false},
+		{"invalid value", []*proto_gen_go.ServerVariable{{EnvKey: "MAX_PLAYERS", Value: "many"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkUserVariables(variables, tt.values)
+			if (err == nil) != tt.ok {
+				t.Errorf("checkUserVariables() = %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
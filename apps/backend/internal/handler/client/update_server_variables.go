@@ -0,0 +1,68 @@
+package client
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"panelium/backend/internal/db"
+	"panelium/backend/internal/middleware"
+	"panelium/backend/internal/model"
+	"panelium/common/errors"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/backend"
+)
+
+// UpdateServerVariables stores the new values, the daemon picks them up with its next server sync and recreates the
+// server container with them.
+func (s *ClientServiceHandler) UpdateServerVariables(ctx context.Context, req *connect.Request[backend.UpdateServerVariablesRequest]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
+	sessionInfoData := ctx.Value("panelium_session_info")
+	sessionInfo, ok := sessionInfoData.(*middleware.SessionInfo)
+	if !ok || sessionInfo == nil || sessionInfo.SessionID == "" || sessionInfo.UserID == "" {
+		return nil, errors.ConnectInvalidCredentials
+	}
+
+	server, err := accessibleServer(sessionInfo.UserID, req.Msg.Sid)
+	if err != nil {
+		return nil, err
+	}
+
+	var variables []*proto_gen_go.BlueprintVariable
+	_ = json.Unmarshal(server.Blueprint.Variables, &variables)
+
+	err = checkUserVariables(variables, req.Msg.Variables)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var values []*proto_gen_go.ServerVariable
+	_ = json.Unmarshal(server.Variables, &values)
+
+	for _, changed := range req.Msg.Variables {
+		found := false
+		for _, value := range values {
+			if value.EnvKey == changed.EnvKey {
+				value.Value = changed.Value
+				found = true
+				break
+			}
+		}
+		if !found {
+			values = append(values, changed)
+		}
+	}
+
+	valuesJson, err := json.Marshal(values)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update server variables"))
+	}
+
+	tx := db.Instance().Model(&model.Server{}).Where("sid = ?", server.SID).Update("variables", valuesJson)
+	if tx.Error != nil {
+		log.Printf("failed to update variables of server %s: %v\n", server.SID, tx.Error)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update server variables"))
+	}
+
+	return connect.NewResponse(&proto_gen_go.SuccessMessage{Success: true}), nil
+}
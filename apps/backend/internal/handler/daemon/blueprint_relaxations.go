@@ -0,0 +1,27 @@
+package daemon
+
+import (
+	"encoding/json"
+	"panelium/backend/internal/model"
+	"panelium/common/blueprint"
+)
+
+// appliedRelaxations returns the security relaxations the blueprint requests and an admin approved, only these are
+// sent to the daemons.
+func appliedRelaxations(b *model.Blueprint) ([]string, error) {
+	var requested, approved []string
+	if len(b.SecurityRelaxations) > 0 {
+		err := json.Unmarshal(b.SecurityRelaxations, &requested)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(b.ApprovedRelaxations) > 0 {
+		err := json.Unmarshal(b.ApprovedRelaxations, &approved)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return blueprint.AppliedRelaxations(requested, approved), nil
+}
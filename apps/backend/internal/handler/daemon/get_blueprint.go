@@ -67,6 +67,27 @@ func (s *DaemonServiceHandler) GetBlueprint(
 		dockerImagesProto = append(dockerImagesProto, image.Image)
 	}
 
+	var ports []*proto_gen_go.BlueprintPort
+	if len(blueprint.Ports) > 0 {
+		err = json.Unmarshal(blueprint.Ports, &ports)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	var variables []*proto_gen_go.BlueprintVariable
+	if len(blueprint.Variables) > 0 {
+		err = json.Unmarshal(blueprint.Variables, &variables)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	relaxations, err := appliedRelaxations(blueprint)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	blueprintProto := &backend.Blueprint{
 		Bid:                    blueprint.BID,
 		Version:                uint32(blueprint.Version),
@@ -79,6 +100,12 @@ func (s *DaemonServiceHandler) GetBlueprint(
 		SetupScriptBase64:      blueprint.SetupScriptBase64,
 		SetupDockerImage:       blueprint.SetupDockerImage,
 		SetupScriptInterpreter: blueprint.SetupScriptInterpreter,
+		StartupDonePattern:     blueprint.StartupDonePattern,
+		Ports:                  ports,
+		Variables:              variables,
+		EntrypointShell:        blueprint.EntrypointShell,
+		TerminalShell:          blueprint.TerminalShell,
+		SecurityRelaxations:    relaxations,
 	}
 
 	return connect.NewResponse(blueprintProto), nil
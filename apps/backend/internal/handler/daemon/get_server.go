@@ -3,7 +3,9 @@ package daemon
 import (
 	"connectrpc.com/connect"
 	"context"
+	"encoding/json"
 	"errors"
+	"gorm.io/gorm"
 	"panelium/backend/internal/db"
 	"panelium/backend/internal/middleware"
 	"panelium/backend/internal/model"
@@ -28,7 +30,10 @@ func (s *DaemonServiceHandler) GetServer(
 	}
 
 	var server *model.Server
-	tx = db.Instance().First(&server, "sid = ? AND node_id = ?", req.Msg.Id, node.ID)
+	tx = db.Instance().Preload("Owner").Preload("Users.User").Preload("Allocations", func(tx *gorm.DB) *gorm.DB {
+		// ports are bound in the order of the allocations
+		return tx.Order("id")
+	}).First(&server, "sid = ? AND node_id = ?", req.Msg.Id, node.ID)
 	if tx.Error != nil || tx.RowsAffected == 0 {
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("server not found"))
 	}
@@ -50,6 +55,14 @@ func (s *DaemonServiceHandler) GetServer(
 		})
 	}
 
+	var variables []*proto_gen_go.ServerVariable
+	if len(server.Variables) > 0 {
+		err := json.Unmarshal(server.Variables, &variables)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
 	serverProto := backend.Server{
 		Sid:         server.SID,
 		OwnerId:     server.Owner.UID,
@@ -61,8 +74,10 @@ func (s *DaemonServiceHandler) GetServer(
 			Swap:    uint32(server.ResourceLimit.SWAP),
 			Storage: uint32(server.ResourceLimit.Storage),
 		},
-		DockerImage: server.DockerImage,
-		Bid:         server.BID,
+		DockerImage:   server.DockerImage,
+		Bid:           server.BID,
+		RestartPolicy: proto_gen_go.RestartPolicy(server.RestartPolicy),
+		Variables:     variables,
 	}
 
 	return connect.NewResponse(&serverProto), nil
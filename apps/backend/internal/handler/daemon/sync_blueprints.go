@@ -4,7 +4,10 @@ import (
 	"connectrpc.com/connect"
 	"context"
 	"encoding/json"
+	"errors"
+	"log"
 	"panelium/backend/internal/db"
+	"panelium/backend/internal/middleware"
 	"panelium/backend/internal/model"
 	"panelium/proto_gen_go"
 	"panelium/proto_gen_go/backend"
@@ -15,13 +18,12 @@ func (s *DaemonServiceHandler) SyncBlueprints(
 	req *connect.Request[proto_gen_go.Empty],
 	stm *connect.ServerStream[backend.Blueprint],
 ) error {
-	// TODO: bruh
-	//daemonInfoData := ctx.Value("panelium_daemon_info")
-	//daemonInfo, ok := daemonInfoData.(*middleware.DaemonInfo)
-	//if !ok || daemonInfo == nil || daemonInfo.NID == "" {
-	//	log.Printf("invalid daemon info in context: %v - %v", daemonInfoData, daemonInfo)
-	//	return connect.NewError(connect.CodeUnauthenticated, errors.New("invalid node token"))
-	//}
+	daemonInfoData := ctx.Value("panelium_daemon_info")
+	daemonInfo, ok := daemonInfoData.(*middleware.DaemonInfo)
+	if !ok || daemonInfo == nil || daemonInfo.NID == "" {
+		log.Printf("invalid daemon info in context: %v - %v", daemonInfoData, daemonInfo)
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("invalid node token"))
+	}
 
 	var blueprints []*model.Blueprint
 	tx := db.Instance().Find(&blueprints)
@@ -69,6 +71,27 @@ func (s *DaemonServiceHandler) SyncBlueprints(
 			dockerImagesProto = append(dockerImagesProto, image.Image)
 		}
 
+		var ports []*proto_gen_go.BlueprintPort
+		if len(blueprint.Ports) > 0 {
+			err = json.Unmarshal(blueprint.Ports, &ports)
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			}
+		}
+
+		var variables []*proto_gen_go.BlueprintVariable
+		if len(blueprint.Variables) > 0 {
+			err = json.Unmarshal(blueprint.Variables, &variables)
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			}
+		}
+
+		relaxations, err := appliedRelaxations(blueprint)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+
 		blueprintProto := &backend.Blueprint{
 			Bid:                    blueprint.BID,
 			Version:                uint32(blueprint.Version),
@@ -81,6 +104,12 @@ func (s *DaemonServiceHandler) SyncBlueprints(
 			SetupScriptBase64:      blueprint.SetupScriptBase64,
 			SetupDockerImage:       blueprint.SetupDockerImage,
 			SetupScriptInterpreter: blueprint.SetupScriptInterpreter,
+			StartupDonePattern:     blueprint.StartupDonePattern,
+			Ports:                  ports,
+			Variables:              variables,
+			EntrypointShell:        blueprint.EntrypointShell,
+			TerminalShell:          blueprint.TerminalShell,
+			SecurityRelaxations:    relaxations,
 		}
 
 		if err := stm.Send(blueprintProto); err != nil {
@@ -3,7 +3,9 @@ package daemon
 import (
 	"connectrpc.com/connect"
 	"context"
+	"encoding/json"
 	"errors"
+	"gorm.io/gorm"
 	"panelium/backend/internal/db"
 	"panelium/backend/internal/middleware"
 	"panelium/backend/internal/model"
@@ -23,7 +25,10 @@ func (s *DaemonServiceHandler) SyncServers(
 	}
 
 	var node *model.Node
-	tx := db.Instance().First(&node, "nid = ?", daemonInfo.NID)
+	tx := db.Instance().Preload("Servers.Owner").Preload("Servers.Users.User").Preload("Servers.Allocations", func(tx *gorm.DB) *gorm.DB {
+		// ports are bound in the order of the allocations
+		return tx.Order("id")
+	}).First(&node, "nid = ?", daemonInfo.NID)
 	if tx.Error != nil || tx.RowsAffected == 0 {
 		return connect.NewError(connect.CodeNotFound, errors.New("node not found"))
 	}
@@ -48,6 +53,14 @@ func (s *DaemonServiceHandler) SyncServers(
 			})
 		}
 
+		var variables []*proto_gen_go.ServerVariable
+		if len(server.Variables) > 0 {
+			err := json.Unmarshal(server.Variables, &variables)
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			}
+		}
+
 		serverProto := backend.Server{
 			Sid:         server.SID,
 			OwnerId:     server.Owner.UID,
@@ -59,8 +72,10 @@ func (s *DaemonServiceHandler) SyncServers(
 				Swap:    uint32(server.ResourceLimit.SWAP),
 				Storage: uint32(server.ResourceLimit.Storage),
 			},
-			DockerImage: server.DockerImage,
-			Bid:         server.BID,
+			DockerImage:   server.DockerImage,
+			Bid:           server.BID,
+			RestartPolicy: proto_gen_go.RestartPolicy(server.RestartPolicy),
+			Variables:     variables,
 		}
 
 		if err := stm.Send(&serverProto); err != nil {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	"net/http"
 	"panelium/backend/internal/config"
 	"panelium/backend/internal/db"
 	"panelium/backend/internal/model"
@@ -15,44 +16,73 @@ type DaemonInfo struct {
 	NID string
 }
 
-func NewDaemonAuthInterceptor() connect.UnaryInterceptorFunc {
-	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(
-			ctx context.Context,
-			req connect.AnyRequest,
-		) (connect.AnyResponse, error) {
-			log.Printf("HERE")
-			if req.Spec().IsClient {
-				return next(ctx, req)
-			}
-
-			nodeToken := req.Header().Get("Authorization")
-			if nodeToken == "" {
-				log.Printf("missing node token in request header")
-				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing node token"))
-			}
-
-			claims, err := jwt.VerifyJWT(nodeToken, &config.JWTPrivateKeyInstance.PublicKey, jwt.BackendIssuer, jwt.BackendTokenType)
-			if err != nil {
-				log.Printf("failed to verify node token: %v", err)
-				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid node token"))
-			}
-
-			var node *model.Node
-			tx := db.Instance().First(&node, "backend_jti = ?", claims.JTI)
-			if tx.Error != nil || tx.RowsAffected == 0 {
-				log.Printf("error finding node with backend JTI %s: %v", claims.JTI, tx.Error)
-				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("node token not found"))
-			}
-
-			log.Printf("Node found: %s", node.NID)
-
-			ctx = context.WithValue(ctx, "panelium_daemon_info", &DaemonInfo{
-				NID: node.NID,
-			})
+// daemonAuthInterceptor authenticates the node token of unary and streaming daemon requests, SyncBlueprints and
+// SyncServers are streams and would not be covered by a plain connect.UnaryInterceptorFunc.
+type daemonAuthInterceptor struct{}
 
+func NewDaemonAuthInterceptor() connect.Interceptor {
+	return &daemonAuthInterceptor{}
+}
+
+func (i *daemonAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
 			return next(ctx, req)
 		}
+
+		ctx, err := authenticateDaemon(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+
+		return next(ctx, req)
 	}
-	return interceptor
+}
+
+func (i *daemonAuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *daemonAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(
+		ctx context.Context,
+		conn connect.StreamingHandlerConn,
+	) error {
+		ctx, err := authenticateDaemon(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+
+		return next(ctx, conn)
+	}
+}
+
+func authenticateDaemon(ctx context.Context, header http.Header) (context.Context, error) {
+	nodeToken := header.Get("Authorization")
+	if nodeToken == "" {
+		log.Printf("missing node token in request header")
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing node token"))
+	}
+
+	claims, err := jwt.VerifyJWT(nodeToken, &config.JWTPrivateKeyInstance.PublicKey, jwt.BackendIssuer, jwt.BackendTokenType)
+	if err != nil {
+		log.Printf("failed to verify node token: %v", err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid node token"))
+	}
+
+	var node *model.Node
+	tx := db.Instance().First(&node, "backend_jti = ?", claims.JTI)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		log.Printf("error finding node with backend JTI %s: %v", claims.JTI, tx.Error)
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("node token not found"))
+	}
+
+	log.Printf("Node found: %s", node.NID)
+
+	return context.WithValue(ctx, "panelium_daemon_info", &DaemonInfo{
+		NID: node.NID,
+	}), nil
 }
@@ -25,4 +25,11 @@ type Blueprint struct {
 	SetupScriptBase64      string         `gorm:"not null" json:"setup_script_base64"`      // Base64 encoded setup script
 	SetupDockerImage       string         `gorm:"not null" json:"setup_docker_image"`       // Docker image used for server setup, can be different from the runtime images
 	SetupScriptInterpreter string         `gorm:"not null" json:"setup_script_interpreter"` // Interpreter used for the setup script (e.g., bash, sh, python)
+	StartupDonePattern     string         `json:"startup_done_pattern"`                     // Regex matched against the console output to detect that the server has finished starting, e.g., Done \(.*\)!
+	Ports                  datatypes.JSON `gorm:"type:json" json:"ports"`                   // JSON array of named container ports with their protocol, the server's allocations are bound to them in order
+	Variables              datatypes.JSON `gorm:"type:json" json:"variables"`               // JSON array of typed variables with their validation rules, each server stores its own values
+	EntrypointShell        string         `json:"entrypoint_shell"`                         // Shell running the start command with -c, e.g., /bin/sh, empty runs the parsed start command directly
+	TerminalShell          string         `json:"terminal_shell"`                           // Shell of terminal sessions, e.g., /bin/bash, empty uses the first of bash, sh and ash found in the container
+	SecurityRelaxations    datatypes.JSON `gorm:"type:json" json:"security_relaxations"`    // JSON array of requested loosening of the node security profile, e.g., writable_rootfs or cap:NET_RAW
+	ApprovedRelaxations    datatypes.JSON `gorm:"type:json" json:"approved_relaxations"`    // JSON array of the requested relaxations an admin approved, only these are applied
 }
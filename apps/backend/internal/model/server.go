@@ -1,6 +1,9 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
 
 type Server struct {
 	gorm.Model
@@ -17,6 +20,8 @@ type Server struct {
 	DockerImage   string           `gorm:"not null" json:"docker_image"`
 	BID           string           `gorm:"not null;column:bid" json:"bid"`
 	Blueprint     Blueprint        `gorm:"foreignKey:BID;references:BID" json:"blueprint"`
+	RestartPolicy uint             `gorm:"not null;default:0" json:"restart_policy"` // common.RestartPolicy, 0 = never, 1 = on crash, 2 = always
+	Variables     datatypes.JSON   `gorm:"type:json" json:"variables"`               // JSON array of values of the blueprint's variables, missing ones use the default
 }
 
 type ResourceLimit struct {
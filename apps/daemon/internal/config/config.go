@@ -8,7 +8,9 @@ import (
 	"encoding/pem"
 	"errors"
 	"os"
+	"slices"
 	"sync"
+	"time"
 )
 
 const BasePath = "/etc/panelium/daemon"
@@ -137,6 +139,44 @@ func Init() error {
 const DefaultDashboardHost = "https://example.com"
 const DefaultBackendHost = "https://example.com:9090"
 const DefaultDaemonHost = "https://example.com:9000"
+const DefaultStartupTimeout = 600    // seconds
+const DefaultStopGracePeriod = 30    // seconds
+const DefaultStopTermTimeout = 10    // seconds
+const DefaultRestartBackoff = 5      // seconds, doubled for every crash within the crash loop window
+const DefaultRestartBackoffMax = 300 // seconds
+const DefaultCrashLoopMaxCrashes = 5 // crashes within the crash loop window before auto restart gives up
+const DefaultCrashLoopWindow = 600   // seconds
+const DefaultReconcileInterval = 60  // seconds
+const DefaultRestartOnBoot = true
+const DefaultServerSyncInterval = 300 // seconds
+const DefaultRemoveUnknownServers = false
+const DefaultInstallLogDir = BasePath + "/install_logs"
+const DefaultInstallRunsKept = 20
+const DefaultInstallLogMaxSize = 10 // MB
+const DefaultStorageBackend = "watcher"
+const DefaultStorageCheckInterval = 30 // seconds
+const DefaultStorageProjectIdBase = 100000
+const DefaultDiskUsageRescanInterval = 600 // seconds
+const DefaultResourceHistoryDir = BasePath + "/resource_history"
+const DefaultConsoleScrollback = 1000 // lines
+const DefaultConsoleLogDir = BasePath + "/console_logs"
+const DefaultConsoleLogMaxSize = 10   // MB
+const DefaultConsoleLogRetention = 14 // days
+const DefaultConsoleLineRate = 500    // lines per second
+const DefaultConsoleFloodStop = 0     // seconds
+const DefaultCommandRate = 5          // commands per second
+const DefaultCommandBurst = 20
+const DefaultTerminalIdleTimeout = 900 // seconds
+const DefaultTerminalPrivileged = false
+
+var DefaultCapabilities = []string{"CHOWN", "DAC_OVERRIDE", "FOWNER", "FSETID", "KILL", "SETGID", "SETUID", "NET_BIND_SERVICE"}
+
+const DefaultAllowNewPrivileges = false
+const DefaultPidsLimit = 512
+const DefaultWritableRootFilesystem = false
+const DefaultTmpfsSize = 256 // MB
+const DefaultSeccompProfile = ""
+const DefaultAppArmorProfile = ""
 
 // Config values should never be accessed or modified directly as that could lead to race conditions.
 type Config struct {
@@ -146,9 +186,51 @@ type Config struct {
 		Backend   string `json:"backend"`
 		Daemon    string `json:"daemon"` // host for this daemon instance
 	}
+	Servers struct {
+		StartupTimeout          uint32 `json:"startup_timeout"`   // seconds to wait for the startup done pattern before a server is marked online anyway
+		StopGracePeriod         uint32 `json:"stop_grace_period"` // seconds to wait for a server to exit after the stop command before sending SIGTERM
+		StopTermTimeout         uint32 `json:"stop_term_timeout"` // seconds to wait for a server to exit after SIGTERM before sending SIGKILL
+		RestartBackoff          uint32 `json:"restart_backoff"`
+		RestartBackoffMax       uint32 `json:"restart_backoff_max"`
+		CrashLoopMaxCrashes     uint32 `json:"crash_loop_max_crashes"`
+		CrashLoopWindow         uint32 `json:"crash_loop_window"`
+		ReconcileInterval       uint32 `json:"reconcile_interval"`         // seconds between checks of the stored server state against docker
+		RestartOnBoot           *bool  `json:"restart_on_boot"`            // start servers again that were running when the daemon went down, a pointer so a missing key gets the default
+		ServerSyncInterval      uint32 `json:"server_sync_interval"`       // seconds between syncs of the server list from the backend
+		RemoveUnknownServers    bool   `json:"remove_unknown_servers"`     // delete servers the backend no longer knows instead of quarantining them
+		InstallLogDir           string `json:"install_log_dir"`            // directory the setup script output of every install is stored in
+		InstallRunsKept         uint32 `json:"install_runs_kept"`          // install runs kept per server, older runs and their logs are removed
+		InstallLogMaxSize       uint32 `json:"install_log_max_size"`       // MB of setup script output stored per install, the rest is dropped
+		StorageBackend          string `json:"storage_backend"`            // how storage limits are enforced on this node: project_quota (xfs or ext4 project quotas) or watcher
+		StorageCheckInterval    uint32 `json:"storage_check_interval"`     // seconds between storage usage checks, the watcher stops servers above their limit
+		StorageProjectIdBase    uint32 `json:"storage_project_id_base"`    // project quota IDs are this plus the server's database ID
+		DiskUsageRescanInterval uint32 `json:"disk_usage_rescan_interval"` // seconds between full rescans of the disk usage of servers, inotify tracks the changes in between
+		ResourceHistoryDir      string `json:"resource_history_dir"`       // directory the resource usage history of every server is persisted in
+		ConsoleScrollback       uint32 `json:"console_scrollback"`         // lines of console output kept in memory per server and replayed to new console viewers
+		ConsoleLogDir           string `json:"console_log_dir"`            // directory the console output of every server is archived in
+		ConsoleLogMaxSize       uint32 `json:"console_log_max_size"`       // MB of console output per log file, larger files are rotated and compressed
+		ConsoleLogRetention     uint32 `json:"console_log_retention"`      // days console log files are kept
+		ConsoleLineRate         uint32 `json:"console_line_rate"`          // lines of console output per second and server passed on, the rest is suppressed
+		ConsoleFloodStop        uint32 `json:"console_flood_stop"`         // seconds of sustained console flooding after which the server is stopped, 0 never stops it
+		CommandRate             uint32 `json:"command_rate"`               // console and terminal commands per second a user can send to a server
+		CommandBurst            uint32 `json:"command_burst"`              // console and terminal commands a user can send at once before being throttled to the command rate
+		TerminalIdleTimeout     uint32 `json:"terminal_idle_timeout"`      // seconds without input or output after which a terminal session is closed
+		TerminalPrivileged      bool   `json:"terminal_privileged"`        // run terminal sessions as root in privileged mode instead of as the container's user
+	}
+	Security struct {
+		Capabilities           []string `json:"capabilities"`             // capabilities kept by server and setup containers, all others are dropped
+		AllowNewPrivileges     bool     `json:"allow_new_privileges"`     // let container processes gain privileges, e.g. through setuid binaries, instead of setting no-new-privileges
+		PidsLimit              uint32   `json:"pids_limit"`               // processes and threads per container
+		WritableRootFilesystem bool     `json:"writable_root_filesystem"` // keep the root filesystem of server containers writable instead of read-only with a tmpfs at /tmp and /run
+		TmpfsSize              uint32   `json:"tmpfs_size"`               // MB of each tmpfs of server containers with a read-only root filesystem
+		SeccompProfile         string   `json:"seccomp_profile"`          // path of a seccomp profile JSON file, unconfined disables seccomp, empty uses the docker default
+		AppArmorProfile        string   `json:"apparmor_profile"`         // name of a loaded AppArmor profile, empty uses the docker default
+	}
 }
 
 func newConfig() *Config {
+	restartOnBoot := DefaultRestartOnBoot
+
 	return &Config{
 		lock: sync.RWMutex{},
 		Hosts: struct {
@@ -160,6 +242,84 @@ func newConfig() *Config {
 			Backend:   DefaultBackendHost,
 			Daemon:    DefaultDaemonHost,
 		},
+		Servers: struct {
+			StartupTimeout          uint32 `json:"startup_timeout"`
+			StopGracePeriod         uint32 `json:"stop_grace_period"`
+			StopTermTimeout         uint32 `json:"stop_term_timeout"`
+			RestartBackoff          uint32 `json:"restart_backoff"`
+			RestartBackoffMax       uint32 `json:"restart_backoff_max"`
+			CrashLoopMaxCrashes     uint32 `json:"crash_loop_max_crashes"`
+			CrashLoopWindow         uint32 `json:"crash_loop_window"`
+			ReconcileInterval       uint32 `json:"reconcile_interval"`
+			RestartOnBoot           *bool  `json:"restart_on_boot"`
+			ServerSyncInterval      uint32 `json:"server_sync_interval"`
+			RemoveUnknownServers    bool   `json:"remove_unknown_servers"`
+			InstallLogDir           string `json:"install_log_dir"`
+			InstallRunsKept         uint32 `json:"install_runs_kept"`
+			InstallLogMaxSize       uint32 `json:"install_log_max_size"`
+			StorageBackend          string `json:"storage_backend"`
+			StorageCheckInterval    uint32 `json:"storage_check_interval"`
+			StorageProjectIdBase    uint32 `json:"storage_project_id_base"`
+			DiskUsageRescanInterval uint32 `json:"disk_usage_rescan_interval"`
+			ResourceHistoryDir      string `json:"resource_history_dir"`
+			ConsoleScrollback       uint32 `json:"console_scrollback"`
+			ConsoleLogDir           string `json:"console_log_dir"`
+			ConsoleLogMaxSize       uint32 `json:"console_log_max_size"`
+			ConsoleLogRetention     uint32 `json:"console_log_retention"`
+			ConsoleLineRate         uint32 `json:"console_line_rate"`
+			ConsoleFloodStop        uint32 `json:"console_flood_stop"`
+			CommandRate             uint32 `json:"command_rate"`
+			CommandBurst            uint32 `json:"command_burst"`
+			TerminalIdleTimeout     uint32 `json:"terminal_idle_timeout"`
+			TerminalPrivileged      bool   `json:"terminal_privileged"`
+		}{
+			StartupTimeout:          DefaultStartupTimeout,
+			StopGracePeriod:         DefaultStopGracePeriod,
+			StopTermTimeout:         DefaultStopTermTimeout,
+			RestartBackoff:          DefaultRestartBackoff,
+			RestartBackoffMax:       DefaultRestartBackoffMax,
+			CrashLoopMaxCrashes:     DefaultCrashLoopMaxCrashes,
+			CrashLoopWindow:         DefaultCrashLoopWindow,
+			ReconcileInterval:       DefaultReconcileInterval,
+			RestartOnBoot:           &restartOnBoot,
+			ServerSyncInterval:      DefaultServerSyncInterval,
+			RemoveUnknownServers:    DefaultRemoveUnknownServers,
+			InstallLogDir:           DefaultInstallLogDir,
+			InstallRunsKept:         DefaultInstallRunsKept,
+			InstallLogMaxSize:       DefaultInstallLogMaxSize,
+			StorageBackend:          DefaultStorageBackend,
+			StorageCheckInterval:    DefaultStorageCheckInterval,
+			StorageProjectIdBase:    DefaultStorageProjectIdBase,
+			DiskUsageRescanInterval: DefaultDiskUsageRescanInterval,
+			ResourceHistoryDir:      DefaultResourceHistoryDir,
+			ConsoleScrollback:       DefaultConsoleScrollback,
+			ConsoleLogDir:           DefaultConsoleLogDir,
+			ConsoleLogMaxSize:       DefaultConsoleLogMaxSize,
+			ConsoleLogRetention:     DefaultConsoleLogRetention,
+			ConsoleLineRate:         DefaultConsoleLineRate,
+			ConsoleFloodStop:        DefaultConsoleFloodStop,
+			CommandRate:             DefaultCommandRate,
+			CommandBurst:            DefaultCommandBurst,
+			TerminalIdleTimeout:     DefaultTerminalIdleTimeout,
+			TerminalPrivileged:      DefaultTerminalPrivileged,
+		},
+		Security: struct {
+			Capabilities           []string `json:"capabilities"`
+			AllowNewPrivileges     bool     `json:"allow_new_privileges"`
+			PidsLimit              uint32   `json:"pids_limit"`
+			WritableRootFilesystem bool     `json:"writable_root_filesystem"`
+			TmpfsSize              uint32   `json:"tmpfs_size"`
+			SeccompProfile         string   `json:"seccomp_profile"`
+			AppArmorProfile        string   `json:"apparmor_profile"`
+		}{
+			Capabilities:           slices.Clone(DefaultCapabilities),
+			AllowNewPrivileges:     DefaultAllowNewPrivileges,
+			PidsLimit:              DefaultPidsLimit,
+			WritableRootFilesystem: DefaultWritableRootFilesystem,
+			TmpfsSize:              DefaultTmpfsSize,
+			SeccompProfile:         DefaultSeccompProfile,
+			AppArmorProfile:        DefaultAppArmorProfile,
+		},
 	}
 }
 
@@ -198,6 +358,94 @@ func (c *Config) Migrate() error {
 	if c.Hosts.Daemon == "" {
 		c.Hosts.Daemon = DefaultDaemonHost
 	}
+	if c.Servers.StartupTimeout == 0 {
+		c.Servers.StartupTimeout = DefaultStartupTimeout
+	}
+	if c.Servers.StopGracePeriod == 0 {
+		c.Servers.StopGracePeriod = DefaultStopGracePeriod
+	}
+	if c.Servers.StopTermTimeout == 0 {
+		c.Servers.StopTermTimeout = DefaultStopTermTimeout
+	}
+	if c.Servers.RestartBackoff == 0 {
+		c.Servers.RestartBackoff = DefaultRestartBackoff
+	}
+	if c.Servers.RestartBackoffMax == 0 {
+		c.Servers.RestartBackoffMax = DefaultRestartBackoffMax
+	}
+	if c.Servers.CrashLoopMaxCrashes == 0 {
+		c.Servers.CrashLoopMaxCrashes = DefaultCrashLoopMaxCrashes
+	}
+	if c.Servers.CrashLoopWindow == 0 {
+		c.Servers.CrashLoopWindow = DefaultCrashLoopWindow
+	}
+	if c.Servers.ReconcileInterval == 0 {
+		c.Servers.ReconcileInterval = DefaultReconcileInterval
+	}
+	if c.Servers.RestartOnBoot == nil {
+		restartOnBoot := DefaultRestartOnBoot
+		c.Servers.RestartOnBoot = &restartOnBoot
+	}
+	if c.Servers.ServerSyncInterval == 0 {
+		c.Servers.ServerSyncInterval = DefaultServerSyncInterval
+	}
+	if c.Servers.InstallLogDir == "" {
+		c.Servers.InstallLogDir = DefaultInstallLogDir
+	}
+	if c.Servers.InstallRunsKept == 0 {
+		c.Servers.InstallRunsKept = DefaultInstallRunsKept
+	}
+	if c.Servers.InstallLogMaxSize == 0 {
+		c.Servers.InstallLogMaxSize = DefaultInstallLogMaxSize
+	}
+	if c.Servers.StorageBackend == "" {
+		c.Servers.StorageBackend = DefaultStorageBackend
+	}
+	if c.Servers.StorageCheckInterval == 0 {
+		c.Servers.StorageCheckInterval = DefaultStorageCheckInterval
+	}
+	if c.Servers.StorageProjectIdBase == 0 {
+		c.Servers.StorageProjectIdBase = DefaultStorageProjectIdBase
+	}
+	if c.Servers.DiskUsageRescanInterval == 0 {
+		c.Servers.DiskUsageRescanInterval = DefaultDiskUsageRescanInterval
+	}
+	if c.Servers.ResourceHistoryDir == "" {
+		c.Servers.ResourceHistoryDir = DefaultResourceHistoryDir
+	}
+	if c.Servers.ConsoleScrollback == 0 {
+		c.Servers.ConsoleScrollback = DefaultConsoleScrollback
+	}
+	if c.Servers.ConsoleLogDir == "" {
+		c.Servers.ConsoleLogDir = DefaultConsoleLogDir
+	}
+	if c.Servers.ConsoleLogMaxSize == 0 {
+		c.Servers.ConsoleLogMaxSize = DefaultConsoleLogMaxSize
+	}
+	if c.Servers.ConsoleLogRetention == 0 {
+		c.Servers.ConsoleLogRetention = DefaultConsoleLogRetention
+	}
+	if c.Servers.ConsoleLineRate == 0 {
+		c.Servers.ConsoleLineRate = DefaultConsoleLineRate
+	}
+	if c.Servers.CommandRate == 0 {
+		c.Servers.CommandRate = DefaultCommandRate
+	}
+	if c.Servers.CommandBurst == 0 {
+		c.Servers.CommandBurst = DefaultCommandBurst
+	}
+	if c.Servers.TerminalIdleTimeout == 0 {
+		c.Servers.TerminalIdleTimeout = DefaultTerminalIdleTimeout
+	}
+	if c.Security.Capabilities == nil {
+		c.Security.Capabilities = slices.Clone(DefaultCapabilities)
+	}
+	if c.Security.PidsLimit == 0 {
+		c.Security.PidsLimit = DefaultPidsLimit
+	}
+	if c.Security.TmpfsSize == 0 {
+		c.Security.TmpfsSize = DefaultTmpfsSize
+	}
 
 	c.lock.Unlock()
 
@@ -241,6 +489,261 @@ func (c *Config) GetDaemonHost() string {
 	return c.Hosts.Daemon
 }
 
+func (c *Config) GetStartupTimeout() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return time.Duration(c.Servers.StartupTimeout) * time.Second
+}
+
+func (c *Config) GetStopGracePeriod() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return time.Duration(c.Servers.StopGracePeriod) * time.Second
+}
+
+func (c *Config) GetStopTermTimeout() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return time.Duration(c.Servers.StopTermTimeout) * time.Second
+}
+
+func (c *Config) GetRestartBackoff() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return time.Duration(c.Servers.RestartBackoff) * time.Second
+}
+
+func (c *Config) GetRestartBackoffMax() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return time.Duration(c.Servers.RestartBackoffMax) * time.Second
+}
+
+func (c *Config) GetCrashLoopMaxCrashes() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return int(c.Servers.CrashLoopMaxCrashes)
+}
+
+func (c *Config) GetCrashLoopWindow() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return time.Duration(c.Servers.CrashLoopWindow) * time.Second
+}
+
+func (c *Config) GetReconcileInterval() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return time.Duration(c.Servers.ReconcileInterval) * time.Second
+}
+
+func (c *Config) GetRestartOnBoot() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.Servers.RestartOnBoot == nil {
+		return DefaultRestartOnBoot
+	}
+	return *c.Servers.RestartOnBoot
+}
+
+func (c *Config) GetServerSyncInterval() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return time.Duration(c.Servers.ServerSyncInterval) * time.Second
+}
+
+func (c *Config) GetRemoveUnknownServers() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.Servers.RemoveUnknownServers
+}
+
+func (c *Config) GetInstallLogDir() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.Servers.InstallLogDir
+}
+
+func (c *Config) GetInstallRunsKept() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return int(c.Servers.InstallRunsKept)
+}
+
+func (c *Config) GetInstallLogMaxSize() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return int(c.Servers.InstallLogMaxSize)
+}
+
+func (c *Config) GetStorageBackend() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.Servers.StorageBackend
+}
+
+func (c *Config) GetStorageCheckInterval() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return time.Duration(c.Servers.StorageCheckInterval) * time.Second
+}
+
+func (c *Config) GetStorageProjectIdBase() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return int(c.Servers.StorageProjectIdBase)
+}
+
+func (c *Config) GetDiskUsageRescanInterval() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return time.Duration(c.Servers.DiskUsageRescanInterval) * time.Second
+}
+
+func (c *Config) GetResourceHistoryDir() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.Servers.ResourceHistoryDir
+}
+
+func (c *Config) GetConsoleScrollback() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return int(c.Servers.ConsoleScrollback)
+}
+
+func (c *Config) GetConsoleLogDir() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.Servers.ConsoleLogDir
+}
+
+func (c *Config) GetConsoleLogMaxSize() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return int(c.Servers.ConsoleLogMaxSize)
+}
+
+func (c *Config) GetConsoleLogRetention() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return int(c.Servers.ConsoleLogRetention)
+}
+
+func (c *Config) GetConsoleLineRate() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return int(c.Servers.ConsoleLineRate)
+}
+
+func (c *Config) GetConsoleFloodStop() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return time.Duration(c.Servers.ConsoleFloodStop) * time.Second
+}
+
+func (c *Config) GetCommandRate() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return int(c.Servers.CommandRate)
+}
+
+func (c *Config) GetCommandBurst() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return int(c.Servers.CommandBurst)
+}
+
+func (c *Config) GetTerminalIdleTimeout() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return time.Duration(c.Servers.TerminalIdleTimeout) * time.Second
+}
+
+func (c *Config) GetTerminalPrivileged() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.Servers.TerminalPrivileged
+}
+
+func (c *Config) GetCapabilities() []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return slices.Clone(c.Security.Capabilities)
+}
+
+func (c *Config) GetAllowNewPrivileges() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.Security.AllowNewPrivileges
+}
+
+func (c *Config) GetPidsLimit() int64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return int64(c.Security.PidsLimit)
+}
+
+func (c *Config) GetWritableRootFilesystem() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.Security.WritableRootFilesystem
+}
+
+func (c *Config) GetTmpfsSize() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return int(c.Security.TmpfsSize)
+}
+
+func (c *Config) GetSeccompProfile() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.Security.SeccompProfile
+}
+
+func (c *Config) GetAppArmorProfile() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.Security.AppArmorProfile
+}
+
 // TODO: Secrets should be stored in HSM when possible, or at least encrypted with the encryption key being in HSM or similar secure storage.
 
 // Secrets values should never be accessed or modified directly as that could lead to race conditions.
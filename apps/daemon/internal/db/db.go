@@ -37,6 +37,7 @@ func Init() error {
 			&model.Server{},
 			&model.ServerAllocation{},
 			&model.ServerUser{},
+			&model.InstallRun{},
 		); err != nil {
 			return
 		}
@@ -0,0 +1,428 @@
+package diskusage
+
+import (
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// flushInterval is how often the paths changed since the last flush are measured again.
+const flushInterval = 2 * time.Second
+
+// Tracker keeps the disk usage of a directory tree up to date without walking it on every request. A full scan builds
+// the usage, afterwards inotify events mark the changed paths, which are measured again in batches. Periodic rescans
+// correct anything the events miss, e.g. directories not watched because the inotify watch limit was reached.
+type Tracker struct {
+	dir string
+
+	mu          sync.RWMutex
+	files       map[string]int64 // allocated bytes per file by path relative to dir
+	directories map[string]int64 // allocated bytes below every directory, "." is the total
+	lastScan    time.Time
+
+	watcher *watcher // nil if inotify is not available, the usage is then only updated by rescans
+
+	dirtyMu sync.Mutex
+	dirty   map[string]bool
+
+	rescan chan struct{}
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// Entry is the usage of a top-level file or directory.
+type Entry struct {
+	Name        string
+	IsDirectory bool
+	Size        int64
+}
+
+// New scans dir and keeps tracking its usage until Close is called.
+func New(dir string, rescanInterval time.Duration) (*Tracker, error) {
+	t := &Tracker{
+		dir:         dir,
+		files:       make(map[string]int64),
+		directories: make(map[string]int64),
+		dirty:       make(map[string]bool),
+		rescan:      make(chan struct{}, 1),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+
+	w, err := newWatcher(dir)
+	if err != nil {
+		log.Printf("failed to watch %s, its disk usage is only updated by rescans: %v\n", dir, err)
+	} else {
+		t.watcher = w
+	}
+
+	err = t.scan()
+	if err != nil {
+		if t.watcher != nil {
+			t.watcher.close()
+		}
+		return nil, err
+	}
+
+	go t.run(rescanInterval)
+
+	return t, nil
+}
+
+// Close stops tracking the usage.
+func (t *Tracker) Close() {
+	close(t.stop)
+	<-t.done
+}
+
+// Rescan schedules a full rescan, e.g. after a lot of files were changed at once.
+func (t *Tracker) Rescan() {
+	select {
+	case t.rescan <- struct{}{}:
+	default:
+	}
+}
+
+// Usage returns the bytes allocated by everything below the directory.
+func (t *Tracker) Usage() int64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.directories["."]
+}
+
+// Size returns the usage of the file or directory at the path relative to the tracked directory, false if it is not
+// tracked, e.g. because it is a symlink.
+func (t *Tracker) Size(path string) (int64, bool) {
+	path = relative(path)
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if size, ok := t.directories[path]; ok {
+		return size, true
+	}
+	size, ok := t.files[path]
+	return size, ok
+}
+
+// Entries returns the usage of every top-level file and directory, largest first.
+func (t *Tracker) Entries() []Entry {
+	t.mu.RLock()
+	var entries []Entry
+	for path, size := range t.directories {
+		if path != "." && !strings.Contains(path, "/") {
+			entries = append(entries, Entry{Name: path, IsDirectory: true, Size: size})
+		}
+	}
+	for path, size := range t.files {
+		if !strings.Contains(path, "/") {
+			entries = append(entries, Entry{Name: path, Size: size})
+		}
+	}
+	t.mu.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Size != entries[j].Size {
+			return entries[i].Size > entries[j].Size
+		}
+		return entries[i].Name < entries[j].Name
+	})
+
+	return entries
+}
+
+// LastScan returns the time of the last full scan.
+func (t *Tracker) LastScan() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.lastScan
+}
+
+func (t *Tracker) run(rescanInterval time.Duration) {
+	defer close(t.done)
+
+	var events <-chan event
+	if t.watcher != nil {
+		events = t.watcher.events
+		go t.watcher.read()
+		defer t.watcher.close()
+	}
+
+	rescan := time.NewTicker(rescanInterval)
+	defer rescan.Stop()
+	flush := time.NewTicker(flushInterval)
+	defer flush.Stop()
+
+	for {
+		select {
+		case <-t.stop:
+			return
+		case e, ok := <-events:
+			if !ok {
+				log.Printf("stopped watching %s, its disk usage is only updated by rescans\n", t.dir)
+				events = nil
+				continue
+			}
+			if e.overflow {
+				t.Rescan()
+				continue
+			}
+			t.dirtyMu.Lock()
+			t.dirty[e.path] = true
+			t.dirtyMu.Unlock()
+		case <-flush.C:
+			t.flush()
+		case <-rescan.C:
+			t.Rescan()
+		case <-t.rescan:
+			err := t.scan()
+			if err != nil {
+				log.Printf("failed to rescan disk usage of %s: %v\n", t.dir, err)
+			}
+		}
+	}
+}
+
+// scan measures the whole tree and replaces the tracked usage with the result.
+func (t *Tracker) scan() error {
+	// changes made during the scan are measured again on the next flush, measuring a path twice is harmless
+	files := make(map[string]int64)
+	directories := make(map[string]int64)
+
+	err := filepath.WalkDir(t.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && path != t.dir {
+				return nil // removed while walking
+			}
+			return err
+		}
+
+		rel, err := filepath.Rel(t.dir, path)
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			directories[rel] = 0
+			if t.watcher != nil {
+				t.watcher.add(rel)
+			}
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		size := allocated(info)
+		files[rel] = size
+		addToParents(directories, rel, size)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if t.watcher != nil {
+		t.watcher.prune(directories)
+	}
+
+	t.mu.Lock()
+	t.files = files
+	t.directories = directories
+	t.lastScan = time.Now()
+	t.mu.Unlock()
+
+	return nil
+}
+
+// flush measures the paths changed since the last flush.
+func (t *Tracker) flush() {
+	t.dirtyMu.Lock()
+	dirty := t.dirty
+	t.dirty = make(map[string]bool)
+	t.dirtyMu.Unlock()
+
+	if len(dirty) == 0 {
+		return
+	}
+
+	// removals first, a directory moved within the tree has to be removed at its old path before it is added at its
+	// new one, as the watch of the directory is kept by inotify
+	var removed, changed []string
+	for path := range dirty {
+		_, err := os.Lstat(filepath.Join(t.dir, path))
+		if err != nil {
+			removed = append(removed, path)
+		} else {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed) // parents before their children
+
+	for _, path := range removed {
+		t.remove(path)
+	}
+	for _, path := range changed {
+		t.update(path)
+	}
+}
+
+// remove stops tracking the path and everything below it.
+func (t *Tracker) remove(path string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if size, ok := t.files[path]; ok {
+		delete(t.files, path)
+		addToParents(t.directories, path, -size)
+		return
+	}
+
+	size, ok := t.directories[path]
+	if !ok || path == "." {
+		return
+	}
+
+	prefix := path + "/"
+	for p := range t.files {
+		if strings.HasPrefix(p, prefix) {
+			delete(t.files, p)
+		}
+	}
+	for p := range t.directories {
+		if p == path || strings.HasPrefix(p, prefix) {
+			delete(t.directories, p)
+		}
+	}
+	addToParents(t.directories, path, -size)
+
+	if t.watcher != nil {
+		t.watcher.remove(path)
+	}
+}
+
+// update measures the path again, new directories are scanned completely.
+func (t *Tracker) update(path string) {
+	info, err := os.Lstat(filepath.Join(t.dir, path))
+	if err != nil {
+		return // removed since the flush started, the event of the removal follows
+	}
+
+	t.mu.RLock()
+	_, isDirectory := t.directories[path]
+	_, isFile := t.files[path]
+	t.mu.RUnlock()
+
+	if info.IsDir() {
+		if isFile {
+			t.remove(path)
+		}
+		if !isDirectory {
+			t.add(path)
+		}
+		return
+	}
+
+	if isDirectory {
+		t.remove(path)
+	}
+
+	size := allocated(info)
+	t.mu.Lock()
+	old := t.files[path]
+	t.files[path] = size
+	addToParents(t.directories, path, size-old)
+	t.mu.Unlock()
+}
+
+// add scans a directory which was created or moved into the tree.
+func (t *Tracker) add(path string) {
+	files := make(map[string]int64)
+	directories := make(map[string]int64)
+
+	_ = filepath.WalkDir(filepath.Join(t.dir, path), func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil // changed while walking, the events of the changes follow
+		}
+
+		rel, err := filepath.Rel(t.dir, p)
+		if err != nil {
+			return nil
+		}
+
+		if d.IsDir() {
+			directories[rel] = 0
+			if t.watcher != nil {
+				t.watcher.add(rel)
+			}
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		files[rel] = allocated(info)
+
+		return nil
+	})
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for p := range directories {
+		if _, ok := t.directories[p]; !ok {
+			t.directories[p] = 0
+		}
+	}
+	for p, size := range files {
+		old := t.files[p]
+		t.files[p] = size
+		addToParents(t.directories, p, size-old)
+	}
+}
+
+// addToParents adds delta to the usage of every directory containing path.
+func addToParents(directories map[string]int64, path string, delta int64) {
+	if delta == 0 {
+		return
+	}
+	for p := filepath.Dir(path); ; p = filepath.Dir(p) {
+		directories[p] += delta
+		if p == "." {
+			return
+		}
+	}
+}
+
+// allocated returns the bytes allocated on disk for the file, sparse files take up less than their size.
+func allocated(info fs.FileInfo) int64 {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return info.Size()
+	}
+	return stat.Blocks * 512
+}
+
+// relative cleans a path given relative to the tracked directory, with or without a leading slash.
+func relative(path string) string {
+	path = strings.TrimPrefix(filepath.Clean("/"+path), "/")
+	if path == "" {
+		return "."
+	}
+	return path
+}
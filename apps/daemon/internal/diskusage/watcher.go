@@ -0,0 +1,157 @@
+package diskusage
+
+import (
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const watchMask = unix.IN_CREATE | unix.IN_DELETE | unix.IN_MODIFY | unix.IN_CLOSE_WRITE | unix.IN_MOVED_FROM |
+	unix.IN_MOVED_TO | unix.IN_ATTRIB | unix.IN_ONLYDIR | unix.IN_DONT_FOLLOW | unix.IN_EXCL_UNLINK
+
+// event is a changed path relative to the tracked directory, overflow is set if events were dropped.
+type event struct {
+	path     string
+	overflow bool
+}
+
+// watcher reports changes below a directory using inotify, every directory is watched on its own.
+type watcher struct {
+	dir    string
+	file   *os.File
+	events chan event
+
+	mu          sync.Mutex
+	paths       map[int]string // directory by watch descriptor
+	descriptors map[string]int
+	limitLogged bool
+}
+
+func newWatcher(dir string) (*watcher, error) {
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &watcher{
+		dir: dir,
+		// non-blocking so reads go through the runtime poller and are interrupted by close
+		file:        os.NewFile(uintptr(fd), "inotify"),
+		events:      make(chan event, 1024),
+		paths:       make(map[int]string),
+		descriptors: make(map[string]int),
+	}, nil
+}
+
+func (w *watcher) close() {
+	_ = w.file.Close()
+}
+
+// add watches the directory at the path relative to the tracked directory, adding a watched directory again only
+// updates its path, e.g. after it was moved.
+func (w *watcher) add(path string) {
+	wd, err := unix.InotifyAddWatch(int(w.file.Fd()), filepath.Join(w.dir, path), watchMask)
+	if err != nil {
+		if errors.Is(err, unix.ENOSPC) {
+			w.mu.Lock()
+			if !w.limitLogged {
+				log.Printf("reached the inotify watch limit while watching %s, raise fs.inotify.max_user_watches\n", w.dir)
+				w.limitLogged = true
+			}
+			w.mu.Unlock()
+		}
+		return
+	}
+
+	w.mu.Lock()
+	if old, ok := w.paths[wd]; ok && w.descriptors[old] == wd {
+		delete(w.descriptors, old)
+	}
+	w.paths[wd] = path
+	w.descriptors[path] = wd
+	w.mu.Unlock()
+}
+
+// remove stops watching the directory at the path and every directory below it.
+func (w *watcher) remove(path string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	prefix := path + "/"
+	for p, wd := range w.descriptors {
+		if p == path || strings.HasPrefix(p, prefix) {
+			w.removeWatch(p, wd)
+		}
+	}
+}
+
+// prune stops watching every directory which is not in directories.
+func (w *watcher) prune(directories map[string]int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for p, wd := range w.descriptors {
+		if _, ok := directories[p]; !ok {
+			w.removeWatch(p, wd)
+		}
+	}
+}
+
+func (w *watcher) removeWatch(path string, wd int) {
+	_, _ = unix.InotifyRmWatch(int(w.file.Fd()), uint32(wd))
+	delete(w.descriptors, path)
+	delete(w.paths, wd)
+}
+
+// read sends the events until the watcher is closed, the events channel is closed then.
+func (w *watcher) read() {
+	defer close(w.events)
+
+	buf := make([]byte, 64*1024)
+	for {
+		n, err := w.file.Read(buf)
+		if err != nil {
+			if !errors.Is(err, os.ErrClosed) {
+				log.Printf("failed to read inotify events of %s: %v\n", w.dir, err)
+			}
+			return
+		}
+
+		for offset := 0; offset+unix.SizeofInotifyEvent <= n; {
+			raw := (*unix.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameBytes := buf[offset+unix.SizeofInotifyEvent : offset+unix.SizeofInotifyEvent+int(raw.Len)]
+			offset += unix.SizeofInotifyEvent + int(raw.Len)
+
+			if raw.Mask&unix.IN_Q_OVERFLOW != 0 {
+				w.events <- event{overflow: true}
+				continue
+			}
+
+			w.mu.Lock()
+			dir, ok := w.paths[int(raw.Wd)]
+			if raw.Mask&unix.IN_IGNORED != 0 && ok {
+				// the directory was removed, its watch is gone
+				if w.descriptors[dir] == int(raw.Wd) {
+					delete(w.descriptors, dir)
+				}
+				delete(w.paths, int(raw.Wd))
+			}
+			w.mu.Unlock()
+			if !ok || raw.Mask&unix.IN_IGNORED != 0 {
+				continue
+			}
+
+			name := strings.TrimRight(string(nameBytes), "\x00")
+			if name == "" {
+				continue // event of the watched directory itself
+			}
+			w.events <- event{path: filepath.Join(dir, name)}
+		}
+	}
+}
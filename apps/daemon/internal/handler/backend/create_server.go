@@ -35,7 +35,7 @@ func (s *BackendServiceHandler) CreateServer(
 		Storage: req.Msg.ResourceLimit.Storage,
 	}
 
-	_, err := server.CreateServer(req.Msg.Sid, req.Msg.OwnerId, req.Msg.UserIds, allocations, resourceLimit, req.Msg.DockerImage, req.Msg.Bid)
+	_, err := server.CreateServer(req.Msg.Sid, req.Msg.OwnerId, req.Msg.UserIds, allocations, resourceLimit, req.Msg.DockerImage, req.Msg.Bid, req.Msg.RestartPolicy, req.Msg.Variables)
 	if err != nil {
 		log.Printf("Failed to create server: %v", err)
 		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create server"))
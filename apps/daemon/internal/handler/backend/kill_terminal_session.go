@@ -0,0 +1,24 @@
+package backend
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"panelium/daemon/internal/server"
+	"panelium/proto_gen_go"
+)
+
+func (s *BackendServiceHandler) KillTerminalSession(
+	ctx context.Context,
+	req *connect.Request[proto_gen_go.SimpleIDMessage],
+) (*connect.Response[proto_gen_go.SuccessMessage], error) {
+	err := server.KillTerminalSession(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	res := connect.NewResponse(&proto_gen_go.SuccessMessage{
+		Success: true,
+	})
+
+	return res, nil
+}
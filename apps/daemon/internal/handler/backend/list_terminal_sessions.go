@@ -0,0 +1,20 @@
+package backend
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"panelium/daemon/internal/server"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+)
+
+func (s *BackendServiceHandler) ListTerminalSessions(
+	ctx context.Context,
+	req *connect.Request[proto_gen_go.Empty],
+) (*connect.Response[daemon.ListTerminalSessionsResponse], error) {
+	sessions := server.TerminalSessions(func(string, string) bool {
+		return true
+	})
+
+	return connect.NewResponse(&daemon.ListTerminalSessionsResponse{Sessions: sessions}), nil
+}
@@ -47,9 +47,28 @@ func (s *BackendServiceHandler) UpdateServer(
 		}
 	}
 
-	err := server.UpdateServer(req.Msg.Sid, userIds, allocations, resourceLimit, &req.Msg.DockerImage, &req.Msg.Bid)
+	var dockerImage *string = nil
+	if req.Msg.DockerImage != "" {
+		dockerImage = &req.Msg.DockerImage
+	}
+
+	var bid *string = nil
+	if req.Msg.Bid != "" {
+		bid = &req.Msg.Bid
+	}
+
+	var variables *[]*proto_gen_go.ServerVariable = nil
+	if req.Msg.Variables != nil {
+		variables = &req.Msg.Variables
+	}
+
+	err := server.UpdateServer(req.Msg.Sid, userIds, allocations, resourceLimit, dockerImage, bid, &req.Msg.RestartPolicy, variables)
+	var terr *server.TransitionError
+	if errors.As(err, &terr) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, terr)
+	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create server"))
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update server"))
 	}
 
 	res := connect.NewResponse(&proto_gen_go.SuccessMessage{
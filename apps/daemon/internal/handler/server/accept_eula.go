@@ -0,0 +1,47 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"log"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/model"
+	"panelium/daemon/internal/security"
+	"panelium/daemon/internal/server"
+	"panelium/proto_gen_go"
+)
+
+func (s *ServerServiceHandler) AcceptEula(
+	ctx context.Context,
+	req *connect.Request[proto_gen_go.SimpleIDMessage],
+) (*connect.Response[proto_gen_go.SuccessMessage], error) {
+	err := security.CheckServerAccess(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	var srv *model.Server
+	tx := db.Instance().First(&srv, "sid = ?", req.Msg.Id)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("server not found"))
+	}
+
+	err = security.CheckServerOwner(ctx, srv.SID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	err = server.AcceptEula(srv.SID, security.UserID(ctx))
+	if errors.Is(err, server.ErrEulaNotRequired) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		log.Printf("failed to accept eula of server %s: %v\n", srv.SID, err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to accept eula"))
+	}
+
+	return connect.NewResponse(&proto_gen_go.SuccessMessage{
+		Success: true,
+	}), nil
+}
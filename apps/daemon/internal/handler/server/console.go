@@ -18,7 +18,7 @@ func (s *ServerServiceHandler) Console(
 		return connect.NewError(connect.CodeFailedPrecondition, err)
 	}
 
-	err = server.Console(req.Msg.Id, stm)
+	err = server.Console(ctx, req.Msg.Id, stm)
 	if err != nil {
 		return connect.NewError(connect.CodeInternal, err)
 	}
@@ -3,6 +3,7 @@ package server
 import (
 	"connectrpc.com/connect"
 	"context"
+	"errors"
 	"panelium/daemon/internal/security"
 	"panelium/daemon/internal/server"
 	"panelium/proto_gen_go"
@@ -17,6 +18,10 @@ func (s *ServerServiceHandler) ConsoleCommand(
 		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
 	}
 
+	if !security.AllowCommand(security.CommandKey(ctx, req.Peer().Addr, req.Msg.Id)) {
+		return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("too many commands, slow down"))
+	}
+
 	err = server.ConsoleCommand(req.Msg.Id, req.Msg.Text)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
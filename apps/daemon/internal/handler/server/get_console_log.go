@@ -0,0 +1,23 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"panelium/daemon/internal/security"
+	"panelium/daemon/internal/server"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+)
+
+func (s *ServerServiceHandler) GetConsoleLog(
+	ctx context.Context,
+	req *connect.Request[daemon.GetConsoleLogRequest],
+	stm *connect.ServerStream[proto_gen_go.SimpleMessage],
+) error {
+	err := security.CheckServerAccess(ctx, req.Msg.ServerId)
+	if err != nil {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return server.GetConsoleLog(ctx, req.Msg.ServerId, req.Msg.Name, stm)
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/model"
+	"panelium/daemon/internal/security"
+	"panelium/daemon/internal/server"
+	"panelium/proto_gen_go/daemon"
+	"time"
+)
+
+func (s *ServerServiceHandler) GetResourceHistory(
+	ctx context.Context,
+	req *connect.Request[daemon.GetResourceHistoryRequest],
+) (*connect.Response[daemon.ResourceHistory], error) {
+	err := security.CheckServerAccess(ctx, req.Msg.ServerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	var srv *model.Server
+	tx := db.Instance().First(&srv, "sid = ?", req.Msg.ServerId)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("server not found"))
+	}
+
+	if req.Msg.From == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("from is required"))
+	}
+	from := req.Msg.From.AsTime()
+	to := time.Now()
+	if req.Msg.To != nil {
+		to = req.Msg.To.AsTime()
+	}
+	if to.Before(from) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("to is before from"))
+	}
+
+	switch req.Msg.Resolution {
+	case daemon.ResourceHistoryResolution_RESOURCE_HISTORY_RESOLUTION_AUTO,
+		daemon.ResourceHistoryResolution_RESOURCE_HISTORY_RESOLUTION_FIVE_SECONDS,
+		daemon.ResourceHistoryResolution_RESOURCE_HISTORY_RESOLUTION_ONE_MINUTE:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid resolution"))
+	}
+
+	return connect.NewResponse(server.ResourceHistory(srv.SID, from, to, req.Msg.Resolution)), nil
+}
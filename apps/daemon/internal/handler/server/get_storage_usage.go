@@ -0,0 +1,40 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/model"
+	"panelium/daemon/internal/security"
+	"panelium/daemon/internal/server"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+)
+
+func (s *ServerServiceHandler) GetStorageUsage(
+	ctx context.Context,
+	req *connect.Request[proto_gen_go.SimpleIDMessage],
+) (*connect.Response[daemon.StorageUsage], error) {
+	err := security.CheckServerAccess(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	var srv *model.Server
+	tx := db.Instance().First(&srv, "sid = ?", req.Msg.Id)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("server not found"))
+	}
+
+	if !srv.ContainerExists {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("server does not have a container"))
+	}
+
+	usage, err := server.StorageUsage(srv)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to get storage usage"))
+	}
+
+	return connect.NewResponse(usage), nil
+}
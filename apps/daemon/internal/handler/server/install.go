@@ -9,24 +9,39 @@ import (
 	"panelium/daemon/internal/security"
 	"panelium/daemon/internal/server"
 	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
 )
 
 func (s *ServerServiceHandler) Install(
 	ctx context.Context,
-	req *connect.Request[proto_gen_go.SimpleIDMessage],
+	req *connect.Request[daemon.InstallRequest],
 ) (*connect.Response[proto_gen_go.SuccessMessage], error) {
-	err := security.CheckServerAccess(ctx, req.Msg.Id)
+	err := security.CheckServerAccess(ctx, req.Msg.ServerId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
 	}
 
 	var srv *model.Server
-	tx := db.Instance().First(&srv, "sid = ?", req.Msg.Id)
+	tx := db.Instance().First(&srv, "sid = ?", req.Msg.ServerId)
 	if tx.Error != nil || tx.RowsAffected == 0 {
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("server not found"))
 	}
 
-	err = server.Install(srv.SID)
+	if _, ok := daemon.InstallMode_name[int32(req.Msg.Mode)]; !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid install mode"))
+	}
+	if len(req.Msg.Preserve) > 0 && req.Msg.Mode != daemon.InstallMode_INSTALL_MODE_CLEAN {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("preserved paths are only used by a clean install"))
+	}
+
+	err = server.Install(srv.SID, server.InstallOptions{
+		Mode:     req.Msg.Mode,
+		Preserve: req.Msg.Preserve,
+	})
+	var terr *server.TransitionError
+	if errors.As(err, &terr) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, terr)
+	}
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to install server"))
 	}
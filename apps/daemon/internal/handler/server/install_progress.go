@@ -0,0 +1,23 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"panelium/daemon/internal/security"
+	"panelium/daemon/internal/server"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+)
+
+func (s *ServerServiceHandler) InstallProgress(
+	ctx context.Context,
+	req *connect.Request[proto_gen_go.SimpleIDMessage],
+	stm *connect.ServerStream[daemon.InstallProgressEvent],
+) error {
+	err := security.CheckServerAccess(ctx, req.Msg.Id)
+	if err != nil {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return server.InstallProgress(ctx, req.Msg.Id, stm)
+}
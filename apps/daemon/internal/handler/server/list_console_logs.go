@@ -0,0 +1,28 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"panelium/daemon/internal/security"
+	"panelium/daemon/internal/server"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+)
+
+func (s *ServerServiceHandler) ListConsoleLogs(
+	ctx context.Context,
+	req *connect.Request[proto_gen_go.SimpleIDMessage],
+) (*connect.Response[daemon.ListConsoleLogsResponse], error) {
+	err := security.CheckServerAccess(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	logs, err := server.ListConsoleLogs(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list console logs"))
+	}
+
+	return connect.NewResponse(logs), nil
+}
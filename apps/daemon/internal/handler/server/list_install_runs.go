@@ -0,0 +1,42 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"log"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/model"
+	"panelium/daemon/internal/security"
+	"panelium/daemon/internal/server"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+)
+
+func (s *ServerServiceHandler) ListInstallRuns(
+	ctx context.Context,
+	req *connect.Request[proto_gen_go.SimpleIDMessage],
+) (*connect.Response[daemon.ListInstallRunsResponse], error) {
+	err := security.CheckServerAccess(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	var srv *model.Server
+	tx := db.Instance().First(&srv, "sid = ?", req.Msg.Id)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("server not found"))
+	}
+
+	runs, err := server.ListInstallRuns(srv.SID)
+	if err != nil {
+		log.Printf("failed to list install runs: %v\n", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list install runs"))
+	}
+
+	res := connect.NewResponse(&daemon.ListInstallRunsResponse{
+		Runs: runs,
+	})
+
+	return res, nil
+}
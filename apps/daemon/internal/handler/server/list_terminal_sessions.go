@@ -0,0 +1,27 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"panelium/daemon/internal/security"
+	"panelium/daemon/internal/server"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+)
+
+func (s *ServerServiceHandler) ListTerminalSessions(
+	ctx context.Context,
+	req *connect.Request[proto_gen_go.SimpleIDMessage],
+) (*connect.Response[daemon.ListTerminalSessionsResponse], error) {
+	err := security.CheckServerAccess(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	userId := security.UserID(ctx)
+	sessions := server.TerminalSessions(func(sid string, owner string) bool {
+		return sid == req.Msg.Id && owner == userId
+	})
+
+	return connect.NewResponse(&daemon.ListTerminalSessionsResponse{Sessions: sessions}), nil
+}
@@ -42,6 +42,17 @@ func (s *ServerServiceHandler) PowerAction(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid power action"))
 	}
 
+	var terr *server.TransitionError
+	if errors.As(err, &terr) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, terr)
+	}
+	var ferr *server.FlagError
+	if errors.As(err, &ferr) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ferr)
+	}
+	if errors.Is(err, server.ErrStorageLimitExceeded) {
+		return nil, connect.NewError(connect.CodeResourceExhausted, err)
+	}
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to perform power action"))
 	}
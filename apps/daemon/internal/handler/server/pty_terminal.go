@@ -0,0 +1,43 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/model"
+	"panelium/daemon/internal/security"
+	"panelium/daemon/internal/server"
+	"panelium/proto_gen_go/daemon"
+)
+
+func (s *ServerServiceHandler) PtyTerminal(
+	ctx context.Context,
+	stm *connect.BidiStream[daemon.PtyInput, daemon.PtyOutput],
+) error {
+	msg, err := stm.Receive()
+	if err != nil {
+		return err
+	}
+	open := msg.GetOpen()
+	if open == nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("the first message has to open the terminal"))
+	}
+
+	err = security.CheckServerAccess(ctx, open.ServerId)
+	if err != nil {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	var srv *model.Server
+	tx := db.Instance().First(&srv, "sid = ?", open.ServerId)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return connect.NewError(connect.CodeNotFound, errors.New("server not found"))
+	}
+
+	if !srv.ContainerExists {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("server does not have a container"))
+	}
+
+	return server.Pty(ctx, open.ServerId, security.UserID(ctx), open, stm)
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"panelium/daemon/internal/security"
+	"panelium/daemon/internal/server"
+	"panelium/proto_gen_go/daemon"
+	"time"
+)
+
+func (s *ServerServiceHandler) SearchConsoleLogs(
+	ctx context.Context,
+	req *connect.Request[daemon.SearchConsoleLogsRequest],
+	stm *connect.ServerStream[daemon.ConsoleLogLine],
+) error {
+	err := security.CheckServerAccess(ctx, req.Msg.ServerId)
+	if err != nil {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	if req.Msg.From == nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("from is required"))
+	}
+	from := req.Msg.From.AsTime()
+	to := time.Now()
+	if req.Msg.To != nil {
+		to = req.Msg.To.AsTime()
+	}
+	if to.Before(from) {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("to is before from"))
+	}
+
+	return server.SearchConsoleLogs(ctx, req.Msg.ServerId, from, to, req.Msg.Query, req.Msg.Regex, req.Msg.Limit, stm)
+}
@@ -18,7 +18,7 @@ func (s *ServerServiceHandler) Terminal(
 		return connect.NewError(connect.CodeFailedPrecondition, err)
 	}
 
-	err = server.Terminal(req.Msg.Id, stm)
+	err = server.Terminal(ctx, req.Msg.Id, security.UserID(ctx), stm)
 	if err != nil {
 		return connect.NewError(connect.CodeInternal, err)
 	}
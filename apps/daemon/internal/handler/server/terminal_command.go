@@ -3,6 +3,7 @@ package server
 import (
 	"connectrpc.com/connect"
 	"context"
+	"errors"
 	"panelium/daemon/internal/security"
 	"panelium/daemon/internal/server"
 	"panelium/proto_gen_go"
@@ -17,7 +18,11 @@ func (s *ServerServiceHandler) TerminalCommand(
 		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
 	}
 
-	err = server.TerminalCommand(req.Msg.Id, req.Msg.Text)
+	if !security.AllowCommand(security.CommandKey(ctx, req.Peer().Addr, req.Msg.Id)) {
+		return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("too many commands, slow down"))
+	}
+
+	err = server.TerminalCommand(req.Msg.Id, security.UserID(ctx), req.Msg.Text)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -0,0 +1,23 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"panelium/daemon/internal/security"
+	"panelium/daemon/internal/server"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+)
+
+func (s *ServerServiceHandler) WatchStatus(
+	ctx context.Context,
+	req *connect.Request[proto_gen_go.SimpleIDMessage],
+	stm *connect.ServerStream[daemon.ServerStatusEvent],
+) error {
+	err := security.CheckServerAccess(ctx, req.Msg.Id)
+	if err != nil {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return server.WatchStatus(ctx, req.Msg.Id, stm)
+}
@@ -74,6 +74,11 @@ func (s *ServerFilesServiceHandler) CreateDirectory(ctx context.Context, req *co
 		_ = root.Close()
 	}(root)
 
+	err = checkStorage(req.Msg.ServerId, 0)
+	if err != nil {
+		return nil, err
+	}
+
 	err = rootMkdirAll(root, req.Msg.Path, 0755)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
@@ -99,7 +104,13 @@ func (s *ServerFilesServiceHandler) GetDirectorySize(ctx context.Context, req *c
 		_ = root.Close()
 	}(root)
 
-	size, err := rootDirSize(root, req.Msg.Path)
+	// resolved through the root so the path can't point outside of the server's data
+	_, err = root.Stat(req.Msg.Path)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	size, err := trackedSize(root, req.Msg.ServerId, req.Msg.Path)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -110,6 +121,50 @@ func (s *ServerFilesServiceHandler) GetDirectorySize(ctx context.Context, req *c
 
 	return connect.NewResponse(res), nil
 }
+func (s *ServerFilesServiceHandler) GetDiskUsage(ctx context.Context, req *connect.Request[daemon.GetDiskUsageRequest]) (*connect.Response[daemon.GetDiskUsageResponse], error) {
+	err := security.CheckServerAccess(ctx, req.Msg.ServerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	tracker, err := server.DiskUsage(req.Msg.ServerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var entries []*daemon.DiskUsageEntry
+	for _, e := range tracker.Entries() {
+		entries = append(entries, &daemon.DiskUsageEntry{
+			Name:        e.Name,
+			IsDirectory: e.IsDirectory,
+			Size:        e.Size,
+		})
+	}
+
+	res := &daemon.GetDiskUsageResponse{
+		Total:    tracker.Usage(),
+		Entries:  entries,
+		LastScan: timestamppb.New(tracker.LastScan()),
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// trackedSize returns the usage of the path from the server's disk usage tracker, paths it doesn't track, e.g. paths
+// reached through a symlink, are walked instead.
+func trackedSize(root *os.Root, sid string, path string) (int64, error) {
+	tracker, err := server.DiskUsage(sid)
+	if err != nil {
+		return 0, err
+	}
+
+	size, ok := tracker.Size(path)
+	if ok {
+		return size, nil
+	}
+
+	return rootDirSize(root, path)
+}
 
 func rootDirSize(root *os.Root, path string) (int64, error) {
 	dir, err := root.Open(path)
@@ -3,6 +3,7 @@ package server_files
 import (
 	"connectrpc.com/connect"
 	"context"
+	"errors"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"os"
 	"panelium/daemon/internal/security"
@@ -75,6 +76,11 @@ func (s *ServerFilesServiceHandler) WriteFile(ctx context.Context, req *connect.
 		_ = root.Close()
 	}(root)
 
+	err = checkStorage(req.Msg.ServerId, int64(len(req.Msg.Content)))
+	if err != nil {
+		return nil, err
+	}
+
 	file, err := root.OpenFile(req.Msg.Path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
@@ -120,3 +126,16 @@ func (s *ServerFilesServiceHandler) DeleteFile(ctx context.Context, req *connect
 
 	return connect.NewResponse(res), nil
 }
+
+// checkStorage refuses writes which would take the server above its storage limit.
+func checkStorage(sid string, size int64) error {
+	err := server.CheckStorage(sid, size)
+	if errors.Is(err, server.ErrStorageLimitExceeded) {
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	}
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	return nil
+}
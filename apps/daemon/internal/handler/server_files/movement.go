@@ -66,6 +66,18 @@ func (s *ServerFilesServiceHandler) CopyFile(ctx context.Context, req *connect.R
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
+	size := stat.Size()
+	if stat.IsDir() {
+		size, err = trackedSize(root, req.Msg.ServerId, req.Msg.SourcePath)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	err = checkStorage(req.Msg.ServerId, size)
+	if err != nil {
+		return nil, err
+	}
+
 	if stat.IsDir() {
 		err = rootCopyDirectory(root, req.Msg.SourcePath, req.Msg.DestinationPath, false)
 		if err != nil {
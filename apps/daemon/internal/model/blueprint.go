@@ -15,4 +15,10 @@ type Blueprint struct {
 	SetupScriptBase64      string         `gorm:"not null" json:"setup_script_base64"`      // Base64 encoded setup script
 	SetupDockerImage       string         `gorm:"not null" json:"setup_docker_image"`       // Docker image used for server setup, can be different from the runtime images
 	SetupScriptInterpreter string         `gorm:"not null" json:"setup_script_interpreter"` // Interpreter used for the setup script (e.g., bash, sh, python)
+	StartupDonePattern     string         `json:"startup_done_pattern"`                     // Regex matched against the console output to detect that the server has finished starting, empty means online as soon as the container runs
+	Ports                  datatypes.JSON `gorm:"type:json" json:"ports"`                   // JSON array of named container ports, the server's allocations are bound to them in order
+	Variables              datatypes.JSON `gorm:"type:json" json:"variables"`               // JSON array of typed variables, the server's values are passed to the templates and the environment
+	EntrypointShell        string         `json:"entrypoint_shell"`                         // Shell running the start command with -c, empty runs the parsed start command directly
+	TerminalShell          string         `json:"terminal_shell"`                           // Shell of terminal sessions, empty uses the first of bash, sh and ash found in the container
+	SecurityRelaxations    datatypes.JSON `gorm:"type:json" json:"security_relaxations"`    // JSON array of loosening of the node security profile approved by an admin, e.g., writable_rootfs or cap:NET_RAW
 }
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"panelium/proto_gen_go/daemon"
+	"time"
+)
+
+type InstallRun struct {
+	ID               uint               `gorm:"primaryKey" json:"id"`
+	SID              string             `gorm:"index;not null;column:sid" json:"sid"`
+	Mode             daemon.InstallMode `gorm:"not null;default:0" json:"mode"`
+	BID              string             `gorm:"not null;column:bid" json:"bid"`
+	BlueprintVersion uint               `gorm:"not null" json:"blueprint_version"`
+	SetupDockerImage string             `gorm:"not null" json:"setup_docker_image"`
+	DockerImage      string             `gorm:"not null" json:"docker_image"`
+	TimestampStart   time.Time          `gorm:"not null" json:"timestamp_start"`
+	TimestampEnd     time.Time          `gorm:"default:null" json:"timestamp_end,omitempty"` // Zero while the install is running
+	Success          bool               `gorm:"default:false" json:"success"`
+	ExitCode         *int32             `gorm:"default:null" json:"exit_code,omitempty"` // Exit code of the setup script, nil if it did not run to completion
+	Error            string             `json:"error,omitempty"`
+	LogTruncated     bool               `gorm:"default:false" json:"log_truncated"` // Set when the output exceeded the maximum log size
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"gorm.io/datatypes"
+	"panelium/proto_gen_go"
 	"panelium/proto_gen_go/daemon"
 	"time"
 )
@@ -14,12 +16,18 @@ type Server struct {
 	TimestampStart  time.Time                  `gorm:"default:null" json:"timestamp_start,omitempty"`
 	TimestampEnd    time.Time                  `gorm:"default:null" json:"timestamp_end,omitempty"`
 	OfflineReason   daemon.ServerOfflineReason `gorm:"default:null" json:"offline_reason,omitempty"`
+	ExitCode        int32                      `gorm:"default:0" json:"exit_code,omitempty"` // Exit code of the last run that ended on its own
 	Allocations     []ServerAllocation         `gorm:"foreignKey:SID;references:SID" json:"allocations"`
 	ResourceLimit   ResourceLimit              `gorm:"embedded" json:"resource_limit"`
 	DockerImage     string                     `gorm:"not null" json:"docker_image"`
 	BID             string                     `gorm:"not null;column:bid" json:"bid"` // Blueprint ID
 	Blueprint       Blueprint                  `gorm:"foreignKey:BID;references:BID" json:"blueprint"`
 	ContainerExists bool                       `gorm:"default:false" json:"container_exists"` // Indicates if the server container currently exists in Docker
+	RestartPolicy   proto_gen_go.RestartPolicy `gorm:"not null;default:0" json:"restart_policy"`
+	Quarantined     bool                       `gorm:"default:false" json:"quarantined"` // Set when the backend no longer knows the server, it is kept but cannot be started
+	Variables       datatypes.JSON             `gorm:"type:json" json:"variables"`       // JSON array of values of the blueprint's variables, missing ones use the default
+	EulaAcceptedBy  string                     `json:"eula_accepted_by,omitempty"`       // User ID of the owner who accepted the EULA, for blueprints with the eula flag
+	EulaAcceptedAt  time.Time                  `gorm:"default:null" json:"eula_accepted_at,omitempty"`
 }
 
 type ResourceLimit struct {
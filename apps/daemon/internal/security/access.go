@@ -2,6 +2,9 @@ package security
 
 import (
 	"context"
+	"errors"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/model"
 )
 
 // TODO
@@ -30,3 +33,25 @@ func CheckServerAccess(ctx context.Context, serverId string) error {
 
 	return nil
 }
+
+// UserID returns the ID of the authenticated user, empty as long as the user auth middleware does not set it.
+func UserID(ctx context.Context) string {
+	userId, _ := ctx.Value("user_id").(string)
+	return userId
+}
+
+// CheckServerOwner checks that the authenticated user owns the server. Like CheckServerAccess it lets every request
+// through until user authentication is in place.
+func CheckServerOwner(ctx context.Context, serverId string) error {
+	userId := UserID(ctx)
+	if userId == "" {
+		return nil
+	}
+
+	tx := db.Instance().First(&model.Server{}, "sid = ? AND owner_id = ?", serverId, userId)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return errors.New("only the owner of the server can do this")
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package security
+
+import (
+	"context"
+	"net"
+	"panelium/daemon/internal/config"
+	"sync"
+	"time"
+)
+
+// idle buckets are full again, so they are dropped after a while
+const commandBucketIdle = 10 * time.Minute
+
+// commandBucket is a token bucket refilled with the configured commands per second up to the configured burst
+type commandBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+var commandBuckets = struct {
+	sync.Mutex
+	m         map[string]*commandBucket
+	lastPrune time.Time
+}{m: make(map[string]*commandBucket)}
+
+// CommandKey identifies who sends a command to which server: the authenticated user, or the peer address as long as the
+// user auth middleware does not set one.
+func CommandKey(ctx context.Context, peerAddr string, serverId string) string {
+	if userId := UserID(ctx); userId != "" {
+		return "user:" + userId + "/" + serverId
+	}
+
+	host, _, err := net.SplitHostPort(peerAddr)
+	if err != nil {
+		host = peerAddr
+	}
+	return "peer:" + host + "/" + serverId
+}
+
+// AllowCommand reports whether key may send another console or terminal command and takes a token if so.
+func AllowCommand(key string) bool {
+	rate := float64(config.ConfigInstance.GetCommandRate())
+	burst := float64(config.ConfigInstance.GetCommandBurst())
+	now := time.Now()
+
+	commandBuckets.Lock()
+	defer commandBuckets.Unlock()
+
+	if now.Sub(commandBuckets.lastPrune) >= commandBucketIdle {
+		for k, b := range commandBuckets.m {
+			if now.Sub(b.last) >= commandBucketIdle {
+				delete(commandBuckets.m, k)
+			}
+		}
+		commandBuckets.lastPrune = now
+	}
+
+	b, ok := commandBuckets.m[key]
+	if !ok {
+		b = &commandBucket{tokens: burst, last: now}
+		commandBuckets.m[key] = b
+	}
+
+	b.tokens = min(burst, b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,388 @@
+package server
+
+import (
+	"bufio"
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"io"
+	"log"
+	"panelium/daemon/internal/config"
+	"panelium/daemon/internal/docker"
+	"panelium/proto_gen_go"
+	"strings"
+	"sync"
+	"time"
+)
+
+const consoleSubscriberBuffer = 256
+
+// ConsoleLine is a line of console output with the time it was read from the container.
+type ConsoleLine struct {
+	Time time.Time
+	Text string
+}
+
+func (l ConsoleLine) String() string {
+	return fmt.Sprintf("[%s] %s", l.Time.Format(time.TimeOnly), l.Text)
+}
+
+// consoleSession is the single attachment to a server's container. Its output is kept in a scrollback buffer and
+// fanned out to all viewers, commands are written to the same stdin. The session outlives the attachment, so the
+// scrollback and viewers are kept across restarts of the server.
+type consoleSession struct {
+	sid string
+
+	mu          sync.Mutex
+	conn        *types.HijackedResponse // nil while not attached
+	seeded      bool                    // whether the scrollback was filled from the container logs
+	lines       []ConsoleLine           // ring buffer of the last lines, oldest first from start
+	start       int
+	subscribers map[chan ConsoleLine]struct{}
+	startup     *startupWatch // set while a start waits for the startup done pattern
+}
+
+var consoles = struct {
+	sync.Mutex
+	m map[string]*consoleSession
+}{m: make(map[string]*consoleSession)}
+
+func consoleOf(sid string) *consoleSession {
+	consoles.Lock()
+	defer consoles.Unlock()
+
+	c, ok := consoles.m[sid]
+	if !ok {
+		c = &consoleSession{
+			sid:         sid,
+			subscribers: make(map[chan ConsoleLine]struct{}),
+		}
+		consoles.m[sid] = c
+	}
+	return c
+}
+
+// attachConsole attaches to the server's container unless it is attached already. Attaching to a container which
+// isn't running yet works, its output is received once it starts.
+func attachConsole(sid string) (*consoleSession, error) {
+	c := consoleOf(sid)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn != nil {
+		return c, nil
+	}
+
+	if !c.seeded {
+		// the daemon restarted, so the scrollback starts with what docker kept
+		lines, err := containerLogs(sid, config.ConfigInstance.GetConsoleScrollback())
+		if err != nil {
+			log.Printf("failed to read console logs of server %s: %v\n", sid, err)
+		}
+		for _, l := range lines {
+			c.push(l)
+		}
+		c.seeded = true
+	}
+
+	conn, err := docker.Instance().ContainerAttach(context.Background(), fmt.Sprint("server_", sid), container.AttachOptions{
+		Stream: true,
+		Stdin:  true,
+		Stdout: true,
+		Stderr: true,
+		Logs:   false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach to container console: %w", err)
+	}
+	c.conn = &conn
+
+	go c.read(&conn)
+
+	return c, nil
+}
+
+// read receives the output of the attachment until the container stops.
+func (c *consoleSession) read(conn *types.HijackedResponse) {
+	defer conn.Close()
+
+	archive := openConsoleArchive(c.sid)
+	defer archive.close()
+
+	// the ticker emits markers as well, so lines and markers are emitted one at a time
+	var emitMu sync.Mutex
+	emit := func(l ConsoleLine) {
+		emitMu.Lock()
+		defer emitMu.Unlock()
+
+		c.publish(l)
+		archive.write(l)
+	}
+
+	flood := newFloodLimiter()
+	handle := func(r floodResult) {
+		if r.marker != nil {
+			emit(*r.marker)
+		}
+		if r.stop {
+			emit(ConsoleLine{
+				Time: time.Now(),
+				Text: fmt.Sprintf("[Panelium] stopping the server after %v of console flooding", config.ConfigInstance.GetConsoleFloodStop()),
+			})
+			go stopFlooding(c.sid)
+		}
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer close(done)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				handle(flood.tick(now))
+			}
+		}
+	}()
+
+	scanner := bufio.NewScanner(conn.Reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		// timestamped as it is read, the container's tty doesn't give us anything more accurate
+		l := ConsoleLine{
+			Time: time.Now(),
+			Text: strings.TrimSuffix(scanner.Text(), "\r"),
+		}
+		// matched before flood suppression, and a matching line is never suppressed
+		matched := c.matchStartup(l.Text)
+		r := flood.allow(l.Time)
+		handle(r)
+		if r.pass || matched {
+			emit(l)
+		}
+	}
+	if err := scanner.Err(); err != nil && !errors.Is(err, io.EOF) {
+		log.Printf("failed to read console of server %s: %v\n", c.sid, err)
+	}
+
+	c.mu.Lock()
+	if c.conn == conn {
+		c.conn = nil
+	}
+	c.mu.Unlock()
+}
+
+// push adds the line to the scrollback, c.mu has to be locked.
+func (c *consoleSession) push(l ConsoleLine) {
+	size := config.ConfigInstance.GetConsoleScrollback()
+	if len(c.lines) < size {
+		c.lines = append(c.lines, l)
+		return
+	}
+	if size == 0 {
+		return
+	}
+	c.lines[c.start] = l
+	c.start = (c.start + 1) % len(c.lines)
+}
+
+// publish adds the line to the scrollback and sends it to all viewers. It never blocks, viewers that fall too far
+// behind miss lines.
+func (c *consoleSession) publish(l ConsoleLine) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.push(l)
+	for ch := range c.subscribers {
+		select {
+		case ch <- l:
+		default:
+		}
+	}
+}
+
+// subscribe returns the scrollback and a channel receiving the following lines. The returned function has to be called
+// once the viewer is done, it closes the channel.
+func (c *consoleSession) subscribe() ([]ConsoleLine, <-chan ConsoleLine, func()) {
+	ch := make(chan ConsoleLine, consoleSubscriberBuffer)
+
+	c.mu.Lock()
+	scrollback := make([]ConsoleLine, 0, len(c.lines))
+	scrollback = append(scrollback, c.lines[c.start:]...)
+	scrollback = append(scrollback, c.lines[:c.start]...)
+	c.subscribers[ch] = struct{}{}
+	c.mu.Unlock()
+
+	var once sync.Once
+	return scrollback, ch, func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			if _, ok := c.subscribers[ch]; ok {
+				delete(c.subscribers, ch)
+				close(ch)
+			}
+		})
+	}
+}
+
+// write writes a single line to the stdin of the container.
+func (c *consoleSession) write(line string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		return errors.New("console is not attached")
+	}
+
+	_, err := c.conn.Conn.Write([]byte(line + "\n"))
+	return err
+}
+
+// detach closes the attachment, e.g. a stale one of a container which stopped.
+func (c *consoleSession) detach() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn != nil {
+		c.conn.Close()
+		c.conn = nil
+	}
+}
+
+// closeConsole detaches from a deleted server and drops its scrollback.
+func closeConsole(sid string) {
+	consoles.Lock()
+	c, ok := consoles.m[sid]
+	delete(consoles.m, sid)
+	consoles.Unlock()
+	if !ok {
+		return
+	}
+
+	c.detach()
+
+	c.mu.Lock()
+	for ch := range c.subscribers {
+		delete(c.subscribers, ch)
+		close(ch)
+	}
+	if c.startup != nil {
+		c.startup.done <- false
+		c.startup = nil
+	}
+	c.mu.Unlock()
+}
+
+// Console streams the scrollback and then the live output of the server until ctx is done.
+func Console(
+	ctx context.Context,
+	sid string,
+	stm *connect.ServerStream[proto_gen_go.SimpleMessage],
+) error {
+	c, err := attachConsole(sid)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.New("failed to attach to container console"))
+	}
+
+	scrollback, ch, unsubscribe := c.subscribe()
+	defer unsubscribe()
+
+	for _, l := range scrollback {
+		if err := stm.Send(&proto_gen_go.SimpleMessage{Text: l.String()}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case l, ok := <-ch:
+			if !ok {
+				return nil // the server was deleted
+			}
+			if err := stm.Send(&proto_gen_go.SimpleMessage{Text: l.String()}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func ConsoleCommand(sid string, command string) error {
+	if command == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("command cannot be empty"))
+	}
+
+	err := writeConsole(sid, command)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	return nil
+}
+
+// writeConsole writes a single line to the stdin of the server container.
+func writeConsole(sid string, line string) error {
+	c, err := attachConsole(sid)
+	if err != nil {
+		return err
+	}
+
+	err = c.write(line)
+	if err != nil {
+		return fmt.Errorf("failed to write command to container console: %w", err)
+	}
+
+	return nil
+}
+
+// containerLogs returns the last lines the container wrote with the time docker received them.
+func containerLogs(sid string, tail int) ([]ConsoleLine, error) {
+	rc, err := docker.Instance().ContainerLogs(context.Background(), fmt.Sprint("server_", sid), container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Timestamps: true,
+		Tail:       fmt.Sprint(tail),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get container logs: %w", err)
+	}
+	defer func(rc io.ReadCloser) {
+		_ = rc.Close()
+	}(rc)
+
+	scanner := bufio.NewScanner(rc)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	var lines []ConsoleLine
+	for scanner.Scan() {
+		timestamp, text, _ := strings.Cut(scanner.Text(), " ")
+		t, err := time.Parse(time.RFC3339Nano, timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse timestamp: %w", err)
+		}
+
+		lines = append(lines, ConsoleLine{
+			Time: t,
+			Text: strings.TrimSuffix(text, "\r"),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read container logs: %w", err)
+	}
+
+	return lines, nil
+}
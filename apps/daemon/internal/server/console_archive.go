@@ -0,0 +1,494 @@
+package server
+
+import (
+	"bufio"
+	"compress/gzip"
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"log"
+	"os"
+	"panelium/daemon/internal/config"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	consoleLogSearchLimit    = 1000
+	consoleLogSearchMaxLimit = 10000
+)
+
+// console log files are named after the time their first line was written, archived files are compressed
+var consoleLogName = regexp.MustCompile(`^console-(\d+)\.log(\.gz)?$`)
+
+// activeConsoleLogs holds the name of the file currently written per server, it is never compressed or pruned
+var activeConsoleLogs = struct {
+	sync.Mutex
+	m map[string]string
+}{m: make(map[string]string)}
+
+type consoleLogLock struct {
+	sync.Mutex
+	users int
+}
+
+// consoleLogLocks serializes compressing and removing a log file, a closing archive and the prune pass may both get to
+// the same file. Keyed by the path of the uncompressed file.
+var consoleLogLocks = struct {
+	sync.Mutex
+	m map[string]*consoleLogLock
+}{m: make(map[string]*consoleLogLock)}
+
+// lockConsoleLog takes the lock of the log file, compressed or not, and returns the function to release it.
+func lockConsoleLog(path string) func() {
+	path = strings.TrimSuffix(path, ".gz")
+
+	consoleLogLocks.Lock()
+	l, ok := consoleLogLocks.m[path]
+	if !ok {
+		l = &consoleLogLock{}
+		consoleLogLocks.m[path] = l
+	}
+	l.users++
+	consoleLogLocks.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+
+		consoleLogLocks.Lock()
+		l.users--
+		if l.users == 0 {
+			delete(consoleLogLocks.m, path)
+		}
+		consoleLogLocks.Unlock()
+	}
+}
+
+func consoleLogDir(sid string) string {
+	return filepath.Join(config.ConfigInstance.GetConsoleLogDir(), sid)
+}
+
+// consoleArchive writes the console output of one attachment to the server's log files. Failing to write is logged
+// but never affects the console.
+type consoleArchive struct {
+	sid     string
+	file    *os.File
+	written int64
+}
+
+func openConsoleArchive(sid string) *consoleArchive {
+	a := &consoleArchive{sid: sid}
+	a.open(time.Now())
+	return a
+}
+
+func (a *consoleArchive) open(start time.Time) {
+	err := os.MkdirAll(consoleLogDir(a.sid), 0755)
+	if err != nil {
+		log.Printf("failed to create console log directory of server %s: %v\n", a.sid, err)
+		return
+	}
+
+	name := fmt.Sprint("console-", start.UnixMilli(), ".log")
+	a.file, err = os.OpenFile(filepath.Join(consoleLogDir(a.sid), name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		log.Printf("failed to create console log of server %s: %v\n", a.sid, err)
+		return
+	}
+	a.written = 0
+
+	activeConsoleLogs.Lock()
+	activeConsoleLogs.m[a.sid] = name
+	activeConsoleLogs.Unlock()
+}
+
+func (a *consoleArchive) write(l ConsoleLine) {
+	if a.file == nil {
+		return
+	}
+
+	n, err := a.file.WriteString(l.Time.Format(time.RFC3339Nano) + " " + l.Text + "\n")
+	a.written += int64(n)
+	if err != nil {
+		log.Printf("failed to write console log of server %s: %v\n", a.sid, err)
+	}
+
+	if a.written >= int64(config.ConfigInstance.GetConsoleLogMaxSize())*1024*1024 {
+		a.close()
+		a.open(time.Now())
+	}
+}
+
+// close finishes the current file and compresses it.
+func (a *consoleArchive) close() {
+	if a.file == nil {
+		return
+	}
+
+	path := a.file.Name()
+	_ = a.file.Close()
+	a.file = nil
+
+	activeConsoleLogs.Lock()
+	if activeConsoleLogs.m[a.sid] == filepath.Base(path) {
+		delete(activeConsoleLogs.m, a.sid)
+	}
+	activeConsoleLogs.Unlock()
+
+	err := compressConsoleLog(path)
+	if err != nil {
+		log.Printf("failed to compress console log %s: %v\n", path, err)
+	}
+}
+
+// compressConsoleLog replaces the log file with a gzip compressed copy. A file compressed or removed in the meantime is
+// left as it is.
+func compressConsoleLog(path string) error {
+	unlock := lockConsoleLog(path)
+	defer unlock()
+
+	src, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".gz.tmp"
+	dst, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	zw := gzip.NewWriter(dst)
+	_, err = io.Copy(zw, src)
+	err = errors.Join(err, zw.Close(), dst.Close())
+	if err == nil {
+		// keep the time of the last line, it is the end of the file's range
+		err = os.Chtimes(tmp, info.ModTime(), info.ModTime())
+	}
+	if err == nil {
+		err = os.Rename(tmp, path+".gz")
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	return os.Remove(path)
+}
+
+// consoleLogFile is a log file of a server with the range of time it covers
+type consoleLogFile struct {
+	name  string
+	start time.Time
+	end   time.Time
+	size  int64
+}
+
+// consoleLogFiles returns the log files of the server, oldest first.
+func consoleLogFiles(sid string) ([]consoleLogFile, error) {
+	entries, err := os.ReadDir(consoleLogDir(sid))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var files []consoleLogFile
+	for _, e := range entries {
+		m := consoleLogName.FindStringSubmatch(e.Name())
+		if m == nil || e.IsDir() {
+			continue
+		}
+		ms, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue // removed in the meantime
+		}
+
+		files = append(files, consoleLogFile{
+			name:  e.Name(),
+			start: time.UnixMilli(ms),
+			end:   info.ModTime(),
+			size:  info.Size(),
+		})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].start.Before(files[j].start)
+	})
+
+	return files, nil
+}
+
+func ListConsoleLogs(sid string) (*daemon.ListConsoleLogsResponse, error) {
+	files, err := consoleLogFiles(sid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list console logs: %w", err)
+	}
+
+	activeConsoleLogs.Lock()
+	active := activeConsoleLogs.m[sid]
+	activeConsoleLogs.Unlock()
+
+	res := &daemon.ListConsoleLogsResponse{}
+	for i := len(files) - 1; i >= 0; i-- {
+		f := files[i]
+		res.Files = append(res.Files, &daemon.ConsoleLogFile{
+			Name:           f.name,
+			TimestampStart: timestamppb.New(f.start),
+			TimestampEnd:   timestamppb.New(f.end),
+			Size:           f.size,
+			Active:         f.name == active,
+		})
+	}
+
+	return res, nil
+}
+
+// openConsoleLog opens a log file of the server for reading, archived files are decompressed.
+func openConsoleLog(sid string, name string) (io.ReadCloser, error) {
+	if !consoleLogName.MatchString(name) {
+		return nil, os.ErrNotExist
+	}
+
+	f, err := os.Open(filepath.Join(consoleLogDir(sid), name))
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(name, ".gz") {
+		return f, nil
+	}
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{zr, f}, nil
+}
+
+func GetConsoleLog(
+	ctx context.Context,
+	sid string,
+	name string,
+	stm *connect.ServerStream[proto_gen_go.SimpleMessage],
+) error {
+	rc, err := openConsoleLog(sid, name)
+	if errors.Is(err, os.ErrNotExist) {
+		return connect.NewError(connect.CodeNotFound, errors.New("console log not found"))
+	}
+	if err != nil {
+		log.Printf("failed to open console log %s of server %s: %v\n", name, sid, err)
+		return connect.NewError(connect.CodeInternal, errors.New("failed to open console log"))
+	}
+	defer rc.Close()
+
+	scanner := bufio.NewScanner(rc)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err := stm.Send(&proto_gen_go.SimpleMessage{Text: scanner.Text()}); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Printf("failed to read console log %s of server %s: %v\n", name, sid, err)
+		return connect.NewError(connect.CodeInternal, errors.New("failed to read console log"))
+	}
+
+	return nil
+}
+
+// SearchConsoleLogs streams the lines between from and to which contain the query or match it as a regular
+// expression, oldest first, until limit lines were sent.
+func SearchConsoleLogs(
+	ctx context.Context,
+	sid string,
+	from time.Time,
+	to time.Time,
+	query string,
+	regex bool,
+	limit uint32,
+	stm *connect.ServerStream[daemon.ConsoleLogLine],
+) error {
+	match := func(text string) bool {
+		return strings.Contains(text, query)
+	}
+	if regex {
+		re, err := regexp.Compile(query)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid regular expression: %w", err))
+		}
+		match = re.MatchString
+	}
+
+	if limit == 0 {
+		limit = consoleLogSearchLimit
+	}
+	limit = min(limit, consoleLogSearchMaxLimit)
+
+	files, err := consoleLogFiles(sid)
+	if err != nil {
+		log.Printf("failed to list console logs of server %s: %v\n", sid, err)
+		return connect.NewError(connect.CodeInternal, errors.New("failed to list console logs"))
+	}
+
+	var sent uint32
+	for _, f := range files {
+		if f.end.Before(from) || f.start.After(to) {
+			continue
+		}
+
+		done, err := searchConsoleLog(ctx, sid, f.name, from, to, match, limit-sent, stm)
+		sent += done
+		if err != nil {
+			return err
+		}
+		if sent >= limit || ctx.Err() != nil {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func searchConsoleLog(
+	ctx context.Context,
+	sid string,
+	name string,
+	from time.Time,
+	to time.Time,
+	match func(string) bool,
+	limit uint32,
+	stm *connect.ServerStream[daemon.ConsoleLogLine],
+) (uint32, error) {
+	rc, err := openConsoleLog(sid, name)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil // pruned or compressed in the meantime
+	}
+	if err != nil {
+		log.Printf("failed to open console log %s of server %s: %v\n", name, sid, err)
+		return 0, connect.NewError(connect.CodeInternal, errors.New("failed to open console log"))
+	}
+	defer rc.Close()
+
+	var sent uint32
+	scanner := bufio.NewScanner(rc)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() && sent < limit {
+		if ctx.Err() != nil {
+			return sent, nil
+		}
+
+		timestamp, text, _ := strings.Cut(scanner.Text(), " ")
+		t, err := time.Parse(time.RFC3339Nano, timestamp)
+		if err != nil || t.Before(from) || t.After(to) || !match(text) {
+			continue
+		}
+
+		err = stm.Send(&daemon.ConsoleLogLine{
+			Timestamp: timestamppb.New(t),
+			Text:      text,
+			File:      name,
+		})
+		if err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	if err := scanner.Err(); err != nil {
+		log.Printf("failed to read console log %s of server %s: %v\n", name, sid, err)
+		return sent, connect.NewError(connect.CodeInternal, errors.New("failed to read console log"))
+	}
+
+	return sent, nil
+}
+
+// PruneConsoleLogs removes log files older than the retention and compresses files left behind uncompressed, e.g.
+// when the daemon was stopped while writing them.
+func PruneConsoleLogs() {
+	dirs, err := os.ReadDir(config.ConfigInstance.GetConsoleLogDir())
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Printf("failed to read console log directory: %v\n", err)
+		}
+		return
+	}
+
+	retention := time.Duration(config.ConfigInstance.GetConsoleLogRetention()) * 24 * time.Hour
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		sid := d.Name()
+
+		files, err := consoleLogFiles(sid)
+		if err != nil {
+			log.Printf("failed to list console logs of server %s: %v\n", sid, err)
+			continue
+		}
+
+		activeConsoleLogs.Lock()
+		active := activeConsoleLogs.m[sid]
+		activeConsoleLogs.Unlock()
+
+		for _, f := range files {
+			if f.name == active {
+				continue
+			}
+
+			path := filepath.Join(consoleLogDir(sid), f.name)
+			if time.Since(f.end) > retention {
+				unlock := lockConsoleLog(path)
+				err = os.Remove(path)
+				unlock()
+				if err != nil && !errors.Is(err, os.ErrNotExist) {
+					log.Printf("failed to remove console log %s: %v\n", path, err)
+				}
+				continue
+			}
+
+			if !strings.HasSuffix(f.name, ".gz") {
+				err = compressConsoleLog(path)
+				if err != nil {
+					log.Printf("failed to compress console log %s: %v\n", path, err)
+				}
+			}
+		}
+	}
+}
+
+// deleteConsoleLogs removes the log files of a deleted server.
+func deleteConsoleLogs(sid string) error {
+	return os.RemoveAll(consoleLogDir(sid))
+}
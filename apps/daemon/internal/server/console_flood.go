@@ -0,0 +1,104 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"panelium/daemon/internal/config"
+	"sync"
+	"time"
+)
+
+// floodLimiter limits the console output of a server to the configured lines per second. Lines above the limit are
+// dropped and summarized with a marker line once their second is over.
+type floodLimiter struct {
+	mu            sync.Mutex
+	window        time.Time // start of the current second
+	lines         int       // lines read in the current second
+	suppressed    int       // lines dropped in the current second
+	floodingSince time.Time // start of the first second of the ongoing flood, zero if there is none
+	stopped       bool      // whether the server was stopped for the ongoing flood
+}
+
+// floodResult tells the reader what to do with a line, marker is set if lines were suppressed in the last second.
+type floodResult struct {
+	pass   bool
+	marker *ConsoleLine
+	stop   bool // the server flooded its console for longer than allowed
+}
+
+func newFloodLimiter() *floodLimiter {
+	return &floodLimiter{window: time.Now()}
+}
+
+// allow counts a line read at now.
+func (l *floodLimiter) allow(now time.Time) floodResult {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var r floodResult
+	if now.Sub(l.window) >= time.Second {
+		r = l.roll(now)
+	}
+
+	l.lines++
+	if l.lines > config.ConfigInstance.GetConsoleLineRate() {
+		l.suppressed++
+	} else {
+		r.pass = true
+	}
+
+	return r
+}
+
+// tick ends the current second if it is over, so the marker is shown even when the server falls silent.
+func (l *floodLimiter) tick(now time.Time) floodResult {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.window) < time.Second {
+		return floodResult{}
+	}
+	return l.roll(now)
+}
+
+// roll starts a new second, l.mu has to be locked.
+func (l *floodLimiter) roll(now time.Time) floodResult {
+	var r floodResult
+
+	if l.suppressed > 0 {
+		r.marker = &ConsoleLine{
+			Time: now,
+			Text: fmt.Sprintf("[Panelium] %d lines suppressed, the server printed more than %d lines per second", l.suppressed, config.ConfigInstance.GetConsoleLineRate()),
+		}
+
+		if l.floodingSince.IsZero() {
+			l.floodingSince = l.window
+		}
+		stopAfter := config.ConfigInstance.GetConsoleFloodStop()
+		if stopAfter > 0 && !l.stopped && now.Sub(l.floodingSince) >= stopAfter {
+			r.stop = true
+			l.stopped = true
+		}
+	} else {
+		l.floodingSince = time.Time{}
+		l.stopped = false
+	}
+
+	l.window = now
+	l.lines = 0
+	l.suppressed = 0
+
+	return r
+}
+
+// stopFlooding stops a server which flooded its console for longer than allowed.
+func stopFlooding(sid string) {
+	log.Printf("stopping server %s as it flooded its console for %v\n", sid, config.ConfigInstance.GetConsoleFloodStop())
+
+	err := Stop(sid, false)
+	var terr *TransitionError
+	if err != nil && !errors.As(err, &terr) {
+		log.Printf("failed to stop server %s flooding its console: %v\n", sid, err)
+	}
+}
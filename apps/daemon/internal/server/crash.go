@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/docker/api/types/container"
+	"log"
+	"panelium/common/util"
+	"panelium/daemon/internal/config"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/docker"
+	"panelium/daemon/internal/model"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+	"slices"
+	"sync"
+	"time"
+)
+
+// crashes holds the recent automatic restarts per server, used for backoff and crash loop detection
+var crashes = struct {
+	sync.Mutex
+	m map[string][]time.Time
+}{m: make(map[string][]time.Time)}
+
+// watchers counts the running exit watchers per server
+var watchers = struct {
+	sync.Mutex
+	m map[string]int
+}{m: make(map[string]int)}
+
+// startWatchExit registers an exit watcher for the server before spawning it, so it is visible to the reconciler right away.
+func startWatchExit(sid string) {
+	watchers.Lock()
+	watchers.m[sid]++
+	watchers.Unlock()
+
+	go func() {
+		defer func() {
+			watchers.Lock()
+			watchers.m[sid]--
+			if watchers.m[sid] <= 0 {
+				delete(watchers.m, sid)
+			}
+			watchers.Unlock()
+		}()
+
+		watchExit(sid)
+	}()
+}
+
+func isWatched(sid string) bool {
+	watchers.Lock()
+	defer watchers.Unlock()
+
+	return watchers.m[sid] > 0
+}
+
+// watchExit waits for the current run of the server container to end. Exits that were not requested through Stop or
+// Restart mark the server offline with the exit code and apply the server's restart policy.
+func watchExit(sid string) {
+	statusCh, errCh := docker.Instance().ContainerWait(context.Background(), fmt.Sprint("server_", sid), container.WaitConditionNotRunning)
+
+	var exitCode int64
+	select {
+	case err := <-errCh:
+		if err != nil {
+			log.Printf("error waiting for server container %s to exit: %v\n", sid, err)
+		}
+		return
+	case status := <-statusCh:
+		exitCode = status.StatusCode
+	}
+
+	// only servers that are starting or online exited unexpectedly, everything else is handled by whoever changed the status
+	ok, err := setStatus(sid, []daemon.ServerStatusType{
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_STARTING,
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE,
+	}, model.Server{
+		Status:        daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
+		OfflineReason: util.IfElse(exitCode != 0, daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_ERROR, daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_STOPPED),
+		ExitCode:      int32(exitCode),
+		TimestampEnd:  time.Now(),
+	})
+	if err != nil {
+		log.Printf("failed to update server status after unexpected exit: %v\n", err)
+		return
+	}
+	if !ok {
+		return
+	}
+
+	log.Printf("server %s exited unexpectedly with exit code %d\n", sid, exitCode)
+
+	var s model.Server
+	tx := db.Instance().First(&s, "sid = ?", sid)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		log.Printf("err: %v\n", tx.Error)
+		return
+	}
+
+	switch s.RestartPolicy {
+	case proto_gen_go.RestartPolicy_RESTART_POLICY_ALWAYS:
+	case proto_gen_go.RestartPolicy_RESTART_POLICY_ON_CRASH:
+		if exitCode == 0 {
+			return
+		}
+	default:
+		return
+	}
+
+	count := recordCrash(sid)
+	if count > config.ConfigInstance.GetCrashLoopMaxCrashes() {
+		log.Printf("server %s exited %d times within the crash loop window, giving up on restarting it\n", sid, count)
+		return
+	}
+
+	backoff := restartBackoff(count)
+	log.Printf("restarting server %s in %s\n", sid, backoff)
+	time.Sleep(backoff)
+
+	// the server might have been started, reinstalled or deleted in the meantime
+	var current model.Server
+	tx = db.Instance().First(&current, "sid = ?", sid)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return
+	}
+	if current.Status != daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE || !current.TimestampEnd.Equal(s.TimestampEnd) {
+		return
+	}
+
+	err = Start(sid)
+	if err != nil {
+		log.Printf("failed to restart server %s after unexpected exit: %v\n", sid, err)
+	}
+}
+
+// restartBackoff returns the delay before the restart after the given number of recent crashes, the restart backoff
+// doubled for every crash but the first, up to the maximum. It stops doubling at the maximum, so it can't overflow.
+func restartBackoff(count int) time.Duration {
+	backoff := config.ConfigInstance.GetRestartBackoff()
+	backoffMax := config.ConfigInstance.GetRestartBackoffMax()
+	for i := 1; i < count && backoff < backoffMax; i++ {
+		backoff *= 2
+	}
+	return min(backoff, backoffMax)
+}
+
+// recordCrash records an automatic restart of the server and returns how many happened within the crash loop window.
+func recordCrash(sid string) int {
+	crashes.Lock()
+	defer crashes.Unlock()
+
+	now := time.Now()
+	window := config.ConfigInstance.GetCrashLoopWindow()
+	recent := slices.DeleteFunc(crashes.m[sid], func(t time.Time) bool {
+		return now.Sub(t) > window
+	})
+	recent = append(recent, now)
+	crashes.m[sid] = recent
+
+	return len(recent)
+}
@@ -7,11 +7,12 @@ import (
 	"panelium/daemon/internal/db"
 	"panelium/daemon/internal/model"
 	"panelium/daemon/internal/sync"
+	"panelium/proto_gen_go"
 	"panelium/proto_gen_go/daemon"
 	"slices"
 )
 
-func CreateServer(sid string, ownerId string, userIds []string, allocations []model.ServerAllocation, resourceLimit model.ResourceLimit, dockerImage string, bid string) (*model.Server, error) {
+func CreateServer(sid string, ownerId string, userIds []string, allocations []model.ServerAllocation, resourceLimit model.ResourceLimit, dockerImage string, bid string, restartPolicy proto_gen_go.RestartPolicy, variables []*proto_gen_go.ServerVariable) (*model.Server, error) {
 	err := sync.SyncBlueprints()
 	if err != nil {
 		log.Printf("failed to sync blueprints: %v", err)
@@ -33,13 +34,24 @@ func CreateServer(sid string, ownerId string, userIds []string, allocations []mo
 		return nil, fmt.Errorf("docker image %s is not allowed by the blueprint %s", dockerImage, bid)
 	}
 
+	variablesJson, err := json.Marshal(variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode variables: %w", err)
+	}
+
+	unlock := lockServer(sid)
+	defer unlock()
+
+	// created offline so the install below is a regular transition, nothing can happen in between as the lock is held
 	server := model.Server{
 		SID:           sid,
 		OwnerID:       ownerId,
-		Status:        daemon.ServerStatusType_SERVER_STATUS_TYPE_INSTALLING,
+		Status:        daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
 		ResourceLimit: resourceLimit,
 		DockerImage:   dockerImage,
 		BID:           bid,
+		RestartPolicy: restartPolicy,
+		Variables:     variablesJson,
 	}
 	tx = db.Instance().Create(&server)
 	if tx.Error != nil || tx.RowsAffected == 0 {
@@ -74,8 +86,13 @@ func CreateServer(sid string, ownerId string, userIds []string, allocations []mo
 		}
 	}
 
+	ctx, err := beginInstall(server.SID)
+	if err != nil {
+		return nil, err
+	}
+
 	go func() {
-		err := Install(server.SID)
+		err := runInstall(ctx, server.SID, InstallOptions{})
 		if err != nil {
 			log.Printf("failed to install server %s: %v\n", server.SID, err)
 			return
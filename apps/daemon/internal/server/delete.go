@@ -11,7 +11,16 @@ import (
 	"panelium/daemon/internal/model"
 )
 
+// DeleteServer removes the server with its container, volume and network. A running install is cancelled first.
 func DeleteServer(sid string, force bool) error {
+	unlock := lockServer(sid)
+	defer unlock()
+
+	cancelInstall(sid)
+
+	var s model.Server
+	found := db.Instance().First(&s, "sid = ?", sid).RowsAffected > 0
+
 	var dbErr error
 	tx := db.Instance().Delete(&model.Server{}, "sid = ?", sid)
 	if tx.Error != nil {
@@ -25,6 +34,18 @@ func DeleteServer(sid string, force bool) error {
 	if crErr != nil {
 		log.Printf("failed to remove server container %s: %v\n", sid, crErr)
 	}
+	if found {
+		vol, err := docker.Instance().VolumeInspect(context.Background(), fmt.Sprint("server_", sid))
+		if err == nil {
+			err = releaseStorageLimit(&s, vol.Mountpoint)
+		}
+		if err != nil {
+			log.Printf("failed to release storage limit of server %s: %v\n", sid, err)
+		}
+	}
+	stopDiskUsage(sid)
+	closeConsole(sid)
+	closeTerminalSessions(sid)
 	volErr := docker.Instance().VolumeRemove(context.Background(), fmt.Sprint("server_", sid), force)
 	if volErr != nil {
 		log.Printf("failed to remove server volume %s: %v\n", sid, volErr)
@@ -34,8 +55,23 @@ func DeleteServer(sid string, force bool) error {
 		log.Printf("failed to remove server network %s: %v\n", sid, netErr)
 	}
 
-	if dbErr != nil || crErr != nil || volErr != nil || netErr != nil {
-		return errors.Join(errors.New("failed to delete server completely"), errors.Join(dbErr, crErr, volErr, netErr))
+	runErr := deleteInstallRuns(sid)
+	if runErr != nil {
+		log.Printf("failed to delete install runs of server %s: %v\n", sid, runErr)
+	}
+
+	historyErr := deleteResourceHistory(sid)
+	if historyErr != nil {
+		log.Printf("failed to delete resource history of server %s: %v\n", sid, historyErr)
+	}
+
+	consoleErr := deleteConsoleLogs(sid)
+	if consoleErr != nil {
+		log.Printf("failed to delete console logs of server %s: %v\n", sid, consoleErr)
+	}
+
+	if dbErr != nil || crErr != nil || volErr != nil || netErr != nil || runErr != nil || historyErr != nil || consoleErr != nil {
+		return errors.Join(errors.New("failed to delete server completely"), errors.Join(dbErr, crErr, volErr, netErr, runErr, historyErr, consoleErr))
 	}
 
 	return nil
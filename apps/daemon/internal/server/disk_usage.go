@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"panelium/daemon/internal/config"
+	"panelium/daemon/internal/diskusage"
+	"panelium/daemon/internal/docker"
+	"sync"
+)
+
+type diskUsageTracker struct {
+	ready   chan struct{} // closed once the initial scan finished
+	tracker *diskusage.Tracker
+	err     error
+}
+
+// diskUsageTrackers holds the tracker of every server whose usage was requested, they are shared by all consumers
+var diskUsageTrackers = struct {
+	sync.Mutex
+	m map[string]*diskUsageTracker
+}{m: make(map[string]*diskUsageTracker)}
+
+// DiskUsage returns the disk usage tracker of the server's data. It is started on first use, which scans the whole
+// volume once, afterwards it is kept up to date until the server is deleted.
+func DiskUsage(sid string) (*diskusage.Tracker, error) {
+	diskUsageTrackers.Lock()
+	t, ok := diskUsageTrackers.m[sid]
+	if !ok {
+		t = &diskUsageTracker{ready: make(chan struct{})}
+		diskUsageTrackers.m[sid] = t
+	}
+	diskUsageTrackers.Unlock()
+
+	if ok {
+		<-t.ready
+		return t.tracker, t.err
+	}
+
+	t.tracker, t.err = startDiskUsage(sid)
+	if t.err != nil {
+		// not kept, so the next call tries again
+		diskUsageTrackers.Lock()
+		delete(diskUsageTrackers.m, sid)
+		diskUsageTrackers.Unlock()
+	}
+	close(t.ready)
+
+	return t.tracker, t.err
+}
+
+func startDiskUsage(sid string) (*diskusage.Tracker, error) {
+	vol, err := docker.Instance().VolumeInspect(context.Background(), fmt.Sprint("server_", sid))
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect volume: %w", err)
+	}
+
+	tracker, err := diskusage.New(vol.Mountpoint, config.ConfigInstance.GetDiskUsageRescanInterval())
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan disk usage: %w", err)
+	}
+
+	return tracker, nil
+}
+
+// rescanDiskUsage schedules a full rescan of the server's data if its usage is tracked.
+func rescanDiskUsage(sid string) {
+	diskUsageTrackers.Lock()
+	t, ok := diskUsageTrackers.m[sid]
+	diskUsageTrackers.Unlock()
+	if !ok {
+		return
+	}
+
+	<-t.ready
+	if t.tracker != nil {
+		t.tracker.Rescan()
+	}
+}
+
+// stopDiskUsage stops tracking the usage of a deleted server.
+func stopDiskUsage(sid string) {
+	diskUsageTrackers.Lock()
+	t, ok := diskUsageTrackers.m[sid]
+	delete(diskUsageTrackers.m, sid)
+	diskUsageTrackers.Unlock()
+	if !ok {
+		return
+	}
+
+	<-t.ready
+	if t.tracker != nil {
+		t.tracker.Close()
+	}
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"os"
+	"panelium/common/blueprint"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/model"
+	"panelium/proto_gen_go/daemon"
+	"slices"
+	"time"
+)
+
+var ErrEulaNotRequired = errors.New("the blueprint of this server does not require accepting a EULA")
+
+func eulaBeforeStart(s *model.Server) error {
+	if s.EulaAcceptedAt.IsZero() {
+		return &FlagError{Flag: blueprint.FlagEula, Err: errors.New("the EULA has to be accepted by the owner before the server can be started")}
+	}
+
+	// written on every start, as a clean reinstall removes it together with the rest of the data
+	return writeEula(s.SID)
+}
+
+func writeEula(sid string) error {
+	root, err := GetRoot(sid)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	f, err := root.OpenFile("eula.txt", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write eula.txt: %w", err)
+	}
+	defer f.Close()
+
+	_, err = f.WriteString("eula=true\n")
+	if err != nil {
+		return fmt.Errorf("failed to write eula.txt: %w", err)
+	}
+
+	return nil
+}
+
+func requiresEula(bid string) (bool, error) {
+	flags, err := blueprintFlags(bid)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(flags, blueprint.FlagEula), nil
+}
+
+func EulaStatus(s *model.Server) (*daemon.EulaStatus, error) {
+	required, err := requiresEula(s.BID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &daemon.EulaStatus{
+		Required:   required,
+		Accepted:   !s.EulaAcceptedAt.IsZero(),
+		AcceptedBy: s.EulaAcceptedBy,
+	}
+	if status.Accepted {
+		status.AcceptedAt = timestamppb.New(s.EulaAcceptedAt)
+	}
+
+	return status, nil
+}
+
+// AcceptEula records that the user accepted the EULA of the server and writes eula.txt. A missing volume, e.g. while
+// the server is being installed, does not fail the acceptance, the file is written before every start anyway.
+func AcceptEula(sid string, userId string) error {
+	unlock := lockServer(sid)
+	defer unlock()
+
+	var s model.Server
+	tx := db.Instance().First(&s, "sid = ?", sid)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return fmt.Errorf("failed to find server with ID %s: %w", sid, tx.Error)
+	}
+
+	required, err := requiresEula(s.BID)
+	if err != nil {
+		return err
+	}
+	if !required {
+		return ErrEulaNotRequired
+	}
+
+	tx = db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Updates(model.Server{
+		EulaAcceptedBy: userId,
+		EulaAcceptedAt: time.Now(),
+	})
+	if tx.Error != nil {
+		return fmt.Errorf("failed to record eula acceptance: %w", tx.Error)
+	}
+
+	err = writeEula(sid)
+	if err != nil {
+		log.Printf("failed to write eula.txt of server %s: %v\n", sid, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"panelium/common/blueprint"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/model"
+)
+
+// serverFlag defines the behavior of a blueprint flag, hooks left nil do nothing
+type serverFlag struct {
+	// beforeStart runs before the server container is started, an error refuses the start. A *FlagError tells the user
+	// what to do, other errors are failures of the hook itself.
+	beforeStart func(s *model.Server) error
+}
+
+// serverFlags holds the behavior of every flag in blueprint.Flags
+var serverFlags = map[string]serverFlag{
+	blueprint.FlagEula: {
+		beforeStart: eulaBeforeStart,
+	},
+}
+
+// FlagError is returned when a blueprint flag refuses an operation.
+type FlagError struct {
+	Flag string
+	Err  error
+}
+
+func (e *FlagError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FlagError) Unwrap() error {
+	return e.Err
+}
+
+// blueprintFlags returns the flags of the blueprint, flags the daemon does not know are logged and left out.
+func blueprintFlags(bid string) ([]string, error) {
+	var b model.Blueprint
+	tx := db.Instance().First(&b, "bid = ?", bid)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return nil, fmt.Errorf("failed to find blueprint with ID %s: %w", bid, tx.Error)
+	}
+
+	var flags []string
+	if len(b.Flags) > 0 {
+		err := json.Unmarshal(b.Flags, &flags)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan flags from blueprint: %w", err)
+		}
+	}
+
+	known := flags[:0]
+	for _, flag := range flags {
+		if _, ok := serverFlags[flag]; !ok {
+			log.Printf("ignoring unknown flag %s of blueprint %s\n", flag, bid)
+			continue
+		}
+		known = append(known, flag)
+	}
+
+	return known, nil
+}
+
+// beforeStart runs the start hooks of the flags of the server's blueprint.
+func beforeStart(s *model.Server) error {
+	flags, err := blueprintFlags(s.BID)
+	if err != nil {
+		return err
+	}
+
+	for _, flag := range flags {
+		hook := serverFlags[flag].beforeStart
+		if hook == nil {
+			continue
+		}
+
+		err := hook(s)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
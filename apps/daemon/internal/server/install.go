@@ -4,15 +4,15 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	cerrdefs "github.com/containerd/errdefs"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/api/types/volume"
-	"github.com/docker/go-connections/nat"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
-	"io"
+	"gorm.io/gorm"
 	"log"
 	"os"
 	"panelium/daemon/internal/db"
@@ -20,15 +20,98 @@ import (
 	"panelium/daemon/internal/model"
 	"panelium/proto_gen_go/daemon"
 	"path"
+	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 )
 
-// TODO: implement storage limiting
+// InstallOptions select what an install does with the existing data and container of the server.
+type InstallOptions struct {
+	Mode     daemon.InstallMode
+	Preserve []string // paths relative to the server root kept by a clean install
+}
+
+// Install recreates the server container from its blueprint and blocks until the install finished. The install is
+// cancelled if the server is deleted in the meantime.
+func Install(sid string, opts InstallOptions) error {
+	unlock := lockServer(sid)
+	ctx, err := beginInstall(sid)
+	unlock()
+	if err != nil {
+		return err
+	}
+
+	return runInstall(ctx, sid, opts)
+}
+
+// beginInstall checks that the server can be installed, marks it installing and registers the install.
+// The operation lock of the server must be held.
+func beginInstall(sid string) (context.Context, error) {
+	var s model.Server
+	tx := db.Instance().First(&s, "sid = ?", sid)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return nil, fmt.Errorf("failed to find server with ID %s: %w", sid, tx.Error)
+	}
+
+	err := checkTransition(sid, s.Status, OperationInstall)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := registerInstall(sid)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := setStatus(sid, nil, model.Server{
+		Status: daemon.ServerStatusType_SERVER_STATUS_TYPE_INSTALLING,
+	})
+	if err != nil || !ok {
+		unregisterInstall(sid)
+		log.Printf("failed to update server status to installing: %v\n", err)
+		return nil, fmt.Errorf("failed to update server status to installing: %w", err)
+	}
+
+	return ctx, nil
+}
+
+// runInstall runs an install claimed through beginInstall and stores its outcome.
+func runInstall(ctx context.Context, sid string, opts InstallOptions) error {
+	defer unregisterInstall(sid)
+
+	progress := newInstallProgress(sid, opts.Mode)
+	err := install(ctx, sid, opts, progress)
+	progress.finish(err)
+	if err != nil {
+		_, serr := setStatus(sid, nil, model.Server{
+			Status:        daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
+			OfflineReason: daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_ERROR,
+			TimestampEnd:  time.Now(),
+		})
+		if serr != nil {
+			log.Printf("failed to update server status after failed install: %v\n", serr)
+		}
+		return err
+	}
 
-func Install(sid string) error {
+	_, err = setStatus(sid, nil, model.Server{
+		Status:        daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
+		OfflineReason: daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_CREATED,
+	})
+	if err != nil {
+		log.Printf("failed to update server status after install: %v\n", err)
+		return fmt.Errorf("failed to update server status after install: %w", err)
+	}
+
+	return nil
+}
+
+func install(ctx context.Context, sid string, opts InstallOptions, progress *installProgress) error {
 	var s model.Server
-	tx := db.Instance().Preload("Allocations").First(&s, "sid = ?", sid)
+	tx := db.Instance().Preload("Allocations", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("id")
+	}).First(&s, "sid = ?", sid)
 	if tx.Error != nil || tx.RowsAffected == 0 {
 		log.Printf("err: %v\n", tx.Error)
 		return fmt.Errorf("failed to find server with ID %s: %w", sid, tx.Error)
@@ -41,25 +124,41 @@ func Install(sid string) error {
 		return fmt.Errorf("failed to find blueprint with ID %s: %w", s.BID, tx.Error)
 	}
 
-	// pull setup script docker image
-	rc, err := docker.Instance().ImagePull(context.Background(), blueprint.SetupDockerImage, image.PullOptions{})
+	progress.run.describe(&s, &blueprint)
+
+	ports, portBindings, portEnv, err := portMappings(&blueprint, s.Allocations)
 	if err != nil {
 		log.Printf("err: %v\n", err)
-		return fmt.Errorf("failed to pull setup script docker image %s: %w", blueprint.SetupDockerImage, err)
+		return err
 	}
 
-	_, err = io.Copy(io.Discard, rc) // we could get the progress of the image pull here
+	// rendered before anything is touched, so a broken template or variable fails the install right away
+	templates, err := newTemplateContext(&s, &blueprint, portEnv)
 	if err != nil {
 		log.Printf("err: %v\n", err)
-		return fmt.Errorf("failed to read image pull response: %w", err)
+		return fmt.Errorf("failed to build template context: %w", err)
 	}
-	err = rc.Close()
+
+	startCommand, err := templates.command(&blueprint)
 	if err != nil {
 		log.Printf("err: %v\n", err)
-		return fmt.Errorf("failed to close image pull response: %w", err)
+		return fmt.Errorf("failed to render start command: %w", err)
+	}
+
+	// both images are pulled before anything is touched, so a failed pull leaves the server as it was
+	if opts.Mode != daemon.InstallMode_INSTALL_MODE_RECREATE_CONTAINER {
+		err := pullImage(ctx, blueprint.SetupDockerImage, progress)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = pullImage(ctx, s.DockerImage, progress)
+	if err != nil {
+		return err
 	}
 
-	vl, err := docker.Instance().VolumeList(context.Background(), volume.ListOptions{
+	vl, err := docker.Instance().VolumeList(ctx, volume.ListOptions{
 		Filters: filters.NewArgs(filters.Arg("name", fmt.Sprint("server_", s.SID))),
 	})
 	if err != nil {
@@ -70,7 +169,7 @@ func Install(sid string) error {
 	var vol *volume.Volume
 
 	if len(vl.Volumes) == 0 {
-		v, err := docker.Instance().VolumeCreate(context.Background(), volume.CreateOptions{
+		v, err := docker.Instance().VolumeCreate(ctx, volume.CreateOptions{
 			Name:   fmt.Sprint("server_", s.SID),
 			Driver: "local",
 		})
@@ -87,22 +186,63 @@ func Install(sid string) error {
 		log.Printf("found existing volume for server %s: %s\n", s.SID, vol.Name)
 	}
 
-	setupScript, err := base64.StdEncoding.DecodeString(blueprint.SetupScriptBase64)
+	if opts.Mode == daemon.InstallMode_INSTALL_MODE_CLEAN {
+		err = wipeVolume(vol.Mountpoint, opts.Preserve)
+		if err != nil {
+			log.Printf("err: %v\n", err)
+			return fmt.Errorf("failed to wipe volume of server %s: %w", s.SID, err)
+		}
+		rescanDiskUsage(s.SID)
+	}
+
+	err = applyStorageLimit(&s, vol.Mountpoint)
 	if err != nil {
 		log.Printf("err: %v\n", err)
-		return fmt.Errorf("failed to decode setup script: %w", err)
+		return fmt.Errorf("failed to limit storage of server %s: %w", s.SID, err)
 	}
 
-	err = os.WriteFile(path.Join(vol.Mountpoint, "install"), slices.Concat(setupScript, []byte("\necho -e \"DOWNLOAD FINISHED\"")), 0777)
+	resources := container.Resources{
+		Memory:            int64(s.ResourceLimit.RAM * 1024 * 1024),
+		MemoryReservation: int64(s.ResourceLimit.RAM * 1024 * 1024),
+		MemorySwap:        int64(s.ResourceLimit.RAM*1024*1024 + s.ResourceLimit.SWAP*1024*1024),
+	}
+
+	if s.ResourceLimit.CPU > 0 {
+		resources.CPUQuota = int64(s.ResourceLimit.CPU * 1_000)
+		resources.CPUPeriod = 100_000
+		resources.CPUShares = 1024
+	}
+
+	if opts.Mode != daemon.InstallMode_INSTALL_MODE_RECREATE_CONTAINER {
+		err = runSetupScript(ctx, &s, &blueprint, vol, resources, templates.env, progress)
+		if err != nil {
+			return err
+		}
+	}
+
+	hostConfig := &container.HostConfig{
+		Mounts: []mount.Mount{
+			{
+				Type:     mount.TypeBind,
+				Source:   vol.Mountpoint,
+				Target:   "/data",
+				ReadOnly: false,
+			},
+		},
+		Resources:    resources,
+		PortBindings: portBindings,
+		NetworkMode:  network.NetworkBridge,
+	}
+	err = applySecurityProfile(hostConfig, &blueprint, false)
 	if err != nil {
 		log.Printf("err: %v\n", err)
-		return fmt.Errorf("failed to write setup script to volume: %w", err)
+		return fmt.Errorf("failed to apply security profile to server container: %w", err)
 	}
 
-	_ = os.WriteFile(path.Join(vol.Mountpoint, "eula.txt"), []byte("eula=true"), 0777) // TODO: remove in prod
-
+	// the old container is only removed once the new one is ready to be created
 	if s.ContainerExists {
-		err = docker.Instance().ContainerRemove(context.Background(), fmt.Sprint("server_", s.SID), container.RemoveOptions{
+		closeTerminalSessions(s.SID)
+		err = docker.Instance().ContainerRemove(ctx, fmt.Sprint("server_", s.SID), container.RemoveOptions{
 			Force: true,
 		})
 		if err != nil {
@@ -110,28 +250,84 @@ func Install(sid string) error {
 			return fmt.Errorf("failed to remove existing container for server %s: %w", s.SID, err)
 		}
 
-		tx := db.Instance().Where(&model.Server{}, "sid = ?", s.SID).Update("container_exists", false)
+		tx := db.Instance().Model(&model.Server{}).Where("sid = ?", s.SID).Update("container_exists", false)
 		if tx.Error != nil || tx.RowsAffected == 0 {
 			log.Printf("err: %v\n", tx.Error)
 			return fmt.Errorf("failed to update server %s: %w", s.SID, tx.Error)
 		}
 	}
 
-	resources := container.Resources{
-		Memory:            int64(s.ResourceLimit.RAM * 1024 * 1024),
-		MemoryReservation: int64(s.ResourceLimit.RAM * 1024 * 1024),
-		MemorySwap:        int64(s.ResourceLimit.RAM*1024*1024 + s.ResourceLimit.SWAP*1024*1024),
-		OomKillDisable:    &[]bool{true}[0], // dumb hack to not have to create a variable for this
+	// create the server container
+	_, err = docker.Instance().ContainerCreate(ctx, &container.Config{
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		OpenStdin:    true,
+		Tty:          true,
+		Image:        s.DockerImage,
+		WorkingDir:   "/data",
+		Cmd:          startCommand,
+		Env:          templates.env,
+		ExposedPorts: ports,
+	}, hostConfig, &network.NetworkingConfig{}, &v1.Platform{}, fmt.Sprint("server_", s.SID))
+	if err != nil {
+		log.Printf("err: %v\n", err)
+		return fmt.Errorf("failed to create server container: %w", err)
 	}
 
-	if s.ResourceLimit.CPU > 0 {
-		resources.CPUQuota = int64(s.ResourceLimit.CPU * 1_000)
-		resources.CPUPeriod = 100_000
-		resources.CPUShares = 1024
+	tx = db.Instance().Model(&model.Server{}).Where("sid = ?", s.SID).Updates(model.Server{
+		ContainerExists: true,
+	})
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		log.Printf("err: %v\n", tx.Error)
+		return fmt.Errorf("failed to update server %s: %w", s.SID, tx.Error)
+	}
+
+	return nil
+}
+
+// runSetupScript writes the blueprint's setup script to the volume and runs it in a setup container.
+func runSetupScript(ctx context.Context, s *model.Server, blueprint *model.Blueprint, vol *volume.Volume, resources container.Resources, env []string, progress *installProgress) error {
+	setupScript, err := base64.StdEncoding.DecodeString(blueprint.SetupScriptBase64)
+	if err != nil {
+		log.Printf("err: %v\n", err)
+		return fmt.Errorf("failed to decode setup script: %w", err)
+	}
+
+	err = os.WriteFile(path.Join(vol.Mountpoint, "install"), slices.Concat(setupScript, []byte("\necho -e \"DOWNLOAD FINISHED\"")), 0777)
+	if err != nil {
+		log.Printf("err: %v\n", err)
+		return fmt.Errorf("failed to write setup script to volume: %w", err)
+	}
+
+	hostConfig := &container.HostConfig{
+		Mounts: []mount.Mount{
+			{
+				Type:     mount.TypeBind,
+				Source:   vol.Mountpoint,
+				Target:   "/data",
+				ReadOnly: false,
+			},
+		},
+		Resources: resources,
+	}
+	err = applySecurityProfile(hostConfig, blueprint, true)
+	if err != nil {
+		log.Printf("err: %v\n", err)
+		return fmt.Errorf("failed to apply security profile to setup script container: %w", err)
+	}
+
+	// named apart from the server container, which is only replaced after the setup script ran. One with the name is
+	// left over from a daemon that went down during an install.
+	setupName := fmt.Sprint("setup_", s.SID)
+	err = docker.Instance().ContainerRemove(ctx, setupName, container.RemoveOptions{Force: true})
+	if err != nil && !cerrdefs.IsNotFound(err) {
+		log.Printf("err: %v\n", err)
+		return fmt.Errorf("failed to remove stale setup script container: %w", err)
 	}
 
 	// create setup script container
-	scr, err := docker.Instance().ContainerCreate(context.Background(), &container.Config{
+	scr, err := docker.Instance().ContainerCreate(ctx, &container.Config{
 		AttachStdin:  true,
 		AttachStdout: true,
 		AttachStderr: true,
@@ -143,26 +339,40 @@ func Install(sid string) error {
 			blueprint.SetupScriptInterpreter,
 			"./install",
 		},
-		Env: []string{
-			"SERVER_BINARY=" + blueprint.ServerBinary,
-		},
-	}, &container.HostConfig{
-		Mounts: []mount.Mount{
-			{
-				Type:     mount.TypeBind,
-				Source:   vol.Mountpoint,
-				Target:   "/data",
-				ReadOnly: false,
-			},
-		},
-		Resources: resources,
-	}, &network.NetworkingConfig{}, &v1.Platform{}, fmt.Sprint("server_", s.SID))
+		Env: env,
+	}, hostConfig, &network.NetworkingConfig{}, &v1.Platform{}, setupName)
 	if err != nil {
 		log.Printf("err: %v\n", err)
 		return fmt.Errorf("failed to create setup script container: %w", err)
 	}
 
-	if err := docker.Instance().ContainerStart(context.Background(), scr.ID, container.StartOptions{}); err != nil {
+	// a failed setup container must not be left behind
+	defer func() {
+		err := docker.Instance().ContainerRemove(context.Background(), scr.ID, container.RemoveOptions{Force: true})
+		if err != nil && !cerrdefs.IsNotFound(err) {
+			log.Printf("failed to remove setup script container of server %s: %v\n", s.SID, err)
+		}
+	}()
+
+	// attach before starting so no output of the setup script is missed
+	hr, err := docker.Instance().ContainerAttach(ctx, scr.ID, container.AttachOptions{
+		Stream: true,
+		Stdout: true,
+		Stderr: true,
+	})
+	if err != nil {
+		log.Printf("err: %v\n", err)
+		return fmt.Errorf("failed to attach to setup script container: %w", err)
+	}
+	defer hr.Close()
+
+	outputDone := make(chan struct{})
+	go func() {
+		defer close(outputDone)
+		progress.output(hr.Reader)
+	}()
+
+	if err := docker.Instance().ContainerStart(ctx, scr.ID, container.StartOptions{}); err != nil {
 		log.Printf("err: %v\n", err)
 		return fmt.Errorf("failed to start setup script container: %w", err)
 	}
@@ -170,7 +380,7 @@ func Install(sid string) error {
 	log.Printf("setup script container started with ID: %s\n", scr.ID)
 
 	// wait for the setup script container to finish install
-	statusCh, errCh := docker.Instance().ContainerWait(context.Background(), scr.ID, container.WaitConditionNotRunning)
+	statusCh, errCh := docker.Instance().ContainerWait(ctx, scr.ID, container.WaitConditionNotRunning)
 	select {
 	case err := <-errCh:
 		if err != nil {
@@ -178,6 +388,10 @@ func Install(sid string) error {
 			return err
 		}
 	case status := <-statusCh:
+		// the output ends with the container, wait for the last lines before reporting the result
+		<-outputDone
+		progress.exitCode = &[]int32{int32(status.StatusCode)}[0]
+
 		if status.StatusCode != 0 {
 			log.Printf("setup script container exited with status code %d\n", status.StatusCode)
 			return fmt.Errorf("setup script container exited with status code %d", status.StatusCode)
@@ -185,7 +399,7 @@ func Install(sid string) error {
 		log.Printf("setup script container finished with status code %d\n", status.StatusCode)
 
 		// remove the setup script container
-		if err := docker.Instance().ContainerRemove(context.Background(), scr.ID, container.RemoveOptions{
+		if err := docker.Instance().ContainerRemove(ctx, scr.ID, container.RemoveOptions{
 			Force: true,
 		}); err != nil {
 			log.Printf("err: %v\n", err)
@@ -193,16 +407,22 @@ func Install(sid string) error {
 		}
 	}
 
-	rc, err = docker.Instance().ImagePull(context.Background(), s.DockerImage, image.PullOptions{})
+	return nil
+}
+
+// pullImage pulls the image and publishes the pull progress.
+func pullImage(ctx context.Context, ref string, progress *installProgress) error {
+	rc, err := docker.Instance().ImagePull(ctx, ref, image.PullOptions{})
 	if err != nil {
 		log.Printf("err: %v\n", err)
-		return fmt.Errorf("failed to pull setup script docker image %s: %w", s.DockerImage, err)
+		return fmt.Errorf("failed to pull docker image %s: %w", ref, err)
 	}
 
-	_, err = io.Copy(io.Discard, rc) // we could get the progress of the image pull here
+	err = progress.pullProgress(ref, rc)
 	if err != nil {
+		_ = rc.Close()
 		log.Printf("err: %v\n", err)
-		return fmt.Errorf("failed to read image pull response: %w", err)
+		return err
 	}
 	err = rc.Close()
 	if err != nil {
@@ -210,62 +430,54 @@ func Install(sid string) error {
 		return fmt.Errorf("failed to close image pull response: %w", err)
 	}
 
-	ports := make(nat.PortSet)
-	ports[nat.Port("25565/udp")] = struct{}{}
-	ports[nat.Port("25565/tcp")] = struct{}{}
+	return nil
+}
 
-	portBindings := make(nat.PortMap)
-	for _, alloc := range s.Allocations {
-		if alloc.Port < 1024 || alloc.Port > 65535 {
-			log.Printf("err: port %d is out of range (1024-65535)\n", alloc.Port)
-			return fmt.Errorf("port %d is out of range (1024-65535)", alloc.Port)
+// wipeVolume removes everything in the volume except the preserved paths, which are relative to its root.
+// Paths can not escape the volume, symlinks are removed and never followed.
+func wipeVolume(mountpoint string, preserve []string) error {
+	keep := make(map[string]bool, len(preserve))
+	parents := make(map[string]bool)
+	for _, p := range preserve {
+		if p == "" {
+			continue
 		}
-		portBindings[nat.Port("25565/udp")] = []nat.PortBinding{
-			{HostIP: "0.0.0.0", HostPort: fmt.Sprint(alloc.Port)},
+
+		p = strings.TrimPrefix(filepath.Clean("/"+p), "/")
+		if p == "" {
+			// the whole root is preserved
+			return nil
 		}
-		portBindings[nat.Port("25565/tcp")] = []nat.PortBinding{
-			{HostIP: "0.0.0.0", HostPort: fmt.Sprint(alloc.Port)},
+
+		keep[p] = true
+		for d := filepath.Dir(p); d != "."; d = filepath.Dir(d) {
+			parents[d] = true
 		}
-		break // only use first allocation
 	}
 
-	// create the server container
-	_, err = docker.Instance().ContainerCreate(context.Background(), &container.Config{
-		AttachStdin:  true,
-		AttachStdout: true,
-		AttachStderr: true,
-		OpenStdin:    true,
-		Tty:          true,
-		Image:        s.DockerImage,
-		WorkingDir:   "/data",
-		Cmd:          strings.Split(strings.ReplaceAll(strings.ReplaceAll(blueprint.StartCommand, "{{$env::SERVER_BINARY}}", blueprint.ServerBinary), "{{$env::SERVER_MEMORY}}", fmt.Sprint(s.ResourceLimit.RAM)), " "),
-		Env:          []string{"SERVER_BINARY=" + blueprint.ServerBinary},
-		ExposedPorts: ports,
-	}, &container.HostConfig{
-		Mounts: []mount.Mount{
-			{
-				Type:     mount.TypeBind,
-				Source:   vol.Mountpoint,
-				Target:   "/data",
-				ReadOnly: false,
-			},
-		},
-		Resources:    resources,
-		PortBindings: portBindings,
-		NetworkMode:  network.NetworkBridge,
-	}, &network.NetworkingConfig{}, &v1.Platform{}, fmt.Sprint("server_", s.SID))
+	return wipeDirectory(mountpoint, ".", keep, parents)
+}
+
+func wipeDirectory(mountpoint string, dir string, keep map[string]bool, parents map[string]bool) error {
+	entries, err := os.ReadDir(filepath.Join(mountpoint, dir))
 	if err != nil {
-		log.Printf("err: %v\n", err)
-		return fmt.Errorf("failed to create server container: %w", err)
+		return err
 	}
 
-	tx = db.Instance().Model(&model.Server{}).Where("sid = ?", s.SID).Updates(model.Server{
-		ContainerExists: true,
-		OfflineReason:   daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_CREATED,
-	})
-	if tx.Error != nil || tx.RowsAffected == 0 {
-		log.Printf("err: %v\n", tx.Error)
-		return fmt.Errorf("failed to update server %s: %w", s.SID, tx.Error)
+	for _, e := range entries {
+		p := filepath.Join(dir, e.Name())
+		if keep[p] {
+			continue
+		}
+
+		if parents[p] && e.IsDir() {
+			err = wipeDirectory(mountpoint, p, keep, parents)
+		} else {
+			err = os.RemoveAll(filepath.Join(mountpoint, p))
+		}
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
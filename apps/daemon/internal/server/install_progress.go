@@ -0,0 +1,238 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"connectrpc.com/connect"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"log"
+	"panelium/proto_gen_go/daemon"
+	"sync"
+)
+
+const progressSubscriberBuffer = 256
+const progressMaxEvents = 1000 // events kept for late subscribers, older ones are dropped
+
+// installProgress holds the events of a single install and fans them out to its subscribers
+type installProgress struct {
+	lock        sync.Mutex
+	events      []*daemon.InstallProgressEvent
+	subscribers map[chan *daemon.InstallProgressEvent]struct{}
+	done        bool
+	exitCode    *int32 // exit code of the setup script, set by the install once it exited
+	run         *installRun
+}
+
+// progresses holds the progress of the running or last install per server
+var progresses = struct {
+	sync.Mutex
+	m map[string]*installProgress
+}{m: make(map[string]*installProgress)}
+
+// newInstallProgress replaces the progress of the previous install of the server and starts recording the install.
+func newInstallProgress(sid string, mode daemon.InstallMode) *installProgress {
+	p := &installProgress{
+		subscribers: make(map[chan *daemon.InstallProgressEvent]struct{}),
+		run:         startInstallRun(sid, mode),
+	}
+
+	progresses.Lock()
+	progresses.m[sid] = p
+	progresses.Unlock()
+
+	return p
+}
+
+func (p *installProgress) publish(e *daemon.InstallProgressEvent) {
+	e.Timestamp = timestamppb.Now()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.done {
+		return
+	}
+
+	p.events = append(p.events, e)
+	if len(p.events) > progressMaxEvents {
+		p.events = p.events[len(p.events)-progressMaxEvents:]
+	}
+	for ch := range p.subscribers {
+		select {
+		case ch <- e:
+		default:
+			log.Printf("install progress subscriber is not keeping up, dropping event\n")
+		}
+	}
+}
+
+// finish records and publishes the result of the install and closes all subscriptions.
+func (p *installProgress) finish(err error) {
+	p.run.finish(p.exitCode, err)
+
+	result := &daemon.InstallResult{
+		Success:  err == nil,
+		ExitCode: p.exitCode,
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	p.publish(&daemon.InstallProgressEvent{
+		Event: &daemon.InstallProgressEvent_Result{Result: result},
+	})
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.done = true
+	for ch := range p.subscribers {
+		close(ch)
+	}
+	p.subscribers = nil
+}
+
+// subscribe returns the events so far and, unless the install already finished, a channel receiving the following ones.
+func (p *installProgress) subscribe() ([]*daemon.InstallProgressEvent, chan *daemon.InstallProgressEvent, func()) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	events := append([]*daemon.InstallProgressEvent(nil), p.events...)
+	if p.done {
+		return events, nil, func() {}
+	}
+
+	ch := make(chan *daemon.InstallProgressEvent, progressSubscriberBuffer)
+	p.subscribers[ch] = struct{}{}
+
+	return events, ch, func() {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+
+		if _, ok := p.subscribers[ch]; ok {
+			delete(p.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// pullProgress publishes the progress of an image pull and waits for it to finish.
+func (p *installProgress) pullProgress(image string, rc io.Reader) error {
+	decoder := json.NewDecoder(rc)
+	for {
+		var msg jsonmessage.JSONMessage
+		err := decoder.Decode(&msg)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read image pull response: %w", err)
+		}
+		if msg.Error != nil {
+			return fmt.Errorf("failed to pull image %s: %s", image, msg.Error.Message)
+		}
+
+		pull := &daemon.ImagePullProgress{
+			Image:  image,
+			Layer:  msg.ID,
+			Status: msg.Status,
+		}
+		if msg.Progress != nil {
+			pull.Current = msg.Progress.Current
+			pull.Total = msg.Progress.Total
+		}
+
+		p.publish(&daemon.InstallProgressEvent{
+			Event: &daemon.InstallProgressEvent_ImagePull{ImagePull: pull},
+		})
+	}
+}
+
+// scanOutputLines splits like bufio.ScanLines but also ends a line at a lone \r, so progress bars redrawing their
+// line become one line per redraw instead of one endless line.
+func scanOutputLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+		if data[i] == '\r' {
+			if i+1 == len(data) && !atEOF {
+				return 0, nil, nil // it might be followed by \n
+			}
+			if i+1 < len(data) && data[i+1] == '\n' {
+				return i + 2, data[:i], nil
+			}
+		}
+		return i + 1, data[:i], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// output publishes every line written by the setup script until the reader is closed.
+func (p *installProgress) output(r io.Reader) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	scanner.Split(scanOutputLines)
+	for scanner.Scan() {
+		line := scanner.Text()
+		p.run.writeLine(line)
+		p.publish(&daemon.InstallProgressEvent{
+			Event: &daemon.InstallProgressEvent_Output{Output: &daemon.InstallOutput{
+				Text: line,
+			}},
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		log.Printf("failed to read setup script output, dropping the rest of it: %v\n", err)
+		// keep reading, the setup script blocks once the attach stream is full
+		_, _ = io.Copy(io.Discard, r)
+	}
+}
+
+func InstallProgress(
+	ctx context.Context,
+	sid string,
+	stm *connect.ServerStream[daemon.InstallProgressEvent],
+) error {
+	progresses.Lock()
+	p, ok := progresses.m[sid]
+	progresses.Unlock()
+	if !ok {
+		return connect.NewError(connect.CodeNotFound, errors.New("no install of this server since the daemon started"))
+	}
+
+	events, ch, unsubscribe := p.subscribe()
+	defer unsubscribe()
+
+	for _, e := range events {
+		if err := stm.Send(e); err != nil {
+			return err
+		}
+	}
+	if ch == nil {
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stm.Send(e); err != nil {
+				return err
+			}
+		}
+	}
+}
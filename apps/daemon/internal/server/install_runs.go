@@ -0,0 +1,242 @@
+package server
+
+import (
+	"bufio"
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"os"
+	"panelium/daemon/internal/config"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/model"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+	"path/filepath"
+	"time"
+)
+
+// installRun stores a single install and the output of its setup script
+type installRun struct {
+	run     model.InstallRun
+	file    *os.File
+	written int
+}
+
+func installLogDir(sid string) string {
+	return filepath.Join(config.ConfigInstance.GetInstallLogDir(), sid)
+}
+
+func installLogPath(sid string, id uint) string {
+	return filepath.Join(installLogDir(sid), fmt.Sprint(id, ".log"))
+}
+
+// startInstallRun records the start of an install. Failing to record it is logged but does not fail the install.
+func startInstallRun(sid string, mode daemon.InstallMode) *installRun {
+	r := &installRun{
+		run: model.InstallRun{
+			SID:            sid,
+			Mode:           mode,
+			TimestampStart: time.Now(),
+		},
+	}
+
+	tx := db.Instance().Create(&r.run)
+	if tx.Error != nil {
+		log.Printf("failed to record install run of server %s: %v\n", sid, tx.Error)
+		return r
+	}
+
+	err := os.MkdirAll(installLogDir(sid), 0755)
+	if err != nil {
+		log.Printf("failed to create install log directory of server %s: %v\n", sid, err)
+		return r
+	}
+
+	r.file, err = os.OpenFile(installLogPath(sid, r.run.ID), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		log.Printf("failed to create install log of server %s: %v\n", sid, err)
+	}
+
+	return r
+}
+
+// describe records what the install is based on, once the server and its blueprint were loaded.
+func (r *installRun) describe(s *model.Server, blueprint *model.Blueprint) {
+	r.run.BID = blueprint.BID
+	r.run.BlueprintVersion = blueprint.Version
+	r.run.SetupDockerImage = blueprint.SetupDockerImage
+	r.run.DockerImage = s.DockerImage
+}
+
+// writeLine appends a line of setup script output to the log, output beyond the maximum log size is dropped.
+func (r *installRun) writeLine(line string) {
+	if r.file == nil || r.run.LogTruncated {
+		return
+	}
+
+	if r.written+len(line)+1 > config.ConfigInstance.GetInstallLogMaxSize()*1024*1024 {
+		r.run.LogTruncated = true
+		_, _ = r.file.WriteString("[output truncated, maximum log size reached]\n")
+		return
+	}
+
+	n, err := r.file.WriteString(line + "\n")
+	r.written += n
+	if err != nil {
+		log.Printf("failed to write install log of server %s: %v\n", r.run.SID, err)
+		_ = r.file.Close()
+		r.file = nil
+	}
+}
+
+// finish records the outcome of the install and removes the oldest runs of the server beyond the configured amount.
+func (r *installRun) finish(exitCode *int32, err error) {
+	if r.file != nil {
+		if cerr := r.file.Close(); cerr != nil {
+			log.Printf("failed to close install log of server %s: %v\n", r.run.SID, cerr)
+		}
+		r.file = nil
+	}
+
+	if r.run.ID == 0 {
+		return
+	}
+
+	r.run.TimestampEnd = time.Now()
+	r.run.Success = err == nil
+	r.run.ExitCode = exitCode
+	if err != nil {
+		r.run.Error = err.Error()
+	}
+
+	tx := db.Instance().Save(&r.run)
+	if tx.Error != nil {
+		log.Printf("failed to record install run of server %s: %v\n", r.run.SID, tx.Error)
+	}
+
+	pruneInstallRuns(r.run.SID)
+}
+
+func pruneInstallRuns(sid string) {
+	var old []model.InstallRun
+	tx := db.Instance().Where("sid = ?", sid).Order("id DESC").Offset(config.ConfigInstance.GetInstallRunsKept()).Find(&old)
+	if tx.Error != nil {
+		log.Printf("failed to list old install runs of server %s: %v\n", sid, tx.Error)
+		return
+	}
+
+	for _, run := range old {
+		err := os.Remove(installLogPath(sid, run.ID))
+		if err != nil && !os.IsNotExist(err) {
+			log.Printf("failed to remove install log %d of server %s: %v\n", run.ID, sid, err)
+		}
+
+		tx := db.Instance().Delete(&run)
+		if tx.Error != nil {
+			log.Printf("failed to delete install run %d of server %s: %v\n", run.ID, sid, tx.Error)
+		}
+	}
+}
+
+// closeInterruptedInstallRuns marks install runs that were still running when the daemon went down as failed.
+func closeInterruptedInstallRuns() error {
+	tx := db.Instance().Model(&model.InstallRun{}).Where("timestamp_end IS NULL").Updates(model.InstallRun{
+		TimestampEnd: time.Now(),
+		Error:        "install was interrupted by a daemon restart",
+	})
+	if tx.Error != nil {
+		return fmt.Errorf("failed to close interrupted install runs: %w", tx.Error)
+	}
+
+	return nil
+}
+
+// deleteInstallRuns removes all install runs of the server together with their logs.
+func deleteInstallRuns(sid string) error {
+	tx := db.Instance().Delete(&model.InstallRun{}, "sid = ?", sid)
+	if tx.Error != nil {
+		return fmt.Errorf("failed to delete install runs: %w", tx.Error)
+	}
+
+	err := os.RemoveAll(installLogDir(sid))
+	if err != nil {
+		return fmt.Errorf("failed to remove install logs: %w", err)
+	}
+
+	return nil
+}
+
+func ListInstallRuns(sid string) ([]*daemon.InstallRun, error) {
+	var runs []model.InstallRun
+	tx := db.Instance().Where("sid = ?", sid).Order("id DESC").Find(&runs)
+	if tx.Error != nil {
+		return nil, fmt.Errorf("failed to list install runs: %w", tx.Error)
+	}
+
+	result := make([]*daemon.InstallRun, 0, len(runs))
+	for _, run := range runs {
+		r := &daemon.InstallRun{
+			Id:               uint32(run.ID),
+			Bid:              run.BID,
+			BlueprintVersion: uint32(run.BlueprintVersion),
+			SetupDockerImage: run.SetupDockerImage,
+			DockerImage:      run.DockerImage,
+			TimestampStart:   timestamppb.New(run.TimestampStart),
+			Success:          run.Success,
+			ExitCode:         run.ExitCode,
+			Error:            run.Error,
+			LogTruncated:     run.LogTruncated,
+			Mode:             run.Mode,
+		}
+		if !run.TimestampEnd.IsZero() {
+			r.TimestampEnd = timestamppb.New(run.TimestampEnd)
+		}
+
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+func GetInstallLog(
+	ctx context.Context,
+	sid string,
+	runId uint32,
+	stm *connect.ServerStream[proto_gen_go.SimpleMessage],
+) error {
+	var run model.InstallRun
+	tx := db.Instance().First(&run, "id = ? AND sid = ?", runId, sid)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return connect.NewError(connect.CodeNotFound, errors.New("install run not found"))
+	}
+
+	f, err := os.Open(installLogPath(sid, run.ID))
+	if os.IsNotExist(err) {
+		return connect.NewError(connect.CodeNotFound, errors.New("install log not found"))
+	}
+	if err != nil {
+		log.Printf("failed to open install log %d of server %s: %v\n", run.ID, sid, err)
+		return connect.NewError(connect.CodeInternal, errors.New("failed to open install log"))
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err := stm.Send(&proto_gen_go.SimpleMessage{Text: scanner.Text()}); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Printf("failed to read install log %d of server %s: %v\n", run.ID, sid, err)
+		return connect.NewError(connect.CodeInternal, errors.New("failed to read install log"))
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"panelium/proto_gen_go/daemon"
+	"slices"
+	"strings"
+	"sync"
+)
+
+type Operation int
+
+const (
+	OperationInstall Operation = iota
+	OperationStart
+	OperationStop
+	OperationKill
+	OperationRestart
+	OperationReinstall
+	OperationDelete
+)
+
+func (o Operation) String() string {
+	switch o {
+	case OperationInstall:
+		return "install"
+	case OperationStart:
+		return "start"
+	case OperationStop:
+		return "stop"
+	case OperationKill:
+		return "kill"
+	case OperationRestart:
+		return "restart"
+	case OperationReinstall:
+		return "reinstall"
+	case OperationDelete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
+// transitions lists the statuses each operation may be started from. Delete is allowed from every status, a pending
+// install is cancelled for it.
+var transitions = map[Operation][]daemon.ServerStatusType{
+	OperationInstall: {
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_UNKNOWN,
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
+	},
+	OperationStart: {
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
+	},
+	OperationStop: {
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_STARTING,
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE,
+	},
+	OperationKill: {
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_STARTING,
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE,
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_STOPPING,
+	},
+	OperationRestart: {
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_STARTING,
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE,
+	},
+	OperationReinstall: {
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_STARTING,
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE,
+	},
+	OperationDelete: {
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_UNKNOWN,
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_STARTING,
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE,
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_STOPPING,
+		daemon.ServerStatusType_SERVER_STATUS_TYPE_INSTALLING,
+	},
+}
+
+// TransitionError is returned when an operation is not allowed in the current status of the server.
+type TransitionError struct {
+	SID       string
+	Operation Operation
+	Status    daemon.ServerStatusType
+}
+
+func (e *TransitionError) Error() string {
+	status := strings.ToLower(strings.TrimPrefix(e.Status.String(), "SERVER_STATUS_TYPE_"))
+	return fmt.Sprintf("cannot %s server %s while it is %s", e.Operation, e.SID, status)
+}
+
+func checkTransition(sid string, status daemon.ServerStatusType, op Operation) error {
+	if !slices.Contains(transitions[op], status) {
+		return &TransitionError{SID: sid, Operation: op, Status: status}
+	}
+
+	return nil
+}
+
+// operations holds one lock per server, lifecycle operations take it while checking and claiming their transition
+var operations = struct {
+	sync.Mutex
+	m map[string]*sync.Mutex
+}{m: make(map[string]*sync.Mutex)}
+
+// lockServer takes the operation lock of the server and returns the function to release it.
+func lockServer(sid string) func() {
+	operations.Lock()
+	l, ok := operations.m[sid]
+	if !ok {
+		l = &sync.Mutex{}
+		operations.m[sid] = l
+	}
+	operations.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+type pendingInstall struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// installs holds the running installs per server so they can be cancelled
+var installs = struct {
+	sync.Mutex
+	m map[string]*pendingInstall
+}{m: make(map[string]*pendingInstall)}
+
+// registerInstall records a running install of the server, it fails if one is running already.
+// The operation lock of the server must be held.
+func registerInstall(sid string) (context.Context, error) {
+	installs.Lock()
+	defer installs.Unlock()
+
+	if _, ok := installs.m[sid]; ok {
+		return nil, &TransitionError{SID: sid, Operation: OperationInstall, Status: daemon.ServerStatusType_SERVER_STATUS_TYPE_INSTALLING}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	installs.m[sid] = &pendingInstall{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	return ctx, nil
+}
+
+func unregisterInstall(sid string) {
+	installs.Lock()
+	defer installs.Unlock()
+
+	p, ok := installs.m[sid]
+	if !ok {
+		return
+	}
+
+	p.cancel()
+	close(p.done)
+	delete(installs.m, sid)
+}
+
+// cancelInstall cancels the running install of the server, if any, and waits for it to finish.
+func cancelInstall(sid string) {
+	installs.Lock()
+	p, ok := installs.m[sid]
+	installs.Unlock()
+	if !ok {
+		return
+	}
+
+	p.cancel()
+	<-p.done
+}
+
+type pendingShutdown struct {
+	kill     chan struct{} // closed to escalate the shutdown to SIGKILL
+	killOnce sync.Once
+}
+
+func (p *pendingShutdown) escalate() {
+	p.killOnce.Do(func() {
+		close(p.kill)
+	})
+}
+
+func (p *pendingShutdown) escalated() bool {
+	select {
+	case <-p.kill:
+		return true
+	default:
+		return false
+	}
+}
+
+// shutdowns holds the running shutdowns per server, so a kill escalates the running one instead of racing it
+var shutdowns = struct {
+	sync.Mutex
+	m map[string]*pendingShutdown
+}{m: make(map[string]*pendingShutdown)}
+
+// registerShutdown records a running shutdown of the server. The operation lock of the server must be held.
+func registerShutdown(sid string) *pendingShutdown {
+	shutdowns.Lock()
+	defer shutdowns.Unlock()
+
+	p := &pendingShutdown{kill: make(chan struct{})}
+	shutdowns.m[sid] = p
+	return p
+}
+
+// unregisterShutdown removes the shutdown once the server's status reflects its outcome.
+func unregisterShutdown(sid string, p *pendingShutdown) {
+	shutdowns.Lock()
+	defer shutdowns.Unlock()
+
+	if shutdowns.m[sid] == p {
+		delete(shutdowns.m, sid)
+	}
+}
+
+// escalateShutdown escalates the running shutdown of the server to SIGKILL, it reports false if none is running.
+func escalateShutdown(sid string) bool {
+	shutdowns.Lock()
+	p, ok := shutdowns.m[sid]
+	shutdowns.Unlock()
+	if !ok {
+		return false
+	}
+
+	p.escalate()
+	return true
+}
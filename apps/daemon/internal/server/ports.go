@@ -0,0 +1,107 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/docker/go-connections/nat"
+	"panelium/daemon/internal/model"
+	"panelium/proto_gen_go"
+	"strings"
+)
+
+// portMappings binds the server's allocations in order to the ports declared by the blueprint, each on the IP of its
+// allocation. Allocations beyond the declared ports are extra ports, bound to the same port inside the container.
+// The returned environment variables tell the server which ports to use.
+func portMappings(blueprint *model.Blueprint, allocations []model.ServerAllocation) (nat.PortSet, nat.PortMap, []string, error) {
+	var declared []*proto_gen_go.BlueprintPort
+	if len(blueprint.Ports) > 0 {
+		err := json.Unmarshal(blueprint.Ports, &declared)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to scan ports from blueprint: %w", err)
+		}
+	}
+
+	exposed := make(nat.PortSet)
+	bindings := make(nat.PortMap)
+	var env []string
+
+	bind := func(containerPort uint32, protocol proto_gen_go.PortProtocol, alloc *model.ServerAllocation) error {
+		for _, proto := range protocols(protocol) {
+			port, err := nat.NewPort(proto, fmt.Sprint(containerPort))
+			if err != nil {
+				return err
+			}
+
+			exposed[port] = struct{}{}
+			if alloc != nil {
+				bindings[port] = append(bindings[port], nat.PortBinding{
+					HostIP:   alloc.IP,
+					HostPort: fmt.Sprint(alloc.Port),
+				})
+			}
+		}
+
+		return nil
+	}
+
+	for i, alloc := range allocations {
+		if alloc.Port < 1024 || alloc.Port > 65535 {
+			return nil, nil, nil, fmt.Errorf("port %d is out of range (1024-65535)", alloc.Port)
+		}
+
+		if i < len(declared) {
+			port := declared[i]
+			err := bind(port.Port, port.Protocol, &alloc)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("invalid port %s: %w", port.Name, err)
+			}
+
+			name := strings.ToUpper(port.Name)
+			env = append(env,
+				fmt.Sprint("SERVER_PORT_", name, "=", port.Port),
+				fmt.Sprint("SERVER_PUBLIC_PORT_", name, "=", alloc.Port),
+				fmt.Sprint("SERVER_IP_", name, "=", alloc.IP),
+			)
+			continue
+		}
+
+		extra := i - len(declared) + 1
+		err := bind(uint32(alloc.Port), proto_gen_go.PortProtocol_PORT_PROTOCOL_TCP_UDP, &alloc)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		env = append(env,
+			fmt.Sprint("SERVER_EXTRA_PORT_", extra, "=", alloc.Port),
+			fmt.Sprint("SERVER_IP_EXTRA_", extra, "=", alloc.IP),
+		)
+	}
+
+	// declared ports without an allocation are still exposed, so they can be reached from other containers
+	for i := len(allocations); i < len(declared); i++ {
+		port := declared[i]
+		err := bind(port.Port, port.Protocol, nil)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid port %s: %w", port.Name, err)
+		}
+
+		env = append(env, fmt.Sprint("SERVER_PORT_", strings.ToUpper(port.Name), "=", port.Port))
+	}
+
+	if extras := len(allocations) - len(declared); extras > 0 {
+		env = append(env, fmt.Sprint("SERVER_EXTRA_PORTS=", extras))
+	}
+
+	return exposed, bindings, env, nil
+}
+
+func protocols(protocol proto_gen_go.PortProtocol) []string {
+	switch protocol {
+	case proto_gen_go.PortProtocol_PORT_PROTOCOL_TCP:
+		return []string{"tcp"}
+	case proto_gen_go.PortProtocol_PORT_PROTOCOL_UDP:
+		return []string{"udp"}
+	default:
+		return []string{"tcp", "udp"}
+	}
+}
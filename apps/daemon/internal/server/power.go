@@ -2,10 +2,12 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/docker/docker/api/types/container"
 	"log"
 	"panelium/common/util"
+	"panelium/daemon/internal/config"
 	"panelium/daemon/internal/db"
 	"panelium/daemon/internal/docker"
 	"panelium/daemon/internal/model"
@@ -14,6 +16,9 @@ import (
 )
 
 func Start(sid string) error {
+	unlock := lockServer(sid)
+	defer unlock()
+
 	var s model.Server
 	tx := db.Instance().First(&s, "sid = ?", sid)
 	if tx.Error != nil || tx.RowsAffected == 0 {
@@ -21,10 +26,33 @@ func Start(sid string) error {
 		return fmt.Errorf("failed to find server with ID %s: %w", sid, tx.Error)
 	}
 
+	if s.Quarantined {
+		return fmt.Errorf("server %s is quarantined as the backend no longer knows it", s.SID)
+	}
+
+	err := checkTransition(s.SID, s.Status, OperationStart)
+	if err != nil {
+		return err
+	}
+
 	if !s.ContainerExists {
 		return fmt.Errorf("server %s does not have a container", s.SID)
 	}
 
+	err = beforeStart(&s)
+	if err != nil {
+		return err
+	}
+
+	if !currentStorageBackend().enforced() {
+		state, err := measureStorage(&s)
+		if err != nil {
+			log.Printf("failed to measure storage usage of server %s: %v\n", s.SID, err)
+		} else if state.exceeded() {
+			return ErrStorageLimitExceeded
+		}
+	}
+
 	ci, err := docker.Instance().ContainerInspect(context.Background(), fmt.Sprint("server_", s.SID))
 	if err != nil {
 		return fmt.Errorf("failed to inspect server container %s: %v", s.SID, err)
@@ -33,29 +61,55 @@ func Start(sid string) error {
 		return nil
 	}
 
+	pattern := startupDonePattern(s.BID)
+
+	// attach before starting so no output is missed, neither in the console nor while waiting for the startup done
+	// pattern. The container isn't running, so an attachment still open is left over from its last run.
+	consoleOf(s.SID).detach()
+	c, err := attachConsole(s.SID)
+	if err != nil {
+		log.Printf("failed to attach to server container %s: %v\n", s.SID, err)
+	}
+	var matched <-chan bool
+	stopWatching := func() {}
+	if pattern != nil {
+		if c != nil {
+			matched, stopWatching = c.watchStartup(pattern)
+		} else {
+			log.Printf("skipping startup detection of server %s without a console\n", s.SID)
+			pattern = nil
+		}
+	}
+
 	err = docker.Instance().ContainerStart(context.Background(), fmt.Sprint("server_", s.SID), container.StartOptions{})
 	if err != nil {
 		log.Printf("failed to start server container %s: %v\n", s.SID, err)
+		stopWatching()
+		return err
 	}
 
-	tx = db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Updates(model.Server{
-		Status:         daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE, // TODO: set to starting
+	ok, err := setStatus(sid, nil, model.Server{
+		Status:         util.IfElse(pattern != nil, daemon.ServerStatusType_SERVER_STATUS_TYPE_STARTING, daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE),
 		TimestampStart: time.Now(),
 	})
-	if tx.Error != nil || tx.RowsAffected == 0 {
-		log.Printf("failed to update server status to starting: %v\n", tx.Error)
-		return fmt.Errorf("failed to update server status to starting: %w", tx.Error)
+	if err != nil || !ok {
+		log.Printf("failed to update server status to starting: %v\n", err)
+		stopWatching()
+		return fmt.Errorf("failed to update server status to starting: %w", err)
 	}
 
-	// TODO: start a goroutine to wait for the server to be fully started and update the status
+	startWatchExit(s.SID)
+	if pattern != nil {
+		go waitForStartup(s.SID, matched, stopWatching)
+	}
 
 	return nil
 }
 
-var stopTimeout = -1
-var killTimeout = 0
-
 func Stop(sid string, kill bool) error {
+	unlock := lockServer(sid)
+	defer unlock()
+
 	var s model.Server
 	tx := db.Instance().First(&s, "sid = ?", sid)
 	if tx.Error != nil || tx.RowsAffected == 0 {
@@ -67,50 +121,45 @@ func Stop(sid string, kill bool) error {
 		return fmt.Errorf("server %s does not have a container", s.SID)
 	}
 
-	// might split into Stop and Kill functions later with kill using ContainerKill
-	err := docker.Instance().ContainerStop(context.Background(), fmt.Sprint("server_", s.SID), container.StopOptions{
-		Timeout: util.IfElse(kill, &stopTimeout, &killTimeout),
-	})
+	err := checkTransition(s.SID, s.Status, util.IfElse(kill, OperationKill, OperationStop))
 	if err != nil {
-		log.Printf("failed to stop server container %s: %v\n", s.SID, err)
 		return err
 	}
 
-	tx = db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Updates(model.Server{
+	// a kill while stopping takes over the running shutdown, it reports the reason it stopped the server with
+	if kill && s.Status == daemon.ServerStatusType_SERVER_STATUS_TYPE_STOPPING && escalateShutdown(s.SID) {
+		return nil
+	}
+
+	ok, err := setStatus(sid, nil, model.Server{
 		Status: daemon.ServerStatusType_SERVER_STATUS_TYPE_STOPPING,
 	})
-	if tx.Error != nil || tx.RowsAffected == 0 {
-		log.Printf("failed to update server status to stopping: %v\n", tx.Error)
-		return fmt.Errorf("failed to update server status to stopping: %w", tx.Error)
+	if err != nil || !ok {
+		log.Printf("failed to update server status to stopping: %v\n", err)
+		return fmt.Errorf("failed to update server status to stopping: %w", err)
 	}
 
+	p := registerShutdown(s.SID)
 	go func() {
-		statusCh, errCh := docker.Instance().ContainerWait(context.Background(), fmt.Sprint("server_", s.SID), container.WaitConditionNotRunning)
-		select {
-		case err := <-errCh:
-			if err != nil {
-				log.Printf("error waiting for server container %s to stop: %v\n", s.SID, err)
-			}
-		case status := <-statusCh:
-			if status.StatusCode != 0 {
-				log.Printf("server container %s stopped with non-zero status code: %d\n", s.SID, status.StatusCode)
-			}
+		defer unregisterShutdown(s.SID, p)
 
-			tx = db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Updates(model.Server{
-				Status:        daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
-				OfflineReason: util.IfElse(kill, daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_KILLED, daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_STOPPED),
-				TimestampEnd:  time.Now(),
-			})
-			if tx.Error != nil || tx.RowsAffected == 0 {
-				log.Printf("failed to update server status to offline: %v\n", tx.Error)
-			}
+		reason, err := shutdown(&s, kill, p.kill)
+		if err != nil {
+			log.Printf("failed to stop server container %s: %v\n", s.SID, err)
+			settleStatus(s.SID)
+			return
 		}
+
+		markOffline(s.SID, reason)
 	}()
 
 	return nil
 }
 
 func Restart(sid string) error {
+	unlock := lockServer(sid)
+	defer unlock()
+
 	var s model.Server
 	tx := db.Instance().First(&s, "sid = ?", sid)
 	if tx.Error != nil || tx.RowsAffected == 0 {
@@ -122,60 +171,153 @@ func Restart(sid string) error {
 		return fmt.Errorf("server %s does not have a container", s.SID)
 	}
 
-	err := docker.Instance().ContainerRestart(context.Background(), s.SID, container.StopOptions{
-		Timeout: &stopTimeout,
-	})
+	err := checkTransition(s.SID, s.Status, OperationRestart)
 	if err != nil {
-		log.Printf("failed to restart server container %s: %v\n", s.SID, err)
 		return err
 	}
 
-	tx = db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Updates(model.Server{
-		Status:       daemon.ServerStatusType_SERVER_STATUS_TYPE_STOPPING,
-		TimestampEnd: time.Now(),
+	ok, err := setStatus(sid, nil, model.Server{
+		Status: daemon.ServerStatusType_SERVER_STATUS_TYPE_STOPPING,
 	})
-	if tx.Error != nil || tx.RowsAffected == 0 {
-		log.Printf("failed to update server status to stopping: %v\n", tx.Error)
-		return fmt.Errorf("failed to update server status to stopping: %w", tx.Error)
+	if err != nil || !ok {
+		log.Printf("failed to update server status to stopping: %v\n", err)
+		return fmt.Errorf("failed to update server status to stopping: %w", err)
 	}
 
+	p := registerShutdown(s.SID)
 	go func() {
-		statusCh, errCh := docker.Instance().ContainerWait(context.Background(), s.SID, container.WaitConditionNotRunning)
-		select {
-		case err := <-errCh:
+		reason, err := shutdown(&s, false, p.kill)
+		if err != nil {
+			log.Printf("failed to stop server container %s for restart: %v\n", s.SID, err)
+			settleStatus(s.SID)
+			unregisterShutdown(s.SID, p)
+			return
+		}
+
+		markOffline(s.SID, reason)
+		unregisterShutdown(s.SID, p)
+
+		if p.escalated() {
+			log.Printf("server %s was killed while restarting, not starting it again\n", s.SID)
+			return
+		}
+
+		err = Start(s.SID)
+		if err != nil {
+			log.Printf("failed to start server container %s after restart: %v\n", s.SID, err)
+		}
+	}()
+
+	return nil
+}
+
+// shutdown stops the server container and blocks until it is no longer running. Unless kill is set, the blueprint's
+// stop command is written to the console first, then the container is escalated to SIGTERM and finally SIGKILL. Closing
+// escalate skips straight to SIGKILL. The returned offline reason reflects the step that actually stopped the container.
+func shutdown(s *model.Server, kill bool, escalate <-chan struct{}) (daemon.ServerOfflineReason, error) {
+	name := fmt.Sprint("server_", s.SID)
+
+	ci, err := docker.Instance().ContainerInspect(context.Background(), name)
+	if err != nil {
+		return daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_UNKNOWN, fmt.Errorf("failed to inspect server container %s: %w", s.SID, err)
+	}
+	if !ci.State.Running {
+		return daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_STOPPED, nil
+	}
+
+	if !kill {
+		blueprint := model.Blueprint{}
+		tx := db.Instance().First(&blueprint, "bid = ?", s.BID)
+		if tx.Error != nil || tx.RowsAffected == 0 {
+			log.Printf("failed to find blueprint with ID %s, skipping stop command: %v\n", s.BID, tx.Error)
+		} else if blueprint.StopCommand != "" {
+			err = writeConsole(s.SID, blueprint.StopCommand)
 			if err != nil {
-				log.Printf("error waiting for server container %s to stop: %v\n", s.SID, err)
-
-				tx = db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Updates(model.Server{
-					OfflineReason: daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_ERROR,
-				})
-				if tx.Error != nil || tx.RowsAffected == 0 {
-					log.Printf("failed to update server status after restart error: %v\n", tx.Error)
-				}
-			}
-		case status := <-statusCh:
-			if status.StatusCode != 0 {
-				log.Printf("server container %s stopped with non-zero status code: %d\n", s.SID, status.StatusCode)
-
-				tx = db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Updates(model.Server{
-					OfflineReason: daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_ERROR,
-				})
-				if tx.Error != nil || tx.RowsAffected == 0 {
-					log.Printf("failed to update server status after restart error: %v\n", tx.Error)
-				}
+				log.Printf("failed to send stop command to server container %s: %v\n", s.SID, err)
+			} else if waitNotRunning(name, config.ConfigInstance.GetStopGracePeriod(), escalate) {
+				return daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_STOPPED, nil
 			}
+		}
 
-			tx = db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Updates(model.Server{
-				Status:         daemon.ServerStatusType_SERVER_STATUS_TYPE_STARTING,
-				TimestampStart: time.Now(),
-			})
-			if tx.Error != nil || tx.RowsAffected == 0 {
-				log.Printf("failed to update server status to starting after restart: %v\n", tx.Error)
+		select {
+		case <-escalate:
+		default:
+			err = docker.Instance().ContainerKill(context.Background(), name, "SIGTERM")
+			if err != nil {
+				log.Printf("failed to send SIGTERM to server container %s: %v\n", s.SID, err)
+			} else if waitNotRunning(name, config.ConfigInstance.GetStopTermTimeout(), escalate) {
+				return daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_TERMINATED, nil
 			}
 		}
-	}()
+	}
+
+	err = docker.Instance().ContainerKill(context.Background(), name, "SIGKILL")
+	if err != nil {
+		return daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_UNKNOWN, fmt.Errorf("failed to send SIGKILL to server container %s: %w", s.SID, err)
+	}
+	if !waitNotRunning(name, killTimeout, nil) {
+		return daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_UNKNOWN, fmt.Errorf("server container %s is still running after SIGKILL", s.SID)
+	}
 
-	// TODO: start a goroutine to wait for the server to be fully restarted and update the status
+	return daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_KILLED, nil
+}
 
-	return nil
+const killTimeout = 10 * time.Second
+
+// waitNotRunning reports whether the container stopped running within the timeout, it gives up early once escalate is
+// closed.
+func waitNotRunning(name string, timeout time.Duration, escalate <-chan struct{}) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	statusCh, errCh := docker.Instance().ContainerWait(ctx, name, container.WaitConditionNotRunning)
+	select {
+	case <-escalate:
+		return false
+	case err := <-errCh:
+		if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			log.Printf("error waiting for container %s to stop: %v\n", name, err)
+		}
+		return false
+	case status := <-statusCh:
+		if status.StatusCode != 0 {
+			log.Printf("container %s stopped with non-zero status code: %d\n", name, status.StatusCode)
+		}
+		return true
+	}
+}
+
+func markOffline(sid string, reason daemon.ServerOfflineReason) {
+	ok, err := setStatus(sid, nil, model.Server{
+		Status:        daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
+		OfflineReason: reason,
+		TimestampEnd:  time.Now(),
+	})
+	if err != nil || !ok {
+		log.Printf("failed to update server status to offline: %v\n", err)
+	}
+}
+
+// settleStatus sets the status of a server whose shutdown failed from the state of its container, so it doesn't stay
+// stopping.
+func settleStatus(sid string) {
+	// a map, the unknown status and offline reason are zero values a model.Server update would skip
+	update := map[string]any{"status": daemon.ServerStatusType_SERVER_STATUS_TYPE_UNKNOWN}
+	ci, err := docker.Instance().ContainerInspect(context.Background(), fmt.Sprint("server_", sid))
+	if err != nil {
+		log.Printf("failed to inspect server container %s: %v\n", sid, err)
+	} else if ci.State.Running {
+		update["status"] = daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE
+	} else {
+		update = map[string]any{
+			"status":         daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
+			"offline_reason": daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_UNKNOWN,
+			"timestamp_end":  time.Now(),
+		}
+	}
+
+	ok, err := setStatus(sid, []daemon.ServerStatusType{daemon.ServerStatusType_SERVER_STATUS_TYPE_STOPPING}, update)
+	if err != nil || !ok {
+		log.Printf("failed to update server status after failed shutdown: %v\n", err)
+	}
 }
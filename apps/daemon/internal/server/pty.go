@@ -0,0 +1,103 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"panelium/proto_gen_go/daemon"
+)
+
+// ptyConsoleSize converts the size sent by the client to docker's [height, width], nil leaves docker's default.
+func ptyConsoleSize(size *daemon.PtySize) *[2]uint {
+	if size == nil || size.Cols == 0 || size.Rows == 0 {
+		return nil
+	}
+	return &[2]uint{uint(size.Rows), uint(size.Cols)}
+}
+
+// Pty attaches stm to a terminal session of owner on the server, a new one unless open names a running one, and relays
+// the raw input and output until the session ends or the client goes away. The open message was already received from
+// stm. The session is kept when the client goes away, so it can attach again.
+func Pty(
+	ctx context.Context,
+	sid string,
+	owner string,
+	open *daemon.PtyOpen,
+	stm *connect.BidiStream[daemon.PtyInput, daemon.PtyOutput],
+) error {
+	var t *terminalSession
+	var err error
+	if open.SessionId != nil {
+		t, err = terminalSessionOf(*open.SessionId, sid, owner)
+		if err != nil {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		t.resize(open.Size)
+	} else {
+		t, err = startTerminalSession(sid, owner, open.Size, false)
+		if err != nil {
+			log.Printf("failed to start terminal of server %s: %v\n", sid, err)
+			return connect.NewError(connect.CodeInternal, errors.New("failed to create container terminal"))
+		}
+	}
+
+	scrollback, ch, unsubscribe := t.subscribe()
+	defer unsubscribe()
+
+	err = stm.Send(&daemon.PtyOutput{Output: &daemon.PtyOutput_Session{Session: t.info()}})
+	if err != nil {
+		return err
+	}
+	if open.SessionId != nil && len(scrollback) > 0 {
+		err = stm.Send(&daemon.PtyOutput{Output: &daemon.PtyOutput_Data{Data: scrollback}})
+		if err != nil {
+			return err
+		}
+	}
+
+	clientGone := make(chan struct{})
+	go func() {
+		defer close(clientGone)
+
+		for {
+			msg, err := stm.Receive()
+			if err != nil {
+				if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					log.Printf("failed to receive terminal input of server %s: %v\n", sid, err)
+				}
+				return
+			}
+
+			switch input := msg.Input.(type) {
+			case *daemon.PtyInput_Data:
+				err = t.write(input.Data)
+				if err != nil {
+					return
+				}
+			case *daemon.PtyInput_Resize:
+				t.resize(input.Resize)
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-clientGone:
+			return nil
+		case data, ok := <-ch:
+			if !ok {
+				t.mu.Lock()
+				exit := &daemon.PtyExit{ExitCode: t.exitCode}
+				t.mu.Unlock()
+
+				return stm.Send(&daemon.PtyOutput{Output: &daemon.PtyOutput_Exit{Exit: exit}})
+			}
+			err = stm.Send(&daemon.PtyOutput{Output: &daemon.PtyOutput_Data{Data: data}})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
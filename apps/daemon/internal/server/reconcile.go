@@ -0,0 +1,217 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	cerrdefs "github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/volume"
+	"log"
+	"panelium/daemon/internal/config"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/docker"
+	"panelium/daemon/internal/model"
+	"panelium/proto_gen_go/daemon"
+	"strings"
+	"time"
+)
+
+// Reconcile compares every stored server with its docker container and volume and corrects the stored state.
+// On boot, servers that are stuck installing or were running when the daemon went down are handled as well, outside
+// of boot those are left alone as an install, start or stop might still be in progress.
+func Reconcile(boot bool) error {
+	var servers []model.Server
+	tx := db.Instance().Find(&servers)
+	if tx.Error != nil {
+		return fmt.Errorf("failed to list servers: %w", tx.Error)
+	}
+
+	if boot {
+		err := closeInterruptedInstallRuns()
+		if err != nil {
+			log.Printf("%v\n", err)
+		}
+	}
+
+	known := make(map[string]bool, len(servers))
+	for _, s := range servers {
+		known[s.SID] = true
+
+		start, err := reconcileLocked(s.SID, boot)
+		if err != nil {
+			log.Printf("failed to reconcile server %s: %v\n", s.SID, err)
+		}
+		if start {
+			log.Printf("starting server %s again as it was running before the daemon went down\n", s.SID)
+			err = Start(s.SID)
+			if err != nil {
+				log.Printf("failed to start server %s: %v\n", s.SID, err)
+			}
+		}
+	}
+
+	return reconcileOrphans(known)
+}
+
+// reconcileLocked reconciles the server under its operation lock, as the server might have changed since it was listed.
+// It reports whether the server should be started again.
+func reconcileLocked(sid string, boot bool) (bool, error) {
+	unlock := lockServer(sid)
+	defer unlock()
+
+	var s model.Server
+	tx := db.Instance().First(&s, "sid = ?", sid)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		// deleted in the meantime
+		return false, nil
+	}
+
+	return reconcileServer(&s, boot)
+}
+
+func reconcileServer(s *model.Server, boot bool) (bool, error) {
+	name := fmt.Sprint("server_", s.SID)
+
+	_, err := docker.Instance().VolumeInspect(context.Background(), name)
+	if cerrdefs.IsNotFound(err) {
+		if s.Status != daemon.ServerStatusType_SERVER_STATUS_TYPE_INSTALLING || boot {
+			log.Printf("volume of server %s is missing, it needs to be reinstalled\n", s.SID)
+		}
+	} else if err != nil {
+		return false, fmt.Errorf("failed to inspect volume: %w", err)
+	}
+
+	if s.Status == daemon.ServerStatusType_SERVER_STATUS_TYPE_INSTALLING {
+		if !boot {
+			return false, nil
+		}
+
+		// the install was interrupted, whatever container exists is either the setup container or incomplete
+		log.Printf("install of server %s was interrupted\n", s.SID)
+		err = docker.Instance().ContainerRemove(context.Background(), name, container.RemoveOptions{Force: true})
+		if err != nil && !cerrdefs.IsNotFound(err) {
+			return false, fmt.Errorf("failed to remove container of interrupted install: %w", err)
+		}
+
+		_, err = setStatus(s.SID, nil, model.Server{
+			Status:        daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
+			OfflineReason: daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_ERROR,
+			TimestampEnd:  time.Now(),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		tx := db.Instance().Model(&model.Server{}).Where("sid = ?", s.SID).Update("container_exists", false)
+		return false, tx.Error
+	}
+
+	ci, err := docker.Instance().ContainerInspect(context.Background(), name)
+	if cerrdefs.IsNotFound(err) {
+		if s.ContainerExists {
+			log.Printf("container of server %s is missing\n", s.SID)
+			tx := db.Instance().Model(&model.Server{}).Where("sid = ?", s.SID).Update("container_exists", false)
+			if tx.Error != nil {
+				return false, tx.Error
+			}
+		}
+
+		if s.Status != daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE {
+			_, err = setStatus(s.SID, nil, model.Server{
+				Status:        daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
+				OfflineReason: daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_UNKNOWN,
+				TimestampEnd:  time.Now(),
+			})
+			return false, err
+		}
+
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to inspect container: %w", err)
+	}
+
+	if !s.ContainerExists {
+		tx := db.Instance().Model(&model.Server{}).Where("sid = ?", s.SID).Update("container_exists", true)
+		if tx.Error != nil {
+			return false, tx.Error
+		}
+	}
+
+	if isWatched(s.SID) {
+		return false, nil
+	}
+
+	if ci.State.Running {
+		// started outside the daemon, or the daemon restarted while it was running
+		if s.Status != daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE && (boot || s.Status != daemon.ServerStatusType_SERVER_STATUS_TYPE_STOPPING) {
+			startedAt, _ := time.Parse(time.RFC3339Nano, ci.State.StartedAt)
+			_, err = setStatus(s.SID, nil, model.Server{
+				Status:         daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE,
+				TimestampStart: startedAt,
+			})
+			if err != nil {
+				return false, err
+			}
+		}
+
+		startWatchExit(s.SID)
+		_, err = attachConsole(s.SID)
+		if err != nil {
+			log.Printf("failed to attach to console of server %s: %v\n", s.SID, err)
+		}
+		return false, nil
+	}
+
+	wasRunning := s.Status == daemon.ServerStatusType_SERVER_STATUS_TYPE_STARTING || s.Status == daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE
+	if wasRunning || (boot && s.Status == daemon.ServerStatusType_SERVER_STATUS_TYPE_STOPPING) {
+		finishedAt, _ := time.Parse(time.RFC3339Nano, ci.State.FinishedAt)
+		_, err = setStatus(s.SID, nil, model.Server{
+			Status:        daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE,
+			OfflineReason: daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_UNKNOWN,
+			ExitCode:      int32(ci.State.ExitCode),
+			TimestampEnd:  finishedAt,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return boot && wasRunning && config.ConfigInstance.GetRestartOnBoot(), nil
+}
+
+// reconcileOrphans flags server containers and volumes that have no server stored in the database.
+func reconcileOrphans(known map[string]bool) error {
+	containers, err := docker.Instance().ContainerList(context.Background(), container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("name", "server_")),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	for _, c := range containers {
+		for _, name := range c.Names {
+			sid, ok := strings.CutPrefix(strings.TrimPrefix(name, "/"), "server_")
+			if ok && !known[sid] {
+				log.Printf("orphaned container %s has no server in the database\n", name)
+			}
+		}
+	}
+
+	vl, err := docker.Instance().VolumeList(context.Background(), volume.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("name", "server_")),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list volumes: %w", err)
+	}
+
+	for _, v := range vl.Volumes {
+		sid, ok := strings.CutPrefix(v.Name, "server_")
+		if ok && !known[sid] {
+			log.Printf("orphaned volume %s has no server in the database\n", v.Name)
+		}
+	}
+
+	return nil
+}
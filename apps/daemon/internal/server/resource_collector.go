@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"log"
+	"panelium/daemon/internal/model"
+	"panelium/proto_gen_go/daemon"
+	"sync"
+)
+
+const usageSubscriberBuffer = 16
+
+// resourceCollector reads the stats of a server's container once and fans the usage out to all its subscribers
+type resourceCollector struct {
+	sid         string
+	cancel      context.CancelFunc
+	subscribers map[chan *daemon.ResourceUsageMessage]struct{}
+	last        *daemon.ResourceUsageMessage // sent to new subscribers right away
+}
+
+// resourceCollectors holds the running collector of every server with subscribers, guarded by its lock like the
+// subscribers and last sample of the collectors
+var resourceCollectors = struct {
+	sync.Mutex
+	m map[string]*resourceCollector
+}{m: make(map[string]*resourceCollector)}
+
+// SubscribeResourceUsage returns a channel receiving the usage of the server's container, the collector of the server
+// is started if it isn't running yet. The channel is closed when the collector ends, e.g. because the container
+// stopped. The returned function has to be called once the subscriber is done, the collector is stopped when its last
+// subscriber is gone.
+func SubscribeResourceUsage(s *model.Server) (<-chan *daemon.ResourceUsageMessage, func()) {
+	ch := make(chan *daemon.ResourceUsageMessage, usageSubscriberBuffer)
+
+	resourceCollectors.Lock()
+	c, ok := resourceCollectors.m[s.SID]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		c = &resourceCollector{
+			sid:         s.SID,
+			cancel:      cancel,
+			subscribers: make(map[chan *daemon.ResourceUsageMessage]struct{}),
+		}
+		resourceCollectors.m[s.SID] = c
+		go c.run(ctx, *s)
+	}
+	c.subscribers[ch] = struct{}{}
+	if c.last != nil {
+		ch <- c.last
+	}
+	resourceCollectors.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			resourceCollectors.Lock()
+			defer resourceCollectors.Unlock()
+
+			if _, ok := c.subscribers[ch]; !ok {
+				return // closed by the collector already
+			}
+			delete(c.subscribers, ch)
+			close(ch)
+
+			if len(c.subscribers) == 0 {
+				c.stop()
+			}
+		})
+	}
+}
+
+func (c *resourceCollector) run(ctx context.Context, s model.Server) {
+	err := collectResourceUsage(ctx, &s, c.publish)
+	if err != nil && ctx.Err() == nil {
+		log.Printf("failed to collect resource usage of server %s: %v\n", c.sid, err)
+	}
+
+	resourceCollectors.Lock()
+	c.stop()
+	for ch := range c.subscribers {
+		close(ch)
+	}
+	c.subscribers = nil
+	resourceCollectors.Unlock()
+}
+
+// stop cancels the collector and removes it, so the next subscriber starts a new one. resourceCollectors has to be
+// locked.
+func (c *resourceCollector) stop() {
+	c.cancel()
+	if resourceCollectors.m[c.sid] == c {
+		delete(resourceCollectors.m, c.sid)
+	}
+}
+
+// publish sends the usage to all subscribers, it never blocks, subscribers that fall behind miss samples.
+func (c *resourceCollector) publish(usage *daemon.ResourceUsageMessage) {
+	resourceCollectors.Lock()
+	defer resourceCollectors.Unlock()
+
+	c.last = usage
+	for ch := range c.subscribers {
+		select {
+		case ch <- usage:
+		default:
+		}
+	}
+}
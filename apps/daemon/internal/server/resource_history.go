@@ -0,0 +1,442 @@
+package server
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"github.com/docker/docker/api/types/container"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"os"
+	"panelium/daemon/internal/config"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/model"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	historyFineInterval   = 5 * time.Second
+	historyFineSize       = 720 // one hour
+	historyCoarseInterval = time.Minute
+	historyCoarseSize     = 1440 // one day
+	historyPersistEvery   = time.Minute
+)
+
+// usageSample is the usage averaged over one interval of the history, counters hold the last value of the interval
+type usageSample struct {
+	Time           time.Time // start of the interval
+	CPU            float32
+	CPUOfLimit     float32
+	RAM            float32
+	Storage        float32
+	NetworkRxRate  float32
+	NetworkTxRate  float32
+	NetworkRxBytes int64
+	NetworkTxBytes int64
+	BlockRead      int64
+	BlockWrite     int64
+	Pids           int64
+	Uptime         int64
+}
+
+// ring keeps the last len(items) samples, oldest first from start
+type ring struct {
+	Items []usageSample
+	Start int
+	Count int
+}
+
+func newRing(size int) ring {
+	return ring{Items: make([]usageSample, size)}
+}
+
+func (r *ring) push(s usageSample) {
+	r.Items[(r.Start+r.Count)%len(r.Items)] = s
+	if r.Count < len(r.Items) {
+		r.Count++
+	} else {
+		r.Start = (r.Start + 1) % len(r.Items)
+	}
+}
+
+func (r *ring) each(fn func(s usageSample)) {
+	for i := 0; i < r.Count; i++ {
+		fn(r.Items[(r.Start+i)%len(r.Items)])
+	}
+}
+
+// usageAccumulator averages the samples of the current interval
+type usageAccumulator struct {
+	sum usageSample
+	n   int
+}
+
+func (a *usageAccumulator) add(s usageSample) {
+	a.sum.CPU += s.CPU
+	a.sum.CPUOfLimit += s.CPUOfLimit
+	a.sum.RAM += s.RAM
+	a.sum.Storage += s.Storage
+	a.sum.NetworkRxRate += s.NetworkRxRate
+	a.sum.NetworkTxRate += s.NetworkTxRate
+	a.sum.NetworkRxBytes = s.NetworkRxBytes
+	a.sum.NetworkTxBytes = s.NetworkTxBytes
+	a.sum.BlockRead = s.BlockRead
+	a.sum.BlockWrite = s.BlockWrite
+	a.sum.Pids = s.Pids
+	a.sum.Uptime = s.Uptime
+	a.n++
+}
+
+// take returns the average of the interval starting at start and resets the accumulator.
+func (a *usageAccumulator) take(start time.Time) usageSample {
+	avg := a.sum
+	n := float32(a.n)
+	avg.Time = start
+	avg.CPU /= n
+	avg.CPUOfLimit /= n
+	avg.RAM /= n
+	avg.Storage /= n
+	avg.NetworkRxRate /= n
+	avg.NetworkTxRate /= n
+
+	*a = usageAccumulator{}
+	return avg
+}
+
+// resourceHistory holds the downsampled usage of a server
+type resourceHistory struct {
+	mu      sync.Mutex
+	fine    ring
+	coarse  ring
+	changed bool // since it was last persisted
+
+	fineStart   time.Time
+	fineAcc     usageAccumulator
+	coarseStart time.Time
+	coarseAcc   usageAccumulator
+}
+
+// persistedHistory is the part of the history stored on disk
+type persistedHistory struct {
+	Fine   ring
+	Coarse ring
+}
+
+func newResourceHistory() *resourceHistory {
+	return &resourceHistory{
+		fine:   newRing(historyFineSize),
+		coarse: newRing(historyCoarseSize),
+	}
+}
+
+func (h *resourceHistory) add(t time.Time, s usageSample) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	start := t.Truncate(historyFineInterval)
+	if h.fineAcc.n > 0 && !start.Equal(h.fineStart) {
+		fine := h.fineAcc.take(h.fineStart)
+		h.fine.push(fine)
+		h.changed = true
+
+		coarseStart := fine.Time.Truncate(historyCoarseInterval)
+		if h.coarseAcc.n > 0 && !coarseStart.Equal(h.coarseStart) {
+			h.coarse.push(h.coarseAcc.take(h.coarseStart))
+		}
+		h.coarseStart = coarseStart
+		h.coarseAcc.add(fine)
+	}
+	h.fineStart = start
+	h.fineAcc.add(s)
+}
+
+var resourceHistories = struct {
+	sync.Mutex
+	m map[string]*resourceHistory
+}{m: make(map[string]*resourceHistory)}
+
+func historyPath(sid string) string {
+	return filepath.Join(config.ConfigInstance.GetResourceHistoryDir(), fmt.Sprint(sid, ".gob"))
+}
+
+func historyOf(sid string) *resourceHistory {
+	resourceHistories.Lock()
+	defer resourceHistories.Unlock()
+
+	h, ok := resourceHistories.m[sid]
+	if !ok {
+		h = newResourceHistory()
+		resourceHistories.m[sid] = h
+	}
+	return h
+}
+
+// historySampler holds what the next sample of a running server for its history is computed against
+type historySampler struct {
+	busy         bool // sampling right now, guarded by the samplers' lock
+	prev         *container.StatsResponse
+	storageMB    float32
+	lastMeasured time.Time
+}
+
+// RecordResourceHistory samples the usage of every running server for its history and persists it, it never returns.
+// Every sample is a single stats read, so no stats stream is kept open for servers nobody watches.
+func RecordResourceHistory() {
+	loadResourceHistories()
+
+	samplers := make(map[string]*historySampler)
+	var samplersMu sync.Mutex
+
+	lastPersist := time.Now()
+	for range time.Tick(historyFineInterval) {
+		var servers []model.Server
+		tx := db.Instance().Find(&servers)
+		if tx.Error != nil {
+			log.Printf("failed to list servers: %v\n", tx.Error)
+			continue
+		}
+
+		running := make(map[string]bool)
+		for _, s := range servers {
+			if !s.ContainerExists || !runningStatus(s.Status) {
+				continue
+			}
+			running[s.SID] = true
+
+			samplersMu.Lock()
+			sp, ok := samplers[s.SID]
+			if !ok {
+				sp = &historySampler{}
+				samplers[s.SID] = sp
+			}
+			if sp.busy {
+				samplersMu.Unlock()
+				continue
+			}
+			sp.busy = true
+			samplersMu.Unlock()
+
+			go func(s model.Server) {
+				defer func() {
+					samplersMu.Lock()
+					sp.busy = false
+					samplersMu.Unlock()
+				}()
+				sp.sample(&s)
+			}(s)
+		}
+
+		// the counters start over with the next run, so the previous sample is dropped with the server's sampler
+		samplersMu.Lock()
+		for sid, sp := range samplers {
+			if !running[sid] && !sp.busy {
+				delete(samplers, sid)
+			}
+		}
+		samplersMu.Unlock()
+
+		if time.Since(lastPersist) >= historyPersistEvery {
+			persistResourceHistories()
+			lastPersist = time.Now()
+		}
+	}
+}
+
+func runningStatus(status daemon.ServerStatusType) bool {
+	return status == daemon.ServerStatusType_SERVER_STATUS_TYPE_STARTING ||
+		status == daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE ||
+		status == daemon.ServerStatusType_SERVER_STATUS_TYPE_STOPPING
+}
+
+// sample adds the current usage of the server to its history.
+func (sp *historySampler) sample(s *model.Server) {
+	if time.Since(sp.lastMeasured) >= storageMeasureInterval {
+		usage, err := StorageUsage(s)
+		if err == nil {
+			sp.storageMB = float32(usage.UsedBytes) / (1024 * 1024)
+		}
+		sp.lastMeasured = time.Now()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), historyFineInterval)
+	defer cancel()
+
+	msg, stat, err := sampleResourceUsage(ctx, s, sp.prev, sp.storageMB)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("failed to sample resource usage of server %s: %v\n", s.SID, err)
+		}
+		return
+	}
+	sp.prev = stat
+	if msg == nil {
+		return
+	}
+
+	u := msg.Usage
+	historyOf(s.SID).add(msg.Timestamp.AsTime(), usageSample{
+		CPU:            u.Cpu,
+		CPUOfLimit:     u.CpuOfLimit,
+		RAM:            u.Ram,
+		Storage:        u.Storage,
+		NetworkRxRate:  u.NetworkRxRate,
+		NetworkTxRate:  u.NetworkTxRate,
+		NetworkRxBytes: u.NetworkRxBytes,
+		NetworkTxBytes: u.NetworkTxBytes,
+		BlockRead:      u.BlockReadBytes,
+		BlockWrite:     u.BlockWriteBytes,
+		Pids:           u.Pids,
+		Uptime:         u.Uptime,
+	})
+}
+
+func loadResourceHistories() {
+	entries, err := os.ReadDir(config.ConfigInstance.GetResourceHistoryDir())
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Printf("failed to read resource history directory: %v\n", err)
+		}
+		return
+	}
+
+	for _, e := range entries {
+		sid, ok := strings.CutSuffix(e.Name(), ".gob")
+		if !ok || e.IsDir() {
+			continue
+		}
+
+		f, err := os.Open(historyPath(sid))
+		if err != nil {
+			log.Printf("failed to open resource history of server %s: %v\n", sid, err)
+			continue
+		}
+		var p persistedHistory
+		err = gob.NewDecoder(f).Decode(&p)
+		_ = f.Close()
+		if err != nil || len(p.Fine.Items) != historyFineSize || len(p.Coarse.Items) != historyCoarseSize {
+			log.Printf("failed to read resource history of server %s, starting over: %v\n", sid, err)
+			continue
+		}
+
+		h := historyOf(sid)
+		h.mu.Lock()
+		h.fine = p.Fine
+		h.coarse = p.Coarse
+		h.mu.Unlock()
+	}
+}
+
+func persistResourceHistories() {
+	resourceHistories.Lock()
+	histories := make(map[string]*resourceHistory, len(resourceHistories.m))
+	for sid, h := range resourceHistories.m {
+		histories[sid] = h
+	}
+	resourceHistories.Unlock()
+
+	for sid, h := range histories {
+		h.mu.Lock()
+		if !h.changed {
+			h.mu.Unlock()
+			continue
+		}
+		p := persistedHistory{Fine: h.fine, Coarse: h.coarse}
+		p.Fine.Items = append([]usageSample(nil), h.fine.Items...)
+		p.Coarse.Items = append([]usageSample(nil), h.coarse.Items...)
+		h.changed = false
+		h.mu.Unlock()
+
+		err := writeHistory(sid, &p)
+		if err != nil {
+			log.Printf("failed to persist resource history of server %s: %v\n", sid, err)
+		}
+	}
+}
+
+// writeHistory replaces the stored history of the server, a crash while writing leaves the previous one intact.
+func writeHistory(sid string, p *persistedHistory) error {
+	err := os.MkdirAll(config.ConfigInstance.GetResourceHistoryDir(), 0755)
+	if err != nil {
+		return err
+	}
+
+	tmp := historyPath(sid) + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	err = gob.NewEncoder(f).Encode(p)
+	closeErr := f.Close()
+	if err != nil || closeErr != nil {
+		_ = os.Remove(tmp)
+		return errors.Join(err, closeErr)
+	}
+
+	return os.Rename(tmp, historyPath(sid))
+}
+
+// deleteResourceHistory removes the history of a deleted server.
+func deleteResourceHistory(sid string) error {
+	resourceHistories.Lock()
+	delete(resourceHistories.m, sid)
+	resourceHistories.Unlock()
+
+	err := os.Remove(historyPath(sid))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// ResourceHistory returns the samples of the server between from and to in the given resolution.
+func ResourceHistory(sid string, from time.Time, to time.Time, resolution daemon.ResourceHistoryResolution) *daemon.ResourceHistory {
+	if resolution == daemon.ResourceHistoryResolution_RESOURCE_HISTORY_RESOLUTION_AUTO {
+		resolution = daemon.ResourceHistoryResolution_RESOURCE_HISTORY_RESOLUTION_ONE_MINUTE
+		if time.Since(from) <= historyFineInterval*historyFineSize {
+			resolution = daemon.ResourceHistoryResolution_RESOURCE_HISTORY_RESOLUTION_FIVE_SECONDS
+		}
+	}
+
+	h := historyOf(sid)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	r := &h.coarse
+	if resolution == daemon.ResourceHistoryResolution_RESOURCE_HISTORY_RESOLUTION_FIVE_SECONDS {
+		r = &h.fine
+	}
+
+	res := &daemon.ResourceHistory{
+		Resolution: resolution,
+	}
+	r.each(func(s usageSample) {
+		if s.Time.Before(from) || s.Time.After(to) {
+			return
+		}
+		res.Samples = append(res.Samples, &daemon.ResourceUsageMessage{
+			Usage: &proto_gen_go.ResourceUsage{
+				Cpu:             s.CPU,
+				CpuOfLimit:      s.CPUOfLimit,
+				Ram:             s.RAM,
+				Storage:         s.Storage,
+				NetworkRxRate:   s.NetworkRxRate,
+				NetworkTxRate:   s.NetworkTxRate,
+				NetworkRxBytes:  s.NetworkRxBytes,
+				NetworkTxBytes:  s.NetworkTxBytes,
+				BlockReadBytes:  s.BlockRead,
+				BlockWriteBytes: s.BlockWrite,
+				Pids:            s.Pids,
+				Uptime:          s.Uptime,
+			},
+			Timestamp: timestamppb.New(s.Time),
+		})
+	})
+
+	return res
+}
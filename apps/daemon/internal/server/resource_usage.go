@@ -0,0 +1,241 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/docker/docker/api/types/container"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"panelium/daemon/internal/docker"
+	"panelium/daemon/internal/model"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+	"strings"
+	"time"
+)
+
+// storage usage is measured less often than the stats are read
+const storageMeasureInterval = 30 * time.Second
+
+// containerInfo holds what the usage is computed against besides the stats, read once from the container.
+type containerInfo struct {
+	startedAt time.Time // zero if the container isn't running
+	limit     *proto_gen_go.ResourceLimit
+}
+
+// inspectContainer returns the start time and the effective limits of the server's container, which can differ from
+// the stored limits until the container is recreated.
+func inspectContainer(s *model.Server) (*containerInfo, error) {
+	ci, err := docker.Instance().ContainerInspect(context.Background(), fmt.Sprint("server_", s.SID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect server container %s: %w", s.SID, err)
+	}
+
+	info := &containerInfo{
+		limit: &proto_gen_go.ResourceLimit{
+			Storage: s.ResourceLimit.Storage,
+		},
+	}
+
+	if ci.State != nil && ci.State.Running {
+		startedAt, err := time.Parse(time.RFC3339Nano, ci.State.StartedAt)
+		if err == nil {
+			info.startedAt = startedAt
+		}
+	}
+
+	if ci.HostConfig != nil {
+		r := ci.HostConfig.Resources
+		if r.CPUQuota > 0 && r.CPUPeriod > 0 {
+			info.limit.Cpu = uint32(r.CPUQuota * 100 / r.CPUPeriod)
+		} else if r.NanoCPUs > 0 {
+			info.limit.Cpu = uint32(r.NanoCPUs / 10_000_000)
+		}
+		info.limit.Ram = uint32(r.Memory / (1024 * 1024))
+		if r.MemorySwap > r.Memory {
+			info.limit.Swap = uint32((r.MemorySwap - r.Memory) / (1024 * 1024))
+		}
+	}
+
+	return info, nil
+}
+
+// usageFromStats computes the usage from a stats sample of the container. prev is the previous sample of the same
+// stream, used for the network rates, nil for the first one.
+func usageFromStats(stat *container.StatsResponse, prev *container.StatsResponse, info *containerInfo, storageMB float32) *proto_gen_go.ResourceUsage {
+	usage := &proto_gen_go.ResourceUsage{
+		Storage: storageMB,
+		Limit:   info.limit,
+		Pids:    int64(stat.PidsStats.Current),
+	}
+
+	// like docker stats: the container's share of the host's CPU time scaled by the online CPUs, so 100% is one core
+	onlineCPUs := float64(stat.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(stat.CPUStats.CPUUsage.PercpuUsage))
+	}
+	systemDelta := float64(stat.CPUStats.SystemUsage) - float64(stat.PreCPUStats.SystemUsage)
+	if systemDelta > 0 && onlineCPUs > 0 && stat.PreCPUStats.SystemUsage > 0 {
+		cpuDelta := float64(stat.CPUStats.CPUUsage.TotalUsage) - float64(stat.PreCPUStats.CPUUsage.TotalUsage)
+		usage.Cpu = float32(clamp(cpuDelta/systemDelta*onlineCPUs*100, onlineCPUs*100))
+
+		now, before := stat.CPUStats.CPUUsage.PercpuUsage, stat.PreCPUStats.CPUUsage.PercpuUsage
+		if len(now) > 0 && len(now) == len(before) {
+			usage.CpuCores = make([]float32, len(now))
+			for i := range now {
+				coreDelta := float64(now[i]) - float64(before[i])
+				usage.CpuCores[i] = float32(clamp(coreDelta/systemDelta*onlineCPUs*100, 100))
+			}
+		}
+	}
+	if info.limit.Cpu > 0 {
+		usage.CpuOfLimit = usage.Cpu / float32(info.limit.Cpu) * 100
+	}
+
+	// the page cache is counted in the usage but reclaimed before the limit is hit, docker stats leaves it out as well
+	mem := stat.MemoryStats.Usage
+	cache, ok := stat.MemoryStats.Stats["inactive_file"] // cgroup v2
+	if !ok {
+		cache = stat.MemoryStats.Stats["total_inactive_file"] // cgroup v1
+	}
+	if cache < mem {
+		mem -= cache
+	}
+	usage.Ram = float32(mem) / (1024 * 1024)
+
+	for _, n := range stat.Networks {
+		usage.NetworkRxBytes += int64(n.RxBytes)
+		usage.NetworkTxBytes += int64(n.TxBytes)
+	}
+	if prev != nil {
+		elapsed := stat.Read.Sub(prev.Read).Seconds()
+		var prevRx, prevTx int64
+		for _, n := range prev.Networks {
+			prevRx += int64(n.RxBytes)
+			prevTx += int64(n.TxBytes)
+		}
+		// the counters start over when the container restarts
+		if elapsed > 0 && usage.NetworkRxBytes >= prevRx && usage.NetworkTxBytes >= prevTx {
+			usage.NetworkRxRate = float32(float64(usage.NetworkRxBytes-prevRx) / elapsed)
+			usage.NetworkTxRate = float32(float64(usage.NetworkTxBytes-prevTx) / elapsed)
+		}
+	}
+
+	for _, e := range stat.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(e.Op) {
+		case "read":
+			usage.BlockReadBytes += int64(e.Value)
+		case "write":
+			usage.BlockWriteBytes += int64(e.Value)
+		}
+	}
+
+	if !info.startedAt.IsZero() {
+		usage.Uptime = int64(stat.Read.Sub(info.startedAt).Seconds())
+	}
+
+	return usage
+}
+
+func clamp(v float64, max float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// collectResourceUsage passes the usage of the server's container to fn until the container stops or ctx is done.
+func collectResourceUsage(ctx context.Context, s *model.Server, fn func(*daemon.ResourceUsageMessage)) error {
+	info, err := inspectContainer(s)
+	if err != nil {
+		return err
+	}
+
+	csr, err := docker.Instance().ContainerStats(ctx, fmt.Sprint("server_", s.SID), true)
+	if err != nil {
+		return fmt.Errorf("failed to get container stats: %w", err)
+	}
+	defer func() {
+		_ = csr.Body.Close()
+	}()
+
+	var storageMB float32
+	measure := func() {
+		usage, err := StorageUsage(s)
+		if err == nil {
+			storageMB = float32(usage.UsedBytes) / (1024 * 1024)
+		}
+	}
+	measure()
+	lastMeasured := time.Now()
+
+	decoder := json.NewDecoder(csr.Body)
+	var prev *container.StatsResponse
+	for {
+		var stat container.StatsResponse
+		err := decoder.Decode(&stat)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to decode container stats: %w", err)
+		}
+
+		// the first sample has no previous CPU sample to compute the usage from
+		if stat.Read.IsZero() || stat.PreRead.IsZero() || stat.PreCPUStats.SystemUsage == 0 {
+			prev = &stat
+			continue
+		}
+
+		if time.Since(lastMeasured) >= storageMeasureInterval {
+			measure()
+			lastMeasured = time.Now()
+		}
+
+		fn(&daemon.ResourceUsageMessage{
+			Usage:     usageFromStats(&stat, prev, info, storageMB),
+			Timestamp: timestamppb.New(stat.Read),
+		})
+		prev = &stat
+	}
+}
+
+// sampleResourceUsage reads a single stats sample of the server's container without keeping a stats stream open. prev
+// is the previous sample, used for the network rates, nil for the first one. The returned usage is nil if the sample
+// has no previous CPU sample to compute it from.
+func sampleResourceUsage(ctx context.Context, s *model.Server, prev *container.StatsResponse, storageMB float32) (*daemon.ResourceUsageMessage, *container.StatsResponse, error) {
+	info, err := inspectContainer(s)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// not one-shot, docker waits for a second CPU sample then
+	csr, err := docker.Instance().ContainerStats(ctx, fmt.Sprint("server_", s.SID), false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get container stats: %w", err)
+	}
+	defer func() {
+		_ = csr.Body.Close()
+	}()
+
+	var stat container.StatsResponse
+	err = json.NewDecoder(csr.Body).Decode(&stat)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode container stats: %w", err)
+	}
+	if stat.Read.IsZero() || stat.PreRead.IsZero() || stat.PreCPUStats.SystemUsage == 0 {
+		return nil, &stat, nil
+	}
+
+	return &daemon.ResourceUsageMessage{
+		Usage:     usageFromStats(&stat, prev, info, storageMB),
+		Timestamp: timestamppb.New(stat.Read),
+	}, &stat, nil
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/docker/docker/api/types/container"
+	"log"
+	"os"
+	"panelium/common/blueprint"
+	"panelium/daemon/internal/config"
+	"panelium/daemon/internal/model"
+	"slices"
+	"strings"
+)
+
+// blueprintRelaxations returns the relaxations an admin approved for the blueprint, unknown ones are logged and left out.
+func blueprintRelaxations(b *model.Blueprint) ([]string, error) {
+	var relaxations []string
+	if len(b.SecurityRelaxations) > 0 {
+		err := json.Unmarshal(b.SecurityRelaxations, &relaxations)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan security relaxations from blueprint: %w", err)
+		}
+	}
+
+	known := relaxations[:0]
+	for _, relaxation := range relaxations {
+		if err := blueprint.ValidateRelaxations([]string{relaxation}); err != nil {
+			log.Printf("ignoring security relaxation of blueprint %s: %v\n", b.BID, err)
+			continue
+		}
+		known = append(known, relaxation)
+	}
+
+	return known, nil
+}
+
+// applySecurityProfile applies the node's security profile, loosened by the blueprint's relaxations, to the host config
+// of a server or setup container. Setup containers keep a writable root filesystem, setup scripts usually install
+// packages.
+func applySecurityProfile(hc *container.HostConfig, b *model.Blueprint, setup bool) error {
+	relaxations, err := blueprintRelaxations(b)
+	if err != nil {
+		return err
+	}
+
+	capabilities := config.ConfigInstance.GetCapabilities()
+	for _, relaxation := range relaxations {
+		if capability, ok := blueprint.RelaxationCapability(relaxation); ok {
+			capabilities = append(capabilities, capability)
+		}
+	}
+	hc.CapDrop = []string{"ALL"}
+	hc.CapAdd = nil
+	for _, capability := range capabilities {
+		capability = strings.TrimPrefix(strings.ToUpper(capability), "CAP_")
+		if !slices.Contains(hc.CapAdd, capability) {
+			hc.CapAdd = append(hc.CapAdd, capability)
+		}
+	}
+
+	if !config.ConfigInstance.GetAllowNewPrivileges() && !slices.Contains(relaxations, blueprint.RelaxationNewPrivileges) {
+		hc.SecurityOpt = append(hc.SecurityOpt, "no-new-privileges:true")
+	}
+
+	switch profile := config.ConfigInstance.GetSeccompProfile(); profile {
+	case "":
+	case "unconfined":
+		hc.SecurityOpt = append(hc.SecurityOpt, "seccomp=unconfined")
+	default:
+		// the docker API takes the profile itself, the CLI reads the file the same way
+		data, err := os.ReadFile(profile)
+		if err != nil {
+			return fmt.Errorf("failed to read seccomp profile: %w", err)
+		}
+		hc.SecurityOpt = append(hc.SecurityOpt, "seccomp="+string(data))
+	}
+
+	if profile := config.ConfigInstance.GetAppArmorProfile(); profile != "" {
+		hc.SecurityOpt = append(hc.SecurityOpt, "apparmor="+profile)
+	}
+
+	if !slices.Contains(relaxations, blueprint.RelaxationUnlimitedPids) {
+		limit := config.ConfigInstance.GetPidsLimit()
+		hc.Resources.PidsLimit = &limit
+	}
+
+	if !setup && !config.ConfigInstance.GetWritableRootFilesystem() && !slices.Contains(relaxations, blueprint.RelaxationWritableRootfs) {
+		hc.ReadonlyRootfs = true
+		options := fmt.Sprintf("rw,nosuid,nodev,size=%dm", config.ConfigInstance.GetTmpfsSize())
+		hc.Tmpfs = map[string]string{
+			"/tmp": options,
+			"/run": options,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"log"
+	"panelium/daemon/internal/config"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/model"
+	"panelium/proto_gen_go/daemon"
+	"regexp"
+	"time"
+)
+
+var ansiEscape = regexp.MustCompile("\x1b\\[[0-9;?]*[ -/]*[@-~]")
+
+// startupDonePattern returns the compiled startup done pattern of the blueprint, or nil if the server should be
+// considered online as soon as its container is running.
+func startupDonePattern(bid string) *regexp.Regexp {
+	var blueprint model.Blueprint
+	tx := db.Instance().First(&blueprint, "bid = ?", bid)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		log.Printf("failed to find blueprint with ID %s: %v\n", bid, tx.Error)
+		return nil
+	}
+
+	if blueprint.StartupDonePattern == "" {
+		return nil
+	}
+
+	pattern, err := regexp.Compile(blueprint.StartupDonePattern)
+	if err != nil {
+		log.Printf("invalid startup done pattern in blueprint %s: %v\n", bid, err)
+		return nil
+	}
+
+	return pattern
+}
+
+// startupWatch waits for the startup done pattern in the console output. The console matches every line it reads
+// against it, before lines are suppressed as a flood or dropped for viewers that fall behind.
+type startupWatch struct {
+	pattern *regexp.Regexp
+	done    chan bool // receives true once the pattern matched, false if the console is closed first
+}
+
+// watchStartup starts matching the console output against the pattern. The returned function stops it, it has to be
+// called once the caller is done waiting.
+func (c *consoleSession) watchStartup(pattern *regexp.Regexp) (<-chan bool, func()) {
+	w := &startupWatch{
+		pattern: pattern,
+		done:    make(chan bool, 1),
+	}
+
+	c.mu.Lock()
+	c.startup = w
+	c.mu.Unlock()
+
+	return w.done, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if c.startup == w {
+			c.startup = nil
+		}
+	}
+}
+
+// matchStartup reports whether the line matches the startup done pattern being waited for, if any.
+func (c *consoleSession) matchStartup(text string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.startup == nil || !c.startup.pattern.MatchString(ansiEscape.ReplaceAllString(text, "")) {
+		return false
+	}
+
+	c.startup.done <- true
+	c.startup = nil
+	return true
+}
+
+// waitForStartup waits until the startup done pattern matches or the startup timeout passes, then marks the server
+// online. The watch is stopped once it returns.
+func waitForStartup(sid string, matched <-chan bool, stopWatching func()) {
+	defer stopWatching()
+
+	timer := time.NewTimer(config.ConfigInstance.GetStartupTimeout())
+	defer timer.Stop()
+
+	select {
+	case ok := <-matched:
+		if !ok {
+			log.Printf("console of server %s closed before startup finished\n", sid)
+			return
+		}
+	case <-timer.C:
+		log.Printf("server %s did not report startup done within the startup timeout, marking it online\n", sid)
+	}
+
+	markOnline(sid)
+}
+
+func markOnline(sid string) {
+	// only servers that are still starting, a stop issued in the meantime takes precedence
+	_, err := setStatus(sid, []daemon.ServerStatusType{daemon.ServerStatusType_SERVER_STATUS_TYPE_STARTING}, model.Server{
+		Status: daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE,
+	})
+	if err != nil {
+		log.Printf("failed to update server status to online: %v\n", err)
+	}
+}
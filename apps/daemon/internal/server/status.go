@@ -1,18 +1,69 @@
 package server
 
 import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"panelium/daemon/internal/db"
 	"panelium/daemon/internal/model"
+	"panelium/daemon/internal/state"
 	"panelium/proto_gen_go/daemon"
+	"time"
 )
 
 func Status(s *model.Server) *daemon.ServerStatus {
+	return StatusEvent(transition(s, time.Now())).Status
+}
+
+func StatusEvent(t state.Transition) *daemon.ServerStatusEvent {
 	status := daemon.ServerStatus{
-		Status:         s.Status,
-		TimestampStart: timestamppb.New(s.TimestampStart),
-		TimestampEnd:   timestamppb.New(s.TimestampEnd),
-		OfflineReason:  &s.OfflineReason,
+		Status:         t.Status,
+		TimestampStart: timestamppb.New(t.TimestampStart),
+		TimestampEnd:   timestamppb.New(t.TimestampEnd),
+		OfflineReason:  &t.OfflineReason,
+	}
+
+	if t.OfflineReason == daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_ERROR {
+		status.ExitCode = &t.ExitCode
 	}
 
-	return &status
+	return &daemon.ServerStatusEvent{
+		Status:    &status,
+		Timestamp: timestamppb.New(t.Timestamp),
+	}
+}
+
+func WatchStatus(
+	ctx context.Context,
+	sid string,
+	stm *connect.ServerStream[daemon.ServerStatusEvent],
+) error {
+	// subscribe before reading the current status so no transition in between is lost
+	ch, unsubscribe := state.Subscribe(sid)
+	defer unsubscribe()
+
+	var s model.Server
+	tx := db.Instance().First(&s, "sid = ?", sid)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return connect.NewError(connect.CodeNotFound, errors.New("server not found"))
+	}
+
+	if err := stm.Send(StatusEvent(transition(&s, time.Now()))); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case t, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stm.Send(StatusEvent(t)); err != nil {
+				return err
+			}
+		}
+	}
 }
@@ -0,0 +1,228 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"panelium/daemon/internal/config"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/docker"
+	"panelium/daemon/internal/model"
+	"panelium/proto_gen_go/daemon"
+	"sync"
+	"time"
+)
+
+const (
+	StorageBackendProjectQuota = "project_quota"
+	StorageBackendWatcher      = "watcher"
+)
+
+var ErrStorageLimitExceeded = errors.New("the server has reached its storage limit")
+
+// storageBackend limits and measures the data of servers.
+type storageBackend interface {
+	// apply sets the limit of the server's data directory, it is called on every install
+	apply(s *model.Server, dir string) error
+	// release removes the limit of a deleted server
+	release(s *model.Server, dir string) error
+	// usage returns the bytes the server's data takes up on disk
+	usage(s *model.Server, dir string) (int64, error)
+	// enforced tells whether the limit is enforced by the kernel, otherwise the watcher stops servers above it
+	enforced() bool
+}
+
+func currentStorageBackend() storageBackend {
+	switch config.ConfigInstance.GetStorageBackend() {
+	case StorageBackendProjectQuota:
+		return projectQuota{}
+	case StorageBackendWatcher:
+		return watcher{}
+	default:
+		log.Printf("unknown storage backend %s, using the watcher\n", config.ConfigInstance.GetStorageBackend())
+		return watcher{}
+	}
+}
+
+func storageLimit(s *model.Server) int64 {
+	return int64(s.ResourceLimit.Storage) * 1024 * 1024
+}
+
+type storageState struct {
+	used    int64
+	limit   int64
+	updated time.Time
+}
+
+// storageUsage holds the last measured usage per server
+var storageUsage = struct {
+	sync.Mutex
+	m map[string]storageState
+}{m: make(map[string]storageState)}
+
+// measureStorage measures the usage of the server and stores it for the checks of file writes.
+func measureStorage(s *model.Server) (storageState, error) {
+	vol, err := docker.Instance().VolumeInspect(context.Background(), fmt.Sprint("server_", s.SID))
+	if err != nil {
+		return storageState{}, fmt.Errorf("failed to inspect volume: %w", err)
+	}
+
+	used, err := currentStorageBackend().usage(s, vol.Mountpoint)
+	if err != nil {
+		return storageState{}, err
+	}
+
+	state := storageState{
+		used:    used,
+		limit:   storageLimit(s),
+		updated: time.Now(),
+	}
+
+	storageUsage.Lock()
+	storageUsage.m[s.SID] = state
+	storageUsage.Unlock()
+
+	return state, nil
+}
+
+func (s storageState) exceeded() bool {
+	return s.limit > 0 && s.used >= s.limit
+}
+
+// CheckStorageUsage measures the usage of every server. With the watcher servers above their limit are stopped, the
+// kernel enforced backends make writes fail at the limit already.
+func CheckStorageUsage() {
+	var servers []model.Server
+	tx := db.Instance().Find(&servers)
+	if tx.Error != nil {
+		log.Printf("failed to list servers: %v\n", tx.Error)
+		return
+	}
+
+	enforced := currentStorageBackend().enforced()
+	for _, s := range servers {
+		if !s.ContainerExists || s.Status == daemon.ServerStatusType_SERVER_STATUS_TYPE_INSTALLING {
+			continue
+		}
+
+		state, err := measureStorage(&s)
+		if err != nil {
+			log.Printf("failed to measure storage usage of server %s: %v\n", s.SID, err)
+			continue
+		}
+
+		if enforced || !state.exceeded() {
+			continue
+		}
+		if s.Status != daemon.ServerStatusType_SERVER_STATUS_TYPE_STARTING && s.Status != daemon.ServerStatusType_SERVER_STATUS_TYPE_ONLINE {
+			continue
+		}
+
+		log.Printf("stopping server %s as it uses %d MB of its %d MB storage limit\n", s.SID, state.used/1024/1024, state.limit/1024/1024)
+		err = Stop(s.SID, false)
+		var terr *TransitionError
+		if err != nil && !errors.As(err, &terr) {
+			log.Printf("failed to stop server %s above its storage limit: %v\n", s.SID, err)
+		}
+	}
+}
+
+// CheckStorage returns ErrStorageLimitExceeded if writing the given amount of bytes would take the server above its
+// storage limit. Used to refuse file writes through the panel, the usage is measured if it is not known yet. Allowed
+// writes are added to the known usage until the next measurement, so many small writes can't get past the limit.
+func CheckStorage(sid string, size int64) error {
+	storageUsage.Lock()
+	state, ok := storageUsage.m[sid]
+	storageUsage.Unlock()
+
+	if !ok {
+		var s model.Server
+		tx := db.Instance().First(&s, "sid = ?", sid)
+		if tx.Error != nil || tx.RowsAffected == 0 {
+			return fmt.Errorf("failed to find server with ID %s: %w", sid, tx.Error)
+		}
+
+		var err error
+		state, err = measureStorage(&s)
+		if err != nil {
+			return err
+		}
+	}
+
+	if state.limit > 0 && state.used+size > state.limit {
+		return ErrStorageLimitExceeded
+	}
+
+	storageUsage.Lock()
+	if current, ok := storageUsage.m[sid]; ok {
+		current.used += size
+		storageUsage.m[sid] = current
+	}
+	storageUsage.Unlock()
+
+	return nil
+}
+
+// StorageUsage measures the current usage of the server.
+func StorageUsage(s *model.Server) (*daemon.StorageUsage, error) {
+	state, err := measureStorage(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &daemon.StorageUsage{
+		UsedBytes:  state.used,
+		LimitBytes: state.limit,
+		Exceeded:   state.exceeded(),
+		Backend:    config.ConfigInstance.GetStorageBackend(),
+	}, nil
+}
+
+// applyStorageLimit sets the storage limit of the server on its data directory.
+func applyStorageLimit(s *model.Server, dir string) error {
+	err := currentStorageBackend().apply(s, dir)
+	if err != nil {
+		return fmt.Errorf("failed to apply storage limit: %w", err)
+	}
+
+	return nil
+}
+
+// releaseStorageLimit removes the storage limit of a deleted server.
+func releaseStorageLimit(s *model.Server, dir string) error {
+	storageUsage.Lock()
+	delete(storageUsage.m, s.SID)
+	storageUsage.Unlock()
+
+	err := currentStorageBackend().release(s, dir)
+	if err != nil {
+		return fmt.Errorf("failed to release storage limit: %w", err)
+	}
+
+	return nil
+}
+
+// watcher measures the usage with the server's disk usage tracker, nothing stops a write before the next check.
+type watcher struct{}
+
+func (watcher) apply(*model.Server, string) error {
+	return nil
+}
+
+func (watcher) release(*model.Server, string) error {
+	return nil
+}
+
+func (watcher) usage(s *model.Server, _ string) (int64, error) {
+	tracker, err := DiskUsage(s.SID)
+	if err != nil {
+		return 0, err
+	}
+
+	return tracker.Usage(), nil
+}
+
+func (watcher) enforced() bool {
+	return false
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"fmt"
+	"os/exec"
+	"panelium/daemon/internal/config"
+	"panelium/daemon/internal/model"
+	"strconv"
+	"strings"
+)
+
+// projectQuota limits the data directory with a project quota, so writes fail once the limit is reached. The volumes
+// have to be on an XFS filesystem mounted with prjquota or an ext4 filesystem with the project and quota features
+// enabled and mounted with prjquota.
+type projectQuota struct{}
+
+func projectId(s *model.Server) string {
+	return fmt.Sprint(uint(config.ConfigInstance.GetStorageProjectIdBase()) + s.ID)
+}
+
+// filesystem returns the mount point and type of the filesystem dir is on.
+func filesystem(dir string) (string, string, error) {
+	out, err := run("findmnt", "-n", "-o", "TARGET,FSTYPE", "--target", dir)
+	if err != nil {
+		return "", "", err
+	}
+
+	fields := strings.Fields(out)
+	if len(fields) != 2 {
+		return "", "", fmt.Errorf("unexpected findmnt output %q", out)
+	}
+
+	return fields[0], fields[1], nil
+}
+
+func (projectQuota) apply(s *model.Server, dir string) error {
+	mount, fsType, err := filesystem(dir)
+	if err != nil {
+		return err
+	}
+
+	id := projectId(s)
+	limitKB := fmt.Sprint(storageLimit(s) / 1024) // 0 removes the limit
+
+	switch fsType {
+	case "xfs":
+		_, err = run("xfs_quota", "-x", "-c", fmt.Sprintf("project -s -p %s %s", dir, id), mount)
+		if err != nil {
+			return err
+		}
+		_, err = run("xfs_quota", "-x", "-c", fmt.Sprintf("limit -p bhard=%sk %s", limitKB, id), mount)
+		return err
+	case "ext4":
+		_, err = run("chattr", "-R", "+P", "-p", id, dir)
+		if err != nil {
+			return err
+		}
+		_, err = run("setquota", "-P", id, "0", limitKB, "0", "0", mount)
+		return err
+	default:
+		return fmt.Errorf("project quotas are not supported on %s filesystems", fsType)
+	}
+}
+
+func (projectQuota) release(s *model.Server, dir string) error {
+	mount, fsType, err := filesystem(dir)
+	if err != nil {
+		return err
+	}
+
+	id := projectId(s)
+	switch fsType {
+	case "xfs":
+		_, err = run("xfs_quota", "-x", "-c", fmt.Sprintf("limit -p bhard=0 %s", id), mount)
+	case "ext4":
+		_, err = run("setquota", "-P", id, "0", "0", "0", "0", mount)
+	default:
+		err = fmt.Errorf("project quotas are not supported on %s filesystems", fsType)
+	}
+
+	return err
+}
+
+func (projectQuota) usage(s *model.Server, dir string) (int64, error) {
+	mount, fsType, err := filesystem(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	id := projectId(s)
+	var out string
+	switch fsType {
+	case "xfs":
+		// prints: <device> <used KB> <soft> <hard> <warnings> <grace>
+		out, err = run("xfs_quota", "-x", "-c", fmt.Sprintf("quota -p -N -b %s", id), mount)
+	case "ext4":
+		// prints a header followed by: <device> <used KB> <soft> <hard> <grace> <files> ...
+		out, err = run("quota", "-P", "-w", "-p", "-f", mount, id)
+		if err == nil {
+			lines := strings.Split(out, "\n")
+			out = lines[len(lines)-1]
+			if len(lines) < 3 {
+				out = ""
+			}
+		}
+	default:
+		err = fmt.Errorf("project quotas are not supported on %s filesystems", fsType)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	fields := strings.Fields(out)
+	if len(fields) < 2 {
+		// no usage recorded for the project yet
+		return 0, nil
+	}
+
+	usedKB, err := strconv.ParseInt(strings.TrimSuffix(fields[1], "*"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected quota output %q", out)
+	}
+
+	return usedKB * 1024, nil
+}
+
+func (projectQuota) enforced() bool {
+	return true
+}
+
+func run(name string, args ...string) (string, error) {
+	out, err := exec.Command(name, args...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%s failed: %w: %s", name, err, strings.TrimSpace(string(out)))
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"errors"
+	"fmt"
+	"log"
+	"panelium/daemon/internal/config"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/model"
+	"panelium/daemon/internal/sync"
+	"panelium/proto_gen_go/backend"
+)
+
+// SyncServers brings the stored servers in line with the servers the backend has assigned to this node. Missing servers
+// are created, changed ones are updated and servers the backend no longer knows are quarantined, or deleted if
+// configured so.
+func SyncServers() error {
+	servers, err := sync.SyncServers()
+	if err != nil {
+		return fmt.Errorf("failed to fetch servers from backend: %w", err)
+	}
+
+	var stored []model.Server
+	tx := db.Instance().Find(&stored)
+	if tx.Error != nil {
+		return fmt.Errorf("failed to list servers: %w", tx.Error)
+	}
+
+	existing := make(map[string]model.Server, len(stored))
+	for _, s := range stored {
+		existing[s.SID] = s
+	}
+
+	known := make(map[string]bool, len(servers))
+	for _, s := range servers {
+		known[s.Sid] = true
+
+		err := syncServer(s, existing)
+		if err != nil {
+			log.Printf("failed to sync server %s: %v\n", s.Sid, err)
+		}
+	}
+
+	for _, s := range stored {
+		if known[s.SID] {
+			continue
+		}
+
+		err := removeUnknownServer(&s)
+		if err != nil {
+			log.Printf("failed to remove unknown server %s: %v\n", s.SID, err)
+		}
+	}
+
+	return nil
+}
+
+func syncServer(s *backend.Server, existing map[string]model.Server) error {
+	allocations := make([]model.ServerAllocation, len(s.Allocations))
+	for i, alloc := range s.Allocations {
+		if alloc.Port < 1024 || alloc.Port > 65535 {
+			return fmt.Errorf("port %d is out of range (1024-65535)", alloc.Port)
+		}
+
+		allocations[i] = model.ServerAllocation{
+			IP:   alloc.Ip,
+			Port: uint16(alloc.Port),
+		}
+	}
+
+	resourceLimit := model.ResourceLimit{}
+	if s.ResourceLimit != nil {
+		resourceLimit = model.ResourceLimit{
+			CPU:     s.ResourceLimit.Cpu,
+			RAM:     s.ResourceLimit.Ram,
+			SWAP:    s.ResourceLimit.Swap,
+			Storage: s.ResourceLimit.Storage,
+		}
+	}
+
+	current, ok := existing[s.Sid]
+	if !ok {
+		log.Printf("creating server %s assigned by the backend\n", s.Sid)
+		_, err := CreateServer(s.Sid, s.OwnerId, s.UserIds, allocations, resourceLimit, s.DockerImage, s.Bid, s.RestartPolicy, s.Variables)
+		return err
+	}
+
+	if current.Quarantined {
+		log.Printf("server %s is known to the backend again, lifting its quarantine\n", s.Sid)
+		tx := db.Instance().Model(&model.Server{}).Where("sid = ?", s.Sid).Update("quarantined", false)
+		if tx.Error != nil {
+			return fmt.Errorf("failed to lift quarantine: %w", tx.Error)
+		}
+	}
+
+	return UpdateServer(s.Sid, &s.UserIds, &allocations, &resourceLimit, &s.DockerImage, &s.Bid, &s.RestartPolicy, &s.Variables)
+}
+
+// removeUnknownServer deletes or quarantines a server missing from the backend's list, after confirming with the
+// backend that it really does not know the server anymore.
+func removeUnknownServer(s *model.Server) error {
+	_, err := sync.GetServer(s.SID)
+	if err == nil {
+		return nil
+	}
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		return fmt.Errorf("failed to confirm server is unknown to the backend: %w", err)
+	}
+
+	if config.ConfigInstance.GetRemoveUnknownServers() {
+		log.Printf("deleting server %s as the backend no longer knows it\n", s.SID)
+		return DeleteServer(s.SID, true)
+	}
+
+	if s.Quarantined {
+		return nil
+	}
+
+	log.Printf("quarantining server %s as the backend no longer knows it\n", s.SID)
+	tx := db.Instance().Model(&model.Server{}).Where("sid = ?", s.SID).Update("quarantined", true)
+	if tx.Error != nil {
+		return fmt.Errorf("failed to quarantine server: %w", tx.Error)
+	}
+
+	err = Stop(s.SID, false)
+	var terr *TransitionError
+	if err != nil && !errors.As(err, &terr) {
+		return fmt.Errorf("failed to stop quarantined server: %w", err)
+	}
+
+	return nil
+}
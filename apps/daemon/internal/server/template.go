@@ -0,0 +1,94 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"panelium/common/blueprint"
+	"panelium/daemon/internal/model"
+	"panelium/proto_gen_go"
+	"strings"
+)
+
+// templateContext holds the values available to the blueprint's templates, the same values are passed to the setup
+// script and the server container as environment variables.
+type templateContext struct {
+	values map[string]string
+	env    []string // KEY=value in a stable order
+}
+
+// set adds a value to the context, every key is set only once
+func (c *templateContext) set(key string, value string) {
+	c.values[key] = value
+	c.env = append(c.env, key+"="+value)
+}
+
+// newTemplateContext builds the context of the server from its SID, limits, allocations and the values of the
+// blueprint's variables. portEnv are the port variables returned by portMappings.
+func newTemplateContext(s *model.Server, b *model.Blueprint, portEnv []string) (*templateContext, error) {
+	c := &templateContext{values: make(map[string]string)}
+
+	c.set("SID", s.SID)
+	c.set("SERVER_BINARY", b.ServerBinary)
+	c.set("SERVER_MEMORY", fmt.Sprint(s.ResourceLimit.RAM))
+	c.set("SERVER_SWAP", fmt.Sprint(s.ResourceLimit.SWAP))
+	c.set("SERVER_CPU", fmt.Sprint(s.ResourceLimit.CPU))
+	c.set("SERVER_STORAGE", fmt.Sprint(s.ResourceLimit.Storage))
+	if len(s.Allocations) > 0 {
+		c.set("SERVER_IP", s.Allocations[0].IP)
+		c.set("SERVER_PUBLIC_PORT", fmt.Sprint(s.Allocations[0].Port))
+	}
+
+	for _, e := range portEnv {
+		key, value, _ := strings.Cut(e, "=")
+		c.set(key, value)
+	}
+
+	var variables []*proto_gen_go.BlueprintVariable
+	if len(b.Variables) > 0 {
+		err := json.Unmarshal(b.Variables, &variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan variables from blueprint: %w", err)
+		}
+	}
+
+	var values []*proto_gen_go.ServerVariable
+	if len(s.Variables) > 0 {
+		err := json.Unmarshal(s.Variables, &values)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan variables from server: %w", err)
+		}
+	}
+
+	resolved, err := blueprint.ResolveVariables(variables, values)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range resolved {
+		// reserved keys are rejected by the backend, checked again so a variable can never replace a daemon value
+		if blueprint.IsReservedKey(v.EnvKey) {
+			return nil, fmt.Errorf("variable %s uses a reserved key", v.EnvKey)
+		}
+		c.set(v.EnvKey, v.Value)
+	}
+
+	return c, nil
+}
+
+func (c *templateContext) lookup(key string) (string, bool) {
+	value, ok := c.values[key]
+	return value, ok
+}
+
+// command returns the container command running the blueprint's start command. With an entrypoint shell the command
+// is left to the shell, which reads the values from the environment, otherwise it is split into its arguments here.
+func (c *templateContext) command(b *model.Blueprint) ([]string, error) {
+	if b.EntrypointShell != "" {
+		script, err := blueprint.ShellCommand(b.StartCommand)
+		if err != nil {
+			return nil, err
+		}
+		return []string{b.EntrypointShell, "-c", script}, nil
+	}
+
+	return blueprint.SplitCommand(b.StartCommand, c.lookup)
+}
@@ -0,0 +1,532 @@
+package server
+
+import (
+	"bufio"
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"log"
+	"panelium/common/id"
+	"panelium/daemon/internal/config"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/docker"
+	"panelium/daemon/internal/model"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	terminalScrollback       = 64 * 1024 // bytes of recent output sent to streams attaching to a session
+	terminalSubscriberBuffer = 256
+	terminalReadBuffer       = 32 * 1024
+)
+
+// shells tried in order when the blueprint doesn't declare one
+var terminalShells = []string{"bash", "sh", "ash"}
+
+var ErrTerminalSessionNotFound = errors.New("terminal session not found")
+
+// terminalSession is a shell with a tty in a server's container. It outlives the streams attached to it, so a client
+// can attach again after reconnecting, until the shell exits, it is killed or it was idle for too long.
+type terminalSession struct {
+	id      string
+	sid     string
+	owner   string // user ID of the user who started it
+	shell   string
+	pid     int // of the shell in the container's PID namespace
+	eid     string
+	conn    *types.HijackedResponse
+	created time.Time
+	line    bool          // started by the line based Terminal and TerminalCommand calls
+	done    chan struct{} // closed once the session ended
+
+	writeMu sync.Mutex
+
+	mu           sync.Mutex
+	lastActivity time.Time
+	scrollback   []byte
+	subscribers  map[chan []byte]struct{}
+	exitCode     *int32 // set once the session ended if the shell exited on its own
+}
+
+var terminalSessions = struct {
+	sync.Mutex
+	m map[string]*terminalSession
+}{m: make(map[string]*terminalSession)}
+
+// detected shell per server, dropped when the container is recreated
+var terminalShellCache = struct {
+	sync.Mutex
+	m map[string]string
+}{m: make(map[string]string)}
+
+// terminalExecUser returns who runs the terminal sessions: the container's user unless the node allows privileged ones.
+func terminalExecUser() (string, bool) {
+	if config.ConfigInstance.GetTerminalPrivileged() {
+		return "root", true
+	}
+	return "", false
+}
+
+// terminalShell returns the shell declared by the server's blueprint, or the first shell found in its container.
+func terminalShell(sid string) (string, error) {
+	var s model.Server
+	tx := db.Instance().First(&s, "sid = ?", sid)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return "", fmt.Errorf("failed to find server %s: %w", sid, tx.Error)
+	}
+	var b model.Blueprint
+	tx = db.Instance().First(&b, "bid = ?", s.BID)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return "", fmt.Errorf("failed to find blueprint %s: %w", s.BID, tx.Error)
+	}
+	if b.TerminalShell != "" {
+		return b.TerminalShell, nil
+	}
+
+	terminalShellCache.Lock()
+	shell, ok := terminalShellCache.m[sid]
+	terminalShellCache.Unlock()
+	if ok {
+		return shell, nil
+	}
+
+	for _, shell := range terminalShells {
+		found, err := probeShell(sid, shell)
+		if err != nil {
+			return "", err
+		}
+		if found {
+			terminalShellCache.Lock()
+			terminalShellCache.m[sid] = shell
+			terminalShellCache.Unlock()
+			return shell, nil
+		}
+	}
+
+	return "", errors.New("no shell found in the server container")
+}
+
+// runTerminalExec runs cmd in the server's container as the user of the terminal sessions and returns whether it exited
+// successfully.
+func runTerminalExec(sid string, cmd []string) (bool, error) {
+	user, privileged := terminalExecUser()
+	eid, err := docker.Instance().ContainerExecCreate(context.Background(), fmt.Sprint("server_", sid), container.ExecOptions{
+		User:         user,
+		Privileged:   privileged,
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          cmd,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create exec instance: %w", err)
+	}
+
+	conn, err := docker.Instance().ContainerExecAttach(context.Background(), eid.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to attach to exec instance: %w", err)
+	}
+	_, _ = io.Copy(io.Discard, conn.Reader)
+	conn.Close()
+
+	inspect, err := docker.Instance().ContainerExecInspect(context.Background(), eid.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to inspect exec instance: %w", err)
+	}
+
+	return !inspect.Running && inspect.ExitCode == 0, nil
+}
+
+// probeShell reports whether the shell can be run in the server's container.
+func probeShell(sid string, shell string) (bool, error) {
+	return runTerminalExec(sid, []string{shell, "-c", "exit 0"})
+}
+
+// startTerminalSession starts a shell with a tty in the server's container for owner.
+func startTerminalSession(sid string, owner string, size *daemon.PtySize, line bool) (*terminalSession, error) {
+	shell, err := terminalShell(sid)
+	if err != nil {
+		return nil, err
+	}
+
+	user, privileged := terminalExecUser()
+	eid, err := docker.Instance().ContainerExecCreate(context.Background(), fmt.Sprint("server_", sid), container.ExecOptions{
+		User:         user,
+		Privileged:   privileged,
+		Tty:          true,
+		ConsoleSize:  ptyConsoleSize(size),
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		Env:          []string{"TERM=xterm-256color"}, // what xterm.js emulates
+		// the shell prints its PID first, docker only knows the host PID, which can't be signalled from the daemon's
+		// container, so it is killed from within the server container
+		Cmd: []string{shell, "-c", `echo $$; exec "$0"`, shell},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create exec instance: %w", err)
+	}
+
+	conn, err := docker.Instance().ContainerExecAttach(context.Background(), eid.ID, container.ExecAttachOptions{
+		Tty:         true,
+		ConsoleSize: ptyConsoleSize(size),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach to exec instance: %w", err)
+	}
+
+	first, err := conn.Reader.ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to read terminal shell PID: %w", err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(first))
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to parse terminal shell PID %q: %w", first, err)
+	}
+
+	tid, err := id.New()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to generate terminal session ID: %w", err)
+	}
+
+	now := time.Now()
+	t := &terminalSession{
+		id:           tid,
+		sid:          sid,
+		owner:        owner,
+		shell:        shell,
+		pid:          pid,
+		eid:          eid.ID,
+		conn:         &conn,
+		created:      now,
+		line:         line,
+		done:         make(chan struct{}),
+		lastActivity: now,
+		subscribers:  make(map[chan []byte]struct{}),
+	}
+
+	terminalSessions.Lock()
+	terminalSessions.m[tid] = t
+	terminalSessions.Unlock()
+
+	go t.read()
+
+	return t, nil
+}
+
+// read receives the output of the shell until it exits or the session is killed.
+func (t *terminalSession) read() {
+	buf := make([]byte, terminalReadBuffer)
+	for {
+		n, err := t.conn.Reader.Read(buf)
+		if n > 0 {
+			t.publish(buf[:n])
+		}
+		if err != nil {
+			break
+		}
+	}
+	t.conn.Close()
+
+	var exitCode *int32
+	inspect, err := docker.Instance().ContainerExecInspect(context.Background(), t.eid)
+	if err == nil && !inspect.Running {
+		code := int32(inspect.ExitCode)
+		exitCode = &code
+	}
+
+	terminalSessions.Lock()
+	delete(terminalSessions.m, t.id)
+	terminalSessions.Unlock()
+
+	t.mu.Lock()
+	t.exitCode = exitCode
+	for ch := range t.subscribers {
+		delete(t.subscribers, ch)
+		close(ch)
+	}
+	close(t.done)
+	t.mu.Unlock()
+}
+
+// publish adds the output to the scrollback and sends it to all attached streams. It never blocks, streams that fall
+// too far behind miss output.
+func (t *terminalSession) publish(data []byte) {
+	data = append([]byte(nil), data...) // the read buffer is reused
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.lastActivity = time.Now()
+	t.scrollback = append(t.scrollback, data...)
+	if over := len(t.scrollback) - terminalScrollback; over > 0 {
+		t.scrollback = append([]byte(nil), t.scrollback[over:]...)
+	}
+	for ch := range t.subscribers {
+		select {
+		case ch <- data:
+		default:
+		}
+	}
+}
+
+// subscribe returns the recent output and a channel receiving the following output, which is closed once the session
+// ended. The returned function has to be called once the stream is done.
+func (t *terminalSession) subscribe() ([]byte, <-chan []byte, func()) {
+	ch := make(chan []byte, terminalSubscriberBuffer)
+
+	t.mu.Lock()
+	scrollback := append([]byte(nil), t.scrollback...)
+	select {
+	case <-t.done:
+		close(ch)
+	default:
+		t.subscribers[ch] = struct{}{}
+	}
+	t.mu.Unlock()
+
+	var once sync.Once
+	return scrollback, ch, func() {
+		once.Do(func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			if _, ok := t.subscribers[ch]; ok {
+				delete(t.subscribers, ch)
+				close(ch)
+			}
+		})
+	}
+}
+
+// write sends raw input to the shell.
+func (t *terminalSession) write(data []byte) error {
+	t.mu.Lock()
+	t.lastActivity = time.Now()
+	t.mu.Unlock()
+
+	t.writeMu.Lock()
+	defer t.writeMu.Unlock()
+
+	_, err := t.conn.Conn.Write(data)
+	return err
+}
+
+func (t *terminalSession) resize(size *daemon.PtySize) {
+	s := ptyConsoleSize(size)
+	if s == nil {
+		return
+	}
+
+	err := docker.Instance().ContainerExecResize(context.Background(), t.eid, container.ResizeOptions{Height: s[0], Width: s[1]})
+	if err != nil {
+		log.Printf("failed to resize terminal session %s of server %s: %v\n", t.id, t.sid, err)
+	}
+}
+
+// kill hangs up the shell like closing a terminal window would and ends the session.
+func (t *terminalSession) kill() {
+	inspect, err := docker.Instance().ContainerExecInspect(context.Background(), t.eid)
+	if err == nil && inspect.Running {
+		ok, err := runTerminalExec(t.sid, []string{t.shell, "-c", fmt.Sprint("kill -HUP ", t.pid)})
+		if err == nil && !ok {
+			err = errors.New("kill exited with an error")
+		}
+		if err != nil {
+			log.Printf("failed to hang up terminal session %s of server %s: %v\n", t.id, t.sid, err)
+		}
+	}
+
+	t.conn.Close() // ends read
+}
+
+func (t *terminalSession) info() *daemon.TerminalSession {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return &daemon.TerminalSession{
+		Id:           t.id,
+		ServerId:     t.sid,
+		OwnerId:      t.owner,
+		Shell:        t.shell,
+		Created:      timestamppb.New(t.created),
+		LastActivity: timestamppb.New(t.lastActivity),
+		Attached:     uint32(len(t.subscribers)),
+	}
+}
+
+// terminalSessionOf returns the session if it belongs to the server and owner.
+func terminalSessionOf(tid string, sid string, owner string) (*terminalSession, error) {
+	terminalSessions.Lock()
+	t, ok := terminalSessions.m[tid]
+	terminalSessions.Unlock()
+
+	if !ok || t.sid != sid || t.owner != owner {
+		return nil, ErrTerminalSessionNotFound
+	}
+	return t, nil
+}
+
+// lineTerminalSession returns the owner's session of the line based calls on the server, starting it if needed.
+func lineTerminalSession(sid string, owner string) (*terminalSession, error) {
+	terminalSessions.Lock()
+	for _, t := range terminalSessions.m {
+		if t.line && t.sid == sid && t.owner == owner {
+			terminalSessions.Unlock()
+			return t, nil
+		}
+	}
+	terminalSessions.Unlock()
+
+	return startTerminalSession(sid, owner, nil, true)
+}
+
+// TerminalSessions returns the sessions matching the filter.
+func TerminalSessions(filter func(sid string, owner string) bool) []*daemon.TerminalSession {
+	terminalSessions.Lock()
+	var matching []*terminalSession
+	for _, t := range terminalSessions.m {
+		if filter(t.sid, t.owner) {
+			matching = append(matching, t)
+		}
+	}
+	terminalSessions.Unlock()
+
+	sessions := make([]*daemon.TerminalSession, 0, len(matching))
+	for _, t := range matching {
+		sessions = append(sessions, t.info())
+	}
+	return sessions
+}
+
+// KillTerminalSession ends the session regardless of its owner.
+func KillTerminalSession(tid string) error {
+	terminalSessions.Lock()
+	t, ok := terminalSessions.m[tid]
+	terminalSessions.Unlock()
+	if !ok {
+		return ErrTerminalSessionNotFound
+	}
+
+	t.kill()
+	return nil
+}
+
+// CloseIdleTerminalSessions ends the terminal sessions without input or output for longer than the idle timeout.
+func CloseIdleTerminalSessions() {
+	timeout := config.ConfigInstance.GetTerminalIdleTimeout()
+
+	terminalSessions.Lock()
+	var idle []*terminalSession
+	for _, t := range terminalSessions.m {
+		t.mu.Lock()
+		if time.Since(t.lastActivity) >= timeout {
+			idle = append(idle, t)
+		}
+		t.mu.Unlock()
+	}
+	terminalSessions.Unlock()
+
+	for _, t := range idle {
+		log.Printf("closing idle terminal session %s of server %s\n", t.id, t.sid)
+		t.kill()
+	}
+}
+
+// closeTerminalSessions ends all sessions of a server whose container is removed.
+func closeTerminalSessions(sid string) {
+	terminalSessions.Lock()
+	var closing []*terminalSession
+	for _, t := range terminalSessions.m {
+		if t.sid == sid {
+			closing = append(closing, t)
+		}
+	}
+	terminalSessions.Unlock()
+
+	for _, t := range closing {
+		t.kill()
+	}
+
+	terminalShellCache.Lock()
+	delete(terminalShellCache.m, sid)
+	terminalShellCache.Unlock()
+}
+
+// Terminal streams the output of the owner's line based session on the server line by line until ctx is done.
+func Terminal(
+	ctx context.Context,
+	sid string,
+	owner string,
+	stm *connect.ServerStream[proto_gen_go.SimpleMessage],
+) error {
+	t, err := lineTerminalSession(sid, owner)
+	if err != nil {
+		log.Printf("failed to start terminal of server %s: %v\n", sid, err)
+		return connect.NewError(connect.CodeInternal, errors.New("failed to create container terminal"))
+	}
+
+	_, ch, unsubscribe := t.subscribe()
+	defer unsubscribe()
+
+	pr, pw := io.Pipe()
+	go func() {
+		defer pw.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case data, ok := <-ch:
+				if !ok {
+					return
+				}
+				if _, err := pw.Write(data); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	defer pr.Close()
+
+	scanner := bufio.NewScanner(pr)
+	for scanner.Scan() {
+		line := fmt.Sprintf("[%s] %s", time.Now().Format(time.TimeOnly), strings.TrimSuffix(scanner.Text(), "\r"))
+		if err := stm.Send(&proto_gen_go.SimpleMessage{Text: line}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TerminalCommand writes a line to the owner's line based session on the server.
+func TerminalCommand(sid string, owner string, command string) error {
+	if command == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("command cannot be empty"))
+	}
+
+	t, err := lineTerminalSession(sid, owner)
+	if err != nil {
+		log.Printf("failed to start terminal of server %s: %v\n", sid, err)
+		return connect.NewError(connect.CodeInternal, errors.New("failed to create container terminal"))
+	}
+
+	err = t.write([]byte(command + "\n"))
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.New("failed to write command to container terminal"))
+	}
+
+	return nil
+}
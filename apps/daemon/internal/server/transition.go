@@ -0,0 +1,57 @@
+package server
+
+import (
+	"log"
+	"panelium/daemon/internal/db"
+	"panelium/daemon/internal/model"
+	"panelium/daemon/internal/state"
+	"panelium/proto_gen_go/daemon"
+	"sync"
+	"time"
+)
+
+// statusLock keeps the stored status and the published transitions in the same order
+var statusLock sync.Mutex
+
+// setStatus applies the update to the server and publishes the resulting state on the state bus. If from is not empty,
+// the server is only updated while its current status is one of them. It reports whether the server was updated.
+// update is a model.Server, or a map of columns when zero values like the unknown status have to be written.
+func setStatus(sid string, from []daemon.ServerStatusType, update any) (bool, error) {
+	statusLock.Lock()
+	defer statusLock.Unlock()
+
+	tx := db.Instance().Model(&model.Server{}).Where("sid = ?", sid)
+	if len(from) > 0 {
+		tx = tx.Where("status IN ?", from)
+	}
+	tx = tx.Updates(update)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return false, nil
+	}
+
+	var s model.Server
+	tx = db.Instance().First(&s, "sid = ?", sid)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		log.Printf("failed to load server %s to publish its status: %v\n", sid, tx.Error)
+		return true, nil
+	}
+
+	state.Publish(transition(&s, time.Now()))
+
+	return true, nil
+}
+
+func transition(s *model.Server, timestamp time.Time) state.Transition {
+	return state.Transition{
+		SID:            s.SID,
+		Status:         s.Status,
+		OfflineReason:  s.OfflineReason,
+		ExitCode:       s.ExitCode,
+		TimestampStart: s.TimestampStart,
+		TimestampEnd:   s.TimestampEnd,
+		Timestamp:      timestamp,
+	}
+}
@@ -3,26 +3,81 @@ package server
 import (
 	"encoding/json"
 	"fmt"
+	"gorm.io/gorm"
 	"log"
+	"panelium/common/util"
 	"panelium/daemon/internal/db"
 	"panelium/daemon/internal/model"
 	"panelium/daemon/internal/sync"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/daemon"
 	"slices"
 )
 
-func UpdateServer(sid string, userIds *[]string, allocations *[]model.ServerAllocation, resourceLimit *model.ResourceLimit, dockerImage *string, bid *string) error {
+// UpdateServer applies the given changes, nil values are left as they are. Changes to the allocations, resource limit,
+// docker image, blueprint or variables recreate the server container, a running server is stopped for that and started
+// again.
+func UpdateServer(sid string, userIds *[]string, allocations *[]model.ServerAllocation, resourceLimit *model.ResourceLimit, dockerImage *string, bid *string, restartPolicy *proto_gen_go.RestartPolicy, variables *[]*proto_gen_go.ServerVariable) error {
+	unlock := lockServer(sid)
+	defer unlock()
+
 	server := model.Server{}
-	tx := db.Instance().First(&server, "s.SID = ?", sid)
+	tx := db.Instance().Preload("Users").Preload("Allocations", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("id")
+	}).First(&server, "sid = ?", sid)
 	if tx.Error != nil || tx.RowsAffected == 0 {
 		return fmt.Errorf("failed to find server with ID %s: %w", sid, tx.Error)
 	}
 
-	err := sync.SyncBlueprints()
-	if err != nil {
-		log.Printf("failed to sync blueprints: %v", err)
+	usersChanged := userIds != nil && !sameUsers(server.Users, *userIds)
+	allocationsChanged := allocations != nil && !sameAllocations(server.Allocations, *allocations)
+	resourceLimitChanged := resourceLimit != nil && *resourceLimit != server.ResourceLimit
+
+	newBid := server.BID
+	if bid != nil {
+		newBid = *bid
+	}
+	newDockerImage := server.DockerImage
+	if dockerImage != nil {
+		newDockerImage = *dockerImage
+	}
+	imageChanged := newBid != server.BID || newDockerImage != server.DockerImage
+
+	variablesChanged := variables != nil && !sameVariables(server.Variables, *variables)
+
+	reinstall := allocationsChanged || resourceLimitChanged || imageChanged || variablesChanged
+	if reinstall {
+		// checked before anything is written, so a rejected update leaves the server untouched
+		err := checkTransition(sid, server.Status, OperationReinstall)
+		if err != nil {
+			return err
+		}
+	}
+
+	if imageChanged {
+		err := sync.SyncBlueprints()
+		if err != nil {
+			log.Printf("failed to sync blueprints: %v", err)
+		}
+
+		blueprint := model.Blueprint{}
+		tx := db.Instance().First(&blueprint, "bid = ?", newBid)
+		if tx.Error != nil || tx.RowsAffected == 0 {
+			return fmt.Errorf("failed to find blueprint with ID %s: %w", newBid, tx.Error)
+		}
+
+		var dockerImages []string
+		err = json.Unmarshal(blueprint.DockerImages, &dockerImages)
+		if err != nil {
+			return fmt.Errorf("failed to scan docker images from blueprint: %w", err)
+		}
+
+		if !slices.Contains(dockerImages, newDockerImage) {
+			return fmt.Errorf("docker image %s is not allowed by the blueprint %s", newDockerImage, newBid)
+		}
 	}
 
-	if userIds != nil {
+	if usersChanged {
 		tx := db.Instance().Delete(&model.ServerUser{}, "sid = ?", sid)
 		if tx.Error != nil {
 			return fmt.Errorf("failed to delete existing server users: %w", tx.Error)
@@ -41,7 +96,7 @@ func UpdateServer(sid string, userIds *[]string, allocations *[]model.ServerAllo
 			}
 		}
 	}
-	if allocations != nil {
+	if allocationsChanged {
 		tx := db.Instance().Unscoped().Delete(&model.ServerAllocation{}, "sid = ?", sid)
 		if tx.Error != nil {
 			return fmt.Errorf("failed to delete existing server allocations: %w", tx.Error)
@@ -62,7 +117,7 @@ func UpdateServer(sid string, userIds *[]string, allocations *[]model.ServerAllo
 			}
 		}
 	}
-	if resourceLimit != nil {
+	if resourceLimitChanged {
 		tx := db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Updates(model.Server{
 			ResourceLimit: *resourceLimit,
 		})
@@ -70,72 +125,146 @@ func UpdateServer(sid string, userIds *[]string, allocations *[]model.ServerAllo
 			return fmt.Errorf("failed to update resource limit: %w", tx.Error)
 		}
 	}
-	if bid != nil {
-		blueprint := model.Blueprint{}
-		tx := db.Instance().First(&blueprint, "bid = ?", bid)
-		if tx.Error != nil || tx.RowsAffected == 0 {
-			return fmt.Errorf("failed to find blueprint with ID %s: %w", bid, tx.Error)
+	if restartPolicy != nil && *restartPolicy != server.RestartPolicy {
+		tx := db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Update("restart_policy", *restartPolicy)
+		if tx.Error != nil {
+			return fmt.Errorf("failed to update restart policy: %w", tx.Error)
 		}
-
-		var dockerImages []string
-		err := json.Unmarshal(blueprint.DockerImages, &dockerImages)
+	}
+	if variablesChanged {
+		variablesJson, err := json.Marshal(*variables)
 		if err != nil {
-			return fmt.Errorf("failed to scan docker images from blueprint: %w", err)
-		}
-
-		if dockerImage == nil {
-			server := model.Server{}
-			tx = db.Instance().First(&server, "s.SID = ?", sid)
-			if tx.Error != nil || tx.RowsAffected == 0 {
-				return fmt.Errorf("failed to find server with ID %s: %w", sid, tx.Error)
-			}
-			dockerImage = &server.DockerImage
+			return fmt.Errorf("failed to encode variables: %w", err)
 		}
 
-		if slices.Contains(dockerImages, *dockerImage) {
-			return fmt.Errorf("docker image %s is not allowed by the blueprint %s", *dockerImage, bid)
+		tx := db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Update("variables", variablesJson)
+		if tx.Error != nil {
+			return fmt.Errorf("failed to update variables: %w", tx.Error)
 		}
-	} else if dockerImage != nil {
-		server := model.Server{}
-		tx := db.Instance().First(&server, "s.SID = ?", sid)
+	}
+	if imageChanged {
+		tx := db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Updates(model.Server{
+			BID:         newBid,
+			DockerImage: newDockerImage,
+		})
 		if tx.Error != nil || tx.RowsAffected == 0 {
-			return fmt.Errorf("failed to find server with ID %s: %w", sid, tx.Error)
+			return fmt.Errorf("failed to update blueprint and docker image: %w", tx.Error)
 		}
-
-		blueprint := model.Blueprint{}
-		tx = db.Instance().First(&blueprint, "bid = ?", server.BID)
-		if tx.Error != nil || tx.RowsAffected == 0 {
-			return fmt.Errorf("failed to find blueprint with ID %s: %w", server.BID, tx.Error)
+	}
+	if newBid != server.BID {
+		// an accepted EULA belongs to the software of the previous blueprint
+		tx := db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Updates(map[string]any{
+			"eula_accepted_by": "",
+			"eula_accepted_at": nil,
+		})
+		if tx.Error != nil {
+			return fmt.Errorf("failed to reset eula acceptance: %w", tx.Error)
 		}
+	}
 
-		var dockerImages []string
-		err := json.Unmarshal(blueprint.DockerImages, &dockerImages)
+	if !reinstall {
+		return nil
+	}
+
+	// only a different blueprint needs its setup script to run, everything else is applied by a new container
+	opts := InstallOptions{
+		Mode: util.IfElse(newBid != server.BID, daemon.InstallMode_INSTALL_MODE_KEEP_FILES, daemon.InstallMode_INSTALL_MODE_RECREATE_CONTAINER),
+	}
+
+	if server.Status == daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE {
+		ctx, err := beginInstall(sid)
 		if err != nil {
-			return fmt.Errorf("failed to scan docker images from blueprint: %w", err)
-		}
-		if !slices.Contains(dockerImages, *dockerImage) {
-			return fmt.Errorf("docker image %s is not allowed by the blueprint %s", *dockerImage, server.BID)
+			return err
 		}
 
-		tx = db.Instance().Model(&model.Server{}).Where("sid = ?", sid).Update("docker_image", *dockerImage)
-		if tx.Error != nil || tx.RowsAffected == 0 {
-			return fmt.Errorf("failed to update docker image: %w", tx.Error)
-		}
+		go func() {
+			err := runInstall(ctx, sid, opts)
+			if err != nil {
+				log.Printf("failed to install server %s: %v\n", sid, err)
+			}
+		}()
+
+		return nil
 	}
 
+	ok, err := setStatus(sid, nil, model.Server{
+		Status: daemon.ServerStatusType_SERVER_STATUS_TYPE_STOPPING,
+	})
+	if err != nil || !ok {
+		log.Printf("failed to update server status to stopping: %v\n", err)
+		return fmt.Errorf("failed to update server status to stopping: %w", err)
+	}
+
+	p := registerShutdown(sid)
 	go func() {
-		err := Install(server.SID)
+		reason, err := shutdown(&server, false, p.kill)
 		if err != nil {
-			log.Printf("failed to install server %s: %v\n", server.SID, err)
+			log.Printf("failed to stop server %s for update: %v\n", sid, err)
+			settleStatus(sid)
+			unregisterShutdown(sid, p)
 			return
 		}
 
-		err = Start(server.SID) // TODO: maybe move to install?
+		// marked offline and claimed for the install under the lock, so no start can slip in between
+		unlock := lockServer(sid)
+		markOffline(sid, reason)
+		unregisterShutdown(sid, p)
+		ctx, err := beginInstall(sid)
+		unlock()
 		if err != nil {
-			log.Printf("failed to start server %s: %v\n", server.SID, err)
+			log.Printf("failed to install server %s: %v\n", sid, err)
 			return
 		}
+
+		err = runInstall(ctx, sid, opts)
+		if err != nil {
+			log.Printf("failed to install server %s: %v\n", sid, err)
+			return
+		}
+
+		err = Start(sid)
+		if err != nil {
+			log.Printf("failed to start server %s: %v\n", sid, err)
+		}
 	}()
 
 	return nil
 }
+
+func sameUsers(current []model.ServerUser, userIds []string) bool {
+	a := make([]string, 0, len(current))
+	for _, u := range current {
+		a = append(a, u.UID)
+	}
+	b := slices.Clone(userIds)
+
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+// sameAllocations compares the allocations in order, ports are bound in the order of the allocations, so a reordered
+// list is a change.
+func sameAllocations(current []model.ServerAllocation, allocations []model.ServerAllocation) bool {
+	return slices.EqualFunc(current, allocations, func(a model.ServerAllocation, b model.ServerAllocation) bool {
+		return a.IP == b.IP && a.Port == b.Port
+	})
+}
+
+func sameVariables(current []byte, variables []*proto_gen_go.ServerVariable) bool {
+	var values []*proto_gen_go.ServerVariable
+	if len(current) > 0 {
+		_ = json.Unmarshal(current, &values)
+	}
+
+	key := func(vars []*proto_gen_go.ServerVariable) []string {
+		keys := make([]string, 0, len(vars))
+		for _, v := range vars {
+			keys = append(keys, v.EnvKey+"="+v.Value)
+		}
+		slices.Sort(keys)
+		return keys
+	}
+
+	return slices.Equal(key(values), key(variables))
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"panelium/daemon/internal/model"
+	"testing"
+)
+
+func TestSameAllocations(t *testing.T) {
+	a := model.ServerAllocation{IP: "0.0.0.0", Port: 25565}
+	b := model.ServerAllocation{IP: "0.0.0.0", Port: 25566}
+	c := model.ServerAllocation{IP: "10.0.0.1", Port: 25565}
+
+	tests := []struct {
+		name        string
+		current     []model.ServerAllocation
+		allocations []model.ServerAllocation
+		want        bool
+	}{
+		{"both empty", nil, []model.ServerAllocation{}, true},
+		{"same", []model.ServerAllocation{a, b}, []model.ServerAllocation{a, b}, true},
+		{"reordered", []model.ServerAllocation{a, b}, []model.ServerAllocation{b, a}, false},
+		{"added", []model.ServerAllocation{a}, []model.ServerAllocation{a, b}, false},
+		{"removed", []model.ServerAllocation{a, b}, []model.ServerAllocation{a}, false},
+		{"other IP", []model.ServerAllocation{a}, []model.ServerAllocation{c}, false},
+		{"other port", []model.ServerAllocation{a}, []model.ServerAllocation{b}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameAllocations(tt.current, tt.allocations); got != tt.want {
+				t.Errorf("sameAllocations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
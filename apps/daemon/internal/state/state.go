@@ -0,0 +1,75 @@
+package state
+
+import (
+	"log"
+	"panelium/proto_gen_go/daemon"
+	"sync"
+	"time"
+)
+
+// Transition is a single lifecycle change of a server as published on the state bus.
+type Transition struct {
+	SID            string
+	Status         daemon.ServerStatusType
+	OfflineReason  daemon.ServerOfflineReason
+	ExitCode       int32
+	TimestampStart time.Time
+	TimestampEnd   time.Time
+	Timestamp      time.Time // when the transition happened
+}
+
+// Crashed reports whether the transition is a server going offline because it exited on its own with an error.
+func (t Transition) Crashed() bool {
+	return t.Status == daemon.ServerStatusType_SERVER_STATUS_TYPE_OFFLINE && t.OfflineReason == daemon.ServerOfflineReason_SERVER_OFFLINE_REASON_ERROR
+}
+
+const subscriberBuffer = 64
+
+var (
+	lock        sync.RWMutex
+	subscribers = make(map[string]map[chan Transition]struct{}) // keyed by SID, "" receives transitions of all servers
+)
+
+// Subscribe returns a channel receiving every transition of the server, or of all servers if sid is empty.
+// The returned function has to be called once the subscriber is done, it closes the channel.
+func Subscribe(sid string) (<-chan Transition, func()) {
+	ch := make(chan Transition, subscriberBuffer)
+
+	lock.Lock()
+	if subscribers[sid] == nil {
+		subscribers[sid] = make(map[chan Transition]struct{})
+	}
+	subscribers[sid][ch] = struct{}{}
+	lock.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			lock.Lock()
+			delete(subscribers[sid], ch)
+			if len(subscribers[sid]) == 0 {
+				delete(subscribers, sid)
+			}
+			lock.Unlock()
+
+			close(ch)
+		})
+	}
+}
+
+// Publish sends the transition to all subscribers of the server and to all global subscribers.
+// It never blocks, subscribers that fall too far behind miss transitions.
+func Publish(t Transition) {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	for _, sid := range []string{t.SID, ""} {
+		for ch := range subscribers[sid] {
+			select {
+			case ch <- t:
+			default:
+				log.Printf("state subscriber for server %s is not keeping up, dropping transition\n", t.SID)
+			}
+		}
+	}
+}
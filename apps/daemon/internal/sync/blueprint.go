@@ -57,6 +57,24 @@ func SyncBlueprints() error {
 		}
 		blockedFiles := datatypes.JSON(blockedFilesJson)
 
+		portsJson, err := json.Marshal(blueprint.Ports)
+		if err != nil {
+			return err
+		}
+		ports := datatypes.JSON(portsJson)
+
+		variablesJson, err := json.Marshal(blueprint.Variables)
+		if err != nil {
+			return err
+		}
+		variables := datatypes.JSON(variablesJson)
+
+		relaxationsJson, err := json.Marshal(blueprint.SecurityRelaxations)
+		if err != nil {
+			return err
+		}
+		relaxations := datatypes.JSON(relaxationsJson)
+
 		dbBlueprint := &model.Blueprint{
 			BID:                    blueprint.Bid,
 			Version:                uint(blueprint.Version),
@@ -69,11 +87,17 @@ func SyncBlueprints() error {
 			SetupScriptBase64:      blueprint.SetupScriptBase64,
 			SetupDockerImage:       blueprint.SetupDockerImage,
 			SetupScriptInterpreter: blueprint.SetupScriptInterpreter,
+			StartupDonePattern:     blueprint.StartupDonePattern,
+			Ports:                  ports,
+			Variables:              variables,
+			EntrypointShell:        blueprint.EntrypointShell,
+			TerminalShell:          blueprint.TerminalShell,
+			SecurityRelaxations:    relaxations,
 		}
 
 		tx := dbInstance.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: "bid"}},
-			DoUpdates: clause.AssignmentColumns([]string{"version", "flags", "docker_images", "blocked_files", "server_binary", "start_command", "stop_command", "setup_script_base64", "setup_docker_image", "setup_script_interpreter"}),
+			DoUpdates: clause.AssignmentColumns([]string{"version", "flags", "docker_images", "blocked_files", "server_binary", "start_command", "stop_command", "setup_script_base64", "setup_docker_image", "setup_script_interpreter", "startup_done_pattern", "ports", "variables", "entrypoint_shell", "terminal_shell", "security_relaxations"}),
 		}).Create(dbBlueprint)
 		if tx.Error != nil || tx.RowsAffected == 0 {
 			log.Printf("failed to sync blueprint %s: %v", blueprint.Bid, tx.Error)
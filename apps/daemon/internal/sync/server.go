@@ -0,0 +1,59 @@
+package sync
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"net/http"
+	"panelium/daemon/internal/config"
+	"panelium/proto_gen_go"
+	"panelium/proto_gen_go/backend"
+	"panelium/proto_gen_go/backend/backendconnect"
+)
+
+// SyncServers returns the authoritative list of servers the backend has assigned to this node.
+// The list is only returned if the whole stream was received, a partial list must never be treated as authoritative.
+func SyncServers() ([]*backend.Server, error) {
+	client := backendconnect.NewDaemonServiceClient(http.DefaultClient, config.ConfigInstance.GetBackendHost())
+
+	req := connect.NewRequest(&proto_gen_go.Empty{})
+	req.Header().Add("Authorization", config.SecretsInstance.BackendToken)
+
+	stm, err := client.SyncServers(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(s *connect.ServerStreamForClient[backend.Server]) {
+		_ = s.Close()
+	}(stm)
+
+	var servers []*backend.Server
+	for stm.Receive() {
+		server := stm.Msg()
+		if server == nil {
+			continue
+		}
+
+		servers = append(servers, server)
+	}
+	if err := stm.Err(); err != nil {
+		return nil, err
+	}
+
+	return servers, nil
+}
+
+// GetServer returns a single server from the backend, a connect.CodeNotFound error means the backend does not know it.
+func GetServer(sid string) (*backend.Server, error) {
+	client := backendconnect.NewDaemonServiceClient(http.DefaultClient, config.ConfigInstance.GetBackendHost())
+
+	req := connect.NewRequest(&proto_gen_go.SimpleIDMessage{Id: sid})
+	req.Header().Add("Authorization", config.SecretsInstance.BackendToken)
+
+	res, err := client.GetServer(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
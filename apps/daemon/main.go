@@ -12,6 +12,7 @@ import (
 	"panelium/daemon/internal/docker"
 	"panelium/daemon/internal/handler"
 	"panelium/daemon/internal/security"
+	"panelium/daemon/internal/server"
 	"panelium/proto_gen_go/backend"
 	"panelium/proto_gen_go/backend/backendconnect"
 	"time"
@@ -50,6 +51,52 @@ func main() {
 		return
 	}
 
+	err = server.Reconcile(true)
+	if err != nil {
+		log.Printf("Failed to reconcile servers: %v", err)
+	}
+
+	go func() {
+		for range time.Tick(config.ConfigInstance.GetReconcileInterval()) {
+			err := server.Reconcile(false)
+			if err != nil {
+				log.Printf("Failed to reconcile servers: %v", err)
+			}
+		}
+	}()
+
+	go server.RecordResourceHistory()
+
+	go func() {
+		server.PruneConsoleLogs()
+		for range time.Tick(time.Hour) {
+			server.PruneConsoleLogs()
+		}
+	}()
+
+	go func() {
+		for range time.Tick(time.Minute) {
+			server.CloseIdleTerminalSessions()
+		}
+	}()
+
+	go func() {
+		for range time.Tick(config.ConfigInstance.GetStorageCheckInterval()) {
+			server.CheckStorageUsage()
+		}
+	}()
+
+	go func() {
+		for {
+			err := server.SyncServers()
+			if err != nil {
+				log.Printf("Failed to sync servers: %v", err)
+			}
+
+			time.Sleep(config.ConfigInstance.GetServerSyncInterval())
+		}
+	}()
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "9000"
@@ -0,0 +1,212 @@
+package blueprint
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Lookup returns the value of a key of the template context and whether it exists.
+type Lookup func(key string) (string, bool)
+
+// SplitCommand splits a start command into its arguments with POSIX shell word semantics: words are separated by
+// unquoted blanks, single quotes keep everything literal, double quotes allow escaping $ ` " \ with a backslash and a
+// backslash outside of quotes escapes the following character. $KEY and ${KEY} are expanded from the template context,
+// like template placeholders, which are expanded anywhere. Expanded values are never split into multiple arguments.
+//
+// Without a shell there are no pipes, redirections, command lists or substitutions, these are rejected so they are not
+// silently passed to the server as literal arguments. Blueprints needing them have to use an entrypoint shell.
+func SplitCommand(command string, lookup Lookup) ([]string, error) {
+	var args []string
+	var word strings.Builder
+	inWord := false
+
+	const (
+		unquoted = iota
+		singleQuoted
+		doubleQuoted
+	)
+	state := unquoted
+	quoteStart := 0
+
+	fail := func(i int, format string, a ...any) ([]string, error) {
+		return nil, fmt.Errorf("%s at position %d", fmt.Sprintf(format, a...), i+1)
+	}
+
+	command = strings.TrimSpace(command)
+	for i := 0; i < len(command); i++ {
+		c := command[i]
+
+		if strings.HasPrefix(command[i:], placeholderStart) {
+			key, n, err := placeholderAt(command, i)
+			if err != nil {
+				return fail(i, "%v", err)
+			}
+			value, ok := lookup(key)
+			if !ok {
+				return fail(i, "unknown template key %s", key)
+			}
+			word.WriteString(value)
+			inWord = true
+			i += n - 1
+			continue
+		}
+
+		switch state {
+		case singleQuoted:
+			if c == '\'' {
+				state = unquoted
+				continue
+			}
+			word.WriteByte(c)
+
+		case doubleQuoted:
+			switch c {
+			case '"':
+				state = unquoted
+			case '\\':
+				if i+1 < len(command) && strings.IndexByte("$`\"\\\n", command[i+1]) >= 0 {
+					i++
+					if command[i] != '\n' {
+						word.WriteByte(command[i])
+					}
+				} else {
+					word.WriteByte(c)
+				}
+			case '$':
+				value, n, err := expand(command, i, lookup)
+				if err != nil {
+					return fail(i, "%v", err)
+				}
+				word.WriteString(value)
+				i += n - 1
+			case '`':
+				return fail(i, "command substitution is not supported without an entrypoint shell")
+			default:
+				word.WriteByte(c)
+			}
+
+		default:
+			switch {
+			case c == ' ' || c == '\t':
+				if inWord {
+					args = append(args, word.String())
+					word.Reset()
+					inWord = false
+				}
+			case c == '\n':
+				return fail(i, "multiple commands are not supported without an entrypoint shell")
+			case c == '#' && !inWord:
+				// a comment runs until the end of the line, which is the end of the command
+				i = len(command)
+			case c == '\'':
+				state = singleQuoted
+				quoteStart = i
+				inWord = true
+			case c == '"':
+				state = doubleQuoted
+				quoteStart = i
+				inWord = true
+			case c == '\\':
+				if i+1 >= len(command) {
+					return fail(i, "trailing backslash")
+				}
+				i++
+				if command[i] != '\n' {
+					word.WriteByte(command[i])
+					inWord = true
+				}
+			case c == '$':
+				value, n, err := expand(command, i, lookup)
+				if err != nil {
+					return fail(i, "%v", err)
+				}
+				word.WriteString(value)
+				inWord = true
+				i += n - 1
+			case c == '`':
+				return fail(i, "command substitution is not supported without an entrypoint shell")
+			case strings.IndexByte("|&;<>()", c) >= 0:
+				return fail(i, "unquoted %q is not supported without an entrypoint shell", c)
+			default:
+				word.WriteByte(c)
+				inWord = true
+			}
+		}
+	}
+
+	switch state {
+	case singleQuoted:
+		return fail(quoteStart, "unterminated single quote")
+	case doubleQuoted:
+		return fail(quoteStart, "unterminated double quote")
+	}
+
+	if inWord {
+		args = append(args, word.String())
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("empty command")
+	}
+
+	return args, nil
+}
+
+// expand parses the parameter expansion starting with the $ at index i and returns its value and length. A $ not
+// followed by a name is kept as it is.
+func expand(s string, i int, lookup Lookup) (string, int, error) {
+	rest := s[i+1:]
+
+	var key string
+	var n int
+	switch {
+	case strings.HasPrefix(rest, "("):
+		return "", 0, fmt.Errorf("command substitution is not supported without an entrypoint shell")
+	case strings.HasPrefix(rest, "{"):
+		end := strings.IndexByte(rest, '}')
+		if end < 0 {
+			return "", 0, fmt.Errorf("unterminated ${")
+		}
+		key = rest[1:end]
+		if !isName(key) {
+			return "", 0, fmt.Errorf("unsupported parameter expansion ${%s}", key)
+		}
+		n = end + 2
+	default:
+		end := 0
+		for end < len(rest) && (rest[end] == '_' || isAlpha(rest[end]) || (end > 0 && isDigit(rest[end]))) {
+			end++
+		}
+		if end == 0 {
+			return "$", 1, nil
+		}
+		key = rest[:end]
+		n = end + 1
+	}
+
+	value, ok := lookup(key)
+	if !ok {
+		return "", 0, fmt.Errorf("unknown variable %s", key)
+	}
+
+	return value, n, nil
+}
+
+func isName(s string) bool {
+	if s == "" || isDigit(s[0]) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] != '_' && !isAlpha(s[i]) && !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlpha(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
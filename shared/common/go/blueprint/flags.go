@@ -0,0 +1,25 @@
+package blueprint
+
+import (
+	"fmt"
+	"slices"
+)
+
+// FlagEula requires the owner to accept the EULA of the server software before the server can be started.
+const FlagEula = "eula"
+
+// Flags lists the flags the daemon knows how to handle.
+var Flags = []string{
+	FlagEula,
+}
+
+// ValidateFlags returns an error for the first flag that is not known.
+func ValidateFlags(flags []string) error {
+	for _, flag := range flags {
+		if !slices.Contains(Flags, flag) {
+			return fmt.Errorf("unknown flag %q", flag)
+		}
+	}
+
+	return nil
+}
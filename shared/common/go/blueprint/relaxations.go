@@ -0,0 +1,67 @@
+package blueprint
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Relaxations loosen the node's security profile for the containers of a blueprint. A blueprint only requests them,
+// they are applied once an admin approved them.
+const (
+	// RelaxationWritableRootfs keeps the root filesystem of the server container writable.
+	RelaxationWritableRootfs = "writable_rootfs"
+	// RelaxationNewPrivileges lets processes gain privileges, e.g. through setuid binaries.
+	RelaxationNewPrivileges = "new_privileges"
+	// RelaxationUnlimitedPids lifts the limit of processes and threads.
+	RelaxationUnlimitedPids = "unlimited_pids"
+	// RelaxationCapabilityPrefix followed by a capability name, e.g. cap:NET_RAW, keeps that capability.
+	RelaxationCapabilityPrefix = "cap:"
+)
+
+// Relaxations lists the relaxations besides the capability ones.
+var Relaxations = []string{
+	RelaxationWritableRootfs,
+	RelaxationNewPrivileges,
+	RelaxationUnlimitedPids,
+}
+
+var capabilityPattern = regexp.MustCompile("^[A-Z][A-Z_]*$")
+
+// RelaxationCapability returns the capability a relaxation keeps, false if it is not a capability relaxation.
+func RelaxationCapability(relaxation string) (string, bool) {
+	return strings.CutPrefix(relaxation, RelaxationCapabilityPrefix)
+}
+
+// ValidateRelaxations returns an error for the first relaxation that is not known.
+func ValidateRelaxations(relaxations []string) error {
+	for _, relaxation := range relaxations {
+		if slices.Contains(Relaxations, relaxation) {
+			continue
+		}
+
+		capability, ok := RelaxationCapability(relaxation)
+		if !ok {
+			return fmt.Errorf("unknown security relaxation %q", relaxation)
+		}
+		capability = strings.TrimPrefix(capability, "CAP_")
+		if !capabilityPattern.MatchString(capability) || capability == "ALL" {
+			return fmt.Errorf("invalid capability in security relaxation %q", relaxation)
+		}
+	}
+
+	return nil
+}
+
+// AppliedRelaxations returns the approved relaxations the blueprint still requests, approvals of relaxations it no
+// longer requests don't carry over to later requests.
+func AppliedRelaxations(requested []string, approved []string) []string {
+	var applied []string
+	for _, relaxation := range approved {
+		if slices.Contains(requested, relaxation) {
+			applied = append(applied, relaxation)
+		}
+	}
+	return applied
+}
@@ -0,0 +1,89 @@
+package blueprint
+
+/*
+Templates are used in blueprint start commands, e.g. "java -Xmx{{$env::SERVER_MEMORY}}M -jar {{$env::SERVER_BINARY}}".
+A placeholder is replaced by the value of the key in the template context, which the daemon builds from the server
+(SID, limits, allocations) and the values of the blueprint's variables.
+*/
+
+import (
+	"fmt"
+	"strings"
+)
+
+const placeholderStart = "{{$env::"
+const placeholderEnd = "}}"
+
+// ShellCommand turns every placeholder into a reference to the environment variable of the same name, for commands
+// run by a shell. The values are expanded by the shell and never parsed as shell syntax.
+func ShellCommand(template string) (string, error) {
+	var b strings.Builder
+	err := walk(template, func(text string, key string) error {
+		b.WriteString(text)
+		if key != "" {
+			b.WriteString("${" + key + "}")
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// Keys returns the keys of all placeholders in the template.
+func Keys(template string) ([]string, error) {
+	var keys []string
+	err := walk(template, func(_ string, key string) error {
+		if key != "" {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// walk calls fn for every placeholder with the text preceding it, the text after the last placeholder is passed with
+// an empty key.
+func walk(template string, fn func(text string, key string) error) error {
+	rest := template
+	offset := 0
+	for {
+		start := strings.Index(rest, placeholderStart)
+		if start < 0 {
+			return fn(rest, "")
+		}
+
+		key, n, err := placeholderAt(rest, start)
+		if err != nil {
+			return fmt.Errorf("%w at position %d", err, offset+start+1)
+		}
+
+		err = fn(rest[:start], key)
+		if err != nil {
+			return err
+		}
+		rest = rest[start+n:]
+		offset += start + n
+	}
+}
+
+// placeholderAt parses the placeholder starting at index i and returns its key and length.
+func placeholderAt(s string, i int) (string, int, error) {
+	end := strings.Index(s[i:], placeholderEnd)
+	if end < 0 {
+		return "", 0, fmt.Errorf("unterminated placeholder")
+	}
+
+	key := strings.TrimSpace(s[i+len(placeholderStart) : i+end])
+	if !envKeyPattern.MatchString(key) {
+		return "", 0, fmt.Errorf("invalid template key %q", key)
+	}
+
+	return key, end + len(placeholderEnd), nil
+}
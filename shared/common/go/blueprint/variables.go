@@ -0,0 +1,162 @@
+package blueprint
+
+import (
+	"fmt"
+	"panelium/proto_gen_go"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var envKeyPattern = regexp.MustCompile("^[A-Z_][A-Z0-9_]*$")
+
+// IsReservedKey reports whether the key is provided by the daemon itself and thus cannot be used by a variable.
+func IsReservedKey(key string) bool {
+	return key == "SID" || strings.HasPrefix(key, "SERVER_")
+}
+
+// ValidateVariables checks the variable definitions of a blueprint, including that their defaults are valid values.
+func ValidateVariables(variables []*proto_gen_go.BlueprintVariable) error {
+	keys := make(map[string]bool, len(variables))
+	for _, v := range variables {
+		if v.Name == "" {
+			return fmt.Errorf("variable %s has no name", v.EnvKey)
+		}
+		if !envKeyPattern.MatchString(v.EnvKey) {
+			return fmt.Errorf("invalid env key %q of variable %s, only uppercase letters, digits and underscores are allowed", v.EnvKey, v.Name)
+		}
+		if IsReservedKey(v.EnvKey) {
+			return fmt.Errorf("env key %s of variable %s is reserved", v.EnvKey, v.Name)
+		}
+		if keys[v.EnvKey] {
+			return fmt.Errorf("duplicate env key %s", v.EnvKey)
+		}
+		keys[v.EnvKey] = true
+
+		if _, ok := proto_gen_go.VariableType_name[int32(v.Type)]; !ok {
+			return fmt.Errorf("invalid type of variable %s", v.EnvKey)
+		}
+		if v.Regex != "" {
+			if _, err := regexp.Compile(v.Regex); err != nil {
+				return fmt.Errorf("invalid regex of variable %s: %w", v.EnvKey, err)
+			}
+		}
+		if v.Min != nil && v.Max != nil && *v.Min > *v.Max {
+			return fmt.Errorf("minimum of variable %s is greater than its maximum", v.EnvKey)
+		}
+		for _, option := range v.Options {
+			if err := checkType(v, option); err != nil {
+				return fmt.Errorf("invalid option %q of variable %s: %w", option, v.EnvKey, err)
+			}
+		}
+
+		if err := ValidateValue(v, v.DefaultValue); err != nil {
+			return fmt.Errorf("invalid default value of variable %s: %w", v.EnvKey, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateValue checks a value against the type and the validation rules of the variable.
+func ValidateValue(v *proto_gen_go.BlueprintVariable, value string) error {
+	err := checkType(v, value)
+	if err != nil {
+		return err
+	}
+
+	if len(v.Options) > 0 && !slices.Contains(v.Options, value) {
+		return fmt.Errorf("must be one of %s", strings.Join(v.Options, ", "))
+	}
+
+	if v.Regex != "" {
+		pattern, err := regexp.Compile(v.Regex)
+		if err != nil {
+			return fmt.Errorf("invalid regex: %w", err)
+		}
+		if !pattern.MatchString(value) {
+			return fmt.Errorf("must match %s", v.Regex)
+		}
+	}
+
+	switch v.Type {
+	case proto_gen_go.VariableType_VARIABLE_TYPE_INTEGER:
+		n, _ := strconv.ParseInt(value, 10, 64)
+		if v.Min != nil && n < *v.Min {
+			return fmt.Errorf("must be at least %d", *v.Min)
+		}
+		if v.Max != nil && n > *v.Max {
+			return fmt.Errorf("must be at most %d", *v.Max)
+		}
+	case proto_gen_go.VariableType_VARIABLE_TYPE_STRING:
+		n := int64(utf8.RuneCountInString(value))
+		if v.Min != nil && n < *v.Min {
+			return fmt.Errorf("must be at least %d characters long", *v.Min)
+		}
+		if v.Max != nil && n > *v.Max {
+			return fmt.Errorf("must be at most %d characters long", *v.Max)
+		}
+	}
+
+	return nil
+}
+
+func checkType(v *proto_gen_go.BlueprintVariable, value string) error {
+	switch v.Type {
+	case proto_gen_go.VariableType_VARIABLE_TYPE_INTEGER:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("must be an integer")
+		}
+	case proto_gen_go.VariableType_VARIABLE_TYPE_BOOLEAN:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("must be true or false")
+		}
+	}
+
+	return nil
+}
+
+// ResolveVariables validates the values of a server and returns the value of every variable of the blueprint, in the
+// order of the blueprint, falling back to the default for variables without a value. Values of variables the blueprint
+// does not define are dropped, they are left over from an older version of the blueprint.
+func ResolveVariables(variables []*proto_gen_go.BlueprintVariable, values []*proto_gen_go.ServerVariable) ([]*proto_gen_go.ServerVariable, error) {
+	set := make(map[string]string, len(values))
+	for _, value := range values {
+		if _, ok := set[value.EnvKey]; ok {
+			return nil, fmt.Errorf("duplicate value for variable %s", value.EnvKey)
+		}
+		set[value.EnvKey] = value.Value
+	}
+
+	resolved := make([]*proto_gen_go.ServerVariable, 0, len(variables))
+	for _, v := range variables {
+		value, ok := set[v.EnvKey]
+		if !ok {
+			value = v.DefaultValue
+		}
+
+		if err := ValidateValue(v, value); err != nil {
+			return nil, fmt.Errorf("invalid value of variable %s: %w", v.EnvKey, err)
+		}
+
+		resolved = append(resolved, &proto_gen_go.ServerVariable{
+			EnvKey: v.EnvKey,
+			Value:  value,
+		})
+	}
+
+	return resolved, nil
+}
+
+// CheckKnownVariables returns an error if a value is given for a variable the blueprint does not define.
+func CheckKnownVariables(variables []*proto_gen_go.BlueprintVariable, values []*proto_gen_go.ServerVariable) error {
+	for _, value := range values {
+		if !slices.ContainsFunc(variables, func(v *proto_gen_go.BlueprintVariable) bool { return v.EnvKey == value.EnvKey }) {
+			return fmt.Errorf("unknown variable %s", value.EnvKey)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package blueprint
+
+import (
+	"panelium/proto_gen_go"
+	"testing"
+)
+
+func int64Ptr(n int64) *int64 {
+	return &n
+}
+
+func TestValidateValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		variable *proto_gen_go.BlueprintVariable
+		value    string
+		ok       bool
+	}{
+		{"string without rules", &proto_gen_go.BlueprintVariable{}, "anything", true},
+		{"empty string without rules", &proto_gen_go.BlueprintVariable{}, "", true},
+		{"integer", &proto_gen_go.BlueprintVariable{Type: proto_gen_go.VariableType_VARIABLE_TYPE_INTEGER}, "-42", true},
+		{"integer not a number", &proto_gen_go.BlueprintVariable{Type: proto_gen_go.VariableType_VARIABLE_TYPE_INTEGER}, "4x", false},
+		{"integer with decimals", &proto_gen_go.BlueprintVariable{Type: proto_gen_go.VariableType_VARIABLE_TYPE_INTEGER}, "4.5", false},
+		{"integer empty", &proto_gen_go.BlueprintVariable{Type: proto_gen_go.VariableType_VARIABLE_TYPE_INTEGER}, "", false},
+		{"integer at min", &proto_gen_go.BlueprintVariable{Type: proto_gen_go.VariableType_VARIABLE_TYPE_INTEGER, Min: int64Ptr(1)}, "1", true},
+		{"integer below min", &proto_gen_go.BlueprintVariable{Type: proto_gen_go.VariableType_VARIABLE_TYPE_INTEGER, Min: int64Ptr(1)}, "0", false},
+		{"integer at max", &proto_gen_go.BlueprintVariable{Type: proto_gen_go.VariableType_VARIABLE_TYPE_INTEGER, Max: int64Ptr(100)}, "100", true},
+		{"integer above max", &proto_gen_go.BlueprintVariable{Type: proto_gen_go.VariableType_VARIABLE_TYPE_INTEGER, Max: int64Ptr(100)}, "101", false},
+		{"boolean true", &proto_gen_go.BlueprintVariable{Type: proto_gen_go.VariableType_VARIABLE_TYPE_BOOLEAN}, "true", true},
+		{"boolean false", &proto_gen_go.BlueprintVariable{Type: proto_gen_go.VariableType_VARIABLE_TYPE_BOOLEAN}, "false", true},
+		{"boolean other", &proto_gen_go.BlueprintVariable{Type: proto_gen_go.VariableType_VARIABLE_TYPE_BOOLEAN}, "yes", false},
+		{"string length counts characters", &proto_gen_go.BlueprintVariable{Max: int64Ptr(3)}, "äöü", true},
+		{"string too short", &proto_gen_go.BlueprintVariable{Min: int64Ptr(3)}, "ab", false},
+		{"string too long", &proto_gen_go.BlueprintVariable{Max: int64Ptr(3)}, "abcd", false},
+		{"option", &proto_gen_go.BlueprintVariable{Options: []string{"easy", "hard"}}, "hard", true},
+		{"not an option", &proto_gen_go.BlueprintVariable{Options: []string{"easy", "hard"}}, "normal", false},
+		{"regex match", &proto_gen_go.BlueprintVariable{Regex: "^[a-z]+$"}, "world", true},
+		{"regex mismatch", &proto_gen_go.BlueprintVariable{Regex: "^[a-z]+$"}, "World", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateValue(tt.variable, tt.value)
+			if (err == nil) != tt.ok {
+				t.Errorf("ValidateValue(%q) = %v, want ok %v", tt.value, err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestValidateVariables(t *testing.T) {
+	valid := func() *proto_gen_go.BlueprintVariable {
+		return &proto_gen_go.BlueprintVariable{
+			Name:         "Max players",
+			EnvKey:       "MAX_PLAYERS",
+			Type:         proto_gen_go.VariableType_VARIABLE_TYPE_INTEGER,
+			DefaultValue: "20",
+			Min:          int64Ptr(1),
+			Max:          int64Ptr(100),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(v *proto_gen_go.BlueprintVariable)
+		ok     bool
+	}{
+		{"valid", func(v *proto_gen_go.BlueprintVariable) {}, true},
+		{"no name", func(v *proto_gen_go.BlueprintVariable) { v.Name = "" }, false},
+		{"lowercase env key", func(v *proto_gen_go.BlueprintVariable) { v.EnvKey = "max_players" }, false},
+		{"env key starting with a digit", func(v *proto_gen_go.BlueprintVariable) { v.EnvKey = "1PLAYERS" }, false},
+		{"reserved env key", func(v *proto_gen_go.BlueprintVariable) { v.EnvKey = "SERVER_PORT" }, false},
+		{"reserved SID", func(v *proto_gen_go.BlueprintVariable) { v.EnvKey = "SID" }, false},
+		{"unknown type", func(v *proto_gen_go.BlueprintVariable) { v.Type = 99 }, false},
+		{"invalid regex", func(v *proto_gen_go.BlueprintVariable) { v.Regex = "[" }, false},
+		{"min above max", func(v *proto_gen_go.BlueprintVariable) { v.Min = int64Ptr(200) }, false},
+		{"option of the wrong type", func(v *proto_gen_go.BlueprintVariable) { v.Options = []string{"20", "many"} }, false},
+		{"default not an option", func(v *proto_gen_go.BlueprintVariable) { v.Options = []string{"10", "30"} }, false},
+		{"default out of range", func(v *proto_gen_go.BlueprintVariable) { v.DefaultValue = "0" }, false},
+		{"default of the wrong type", func(v *proto_gen_go.BlueprintVariable) { v.DefaultValue = "twenty" }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := valid()
+			tt.modify(v)
+			err := ValidateVariables([]*proto_gen_go.BlueprintVariable{v})
+			if (err == nil) != tt.ok {
+				t.Errorf("ValidateVariables() = %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+
+	t.Run("duplicate env key", func(t *testing.T) {
+		err := ValidateVariables([]*proto_gen_go.BlueprintVariable{valid(), valid()})
+		if err == nil {
+			t.Error("ValidateVariables() = nil, want an error")
+		}
+	})
+}
+
+func TestResolveVariables(t *testing.T) {
+	variables := []*proto_gen_go.BlueprintVariable{
+		{EnvKey: "MAX_PLAYERS", Type: proto_gen_go.VariableType_VARIABLE_TYPE_INTEGER, DefaultValue: "20"},
+		{EnvKey: "PVP", Type: proto_gen_go.VariableType_VARIABLE_TYPE_BOOLEAN, DefaultValue: "true"},
+	}
+
+	tests := []struct {
+		name   string
+		values []*proto_gen_go.ServerVariable
+		want   []string // values in the order of the blueprint, nil if an error is expected
+	}{
+		{"defaults", nil, []string{"20", "true"}},
+		{"set values", []*proto_gen_go.ServerVariable{{EnvKey: "PVP", Value: "false"}, {EnvKey: "MAX_PLAYERS", Value: "5"}}, []string{"5", "false"}},
+		{"unknown values are dropped", []*proto_gen_go.ServerVariable{{EnvKey: "OLD", Value: "x"}}, []string{"20", "true"}},
+		{"invalid value", []*proto_gen_go.ServerVariable{{EnvKey: "PVP", Value: "maybe"}}, nil},
+		{"duplicate value", []*proto_gen_go.ServerVariable{{EnvKey: "PVP", Value: "true"}, {EnvKey: "PVP", Value: "false"}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved, err := ResolveVariables(variables, tt.values)
+			if tt.want == nil {
+				if err == nil {
+					t.Errorf("ResolveVariables() = %v, want an error", resolved)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveVariables() = %v", err)
+			}
+			if len(resolved) != len(tt.want) {
+				t.Fatalf("ResolveVariables() returned %d values, want %d", len(resolved), len(tt.want))
+			}
+			for i, v := range resolved {
+				if v.EnvKey != variables[i].EnvKey || v.Value != tt.want[i] {
+					t.Errorf("value %d = %s=%s, want %s=%s", i, v.EnvKey, v.Value, variables[i].EnvKey, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -320,12 +320,13 @@ func (x *AvailableNodes) GetNodes() []*AvailableNode {
 }
 
 type NewServerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Bid           string                 `protobuf:"bytes,3,opt,name=bid,proto3" json:"bid,omitempty"`
-	Lid           *string                `protobuf:"bytes,4,opt,name=lid,proto3,oneof" json:"lid,omitempty"`
-	Nid           *string                `protobuf:"bytes,5,opt,name=nid,proto3,oneof" json:"nid,omitempty"`
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Name          string                         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Bid           string                         `protobuf:"bytes,3,opt,name=bid,proto3" json:"bid,omitempty"`
+	Lid           *string                        `protobuf:"bytes,4,opt,name=lid,proto3,oneof" json:"lid,omitempty"`
+	Nid           *string                        `protobuf:"bytes,5,opt,name=nid,proto3,oneof" json:"nid,omitempty"`
+	Variables     []*proto_gen_go.ServerVariable `protobuf:"bytes,6,rep,name=variables,proto3" json:"variables,omitempty"` // only user editable variables, the others use their default
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -395,6 +396,13 @@ func (x *NewServerRequest) GetNid() string {
 	return ""
 }
 
+func (x *NewServerRequest) GetVariables() []*proto_gen_go.ServerVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
 type NewServerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sid           string                 `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
@@ -659,6 +667,154 @@ func (x *ServerInfo) GetLocation() string {
 	return ""
 }
 
+type ServerVariableInfo struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Variable      *proto_gen_go.BlueprintVariable `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty"`
+	Value         string                          `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerVariableInfo) Reset() {
+	*x = ServerVariableInfo{}
+	mi := &file_backend_Client_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerVariableInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerVariableInfo) ProtoMessage() {}
+
+func (x *ServerVariableInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_Client_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerVariableInfo.ProtoReflect.Descriptor instead.
+func (*ServerVariableInfo) Descriptor() ([]byte, []int) {
+	return file_backend_Client_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ServerVariableInfo) GetVariable() *proto_gen_go.BlueprintVariable {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+func (x *ServerVariableInfo) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ServerVariables struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variables     []*ServerVariableInfo  `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty"` // only the user editable variables of the server's blueprint
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerVariables) Reset() {
+	*x = ServerVariables{}
+	mi := &file_backend_Client_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerVariables) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerVariables) ProtoMessage() {}
+
+func (x *ServerVariables) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_Client_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerVariables.ProtoReflect.Descriptor instead.
+func (*ServerVariables) Descriptor() ([]byte, []int) {
+	return file_backend_Client_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ServerVariables) GetVariables() []*ServerVariableInfo {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type UpdateServerVariablesRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Sid           string                         `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+	Variables     []*proto_gen_go.ServerVariable `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"` // variables not given are left as they are
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateServerVariablesRequest) Reset() {
+	*x = UpdateServerVariablesRequest{}
+	mi := &file_backend_Client_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServerVariablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServerVariablesRequest) ProtoMessage() {}
+
+func (x *UpdateServerVariablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_Client_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServerVariablesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServerVariablesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_Client_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateServerVariablesRequest) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *UpdateServerVariablesRequest) GetVariables() []*proto_gen_go.ServerVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
 var File_backend_Client_proto protoreflect.FileDescriptor
 
 const file_backend_Client_proto_rawDesc = "" +
@@ -681,13 +837,14 @@ const file_backend_Client_proto_rawDesc = "" +
 	"\x03lid\x18\x02 \x01(\tR\x03lid\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\">\n" +
 	"\x0eAvailableNodes\x12,\n" +
-	"\x05nodes\x18\x01 \x03(\v2\x16.backend.AvailableNodeR\x05nodes\"\x98\x01\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x16.backend.AvailableNodeR\x05nodes\"\xce\x01\n" +
 	"\x10NewServerRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x10\n" +
 	"\x03bid\x18\x03 \x01(\tR\x03bid\x12\x15\n" +
 	"\x03lid\x18\x04 \x01(\tH\x00R\x03lid\x88\x01\x01\x12\x15\n" +
-	"\x03nid\x18\x05 \x01(\tH\x01R\x03nid\x88\x01\x01B\x06\n" +
+	"\x03nid\x18\x05 \x01(\tH\x01R\x03nid\x88\x01\x01\x124\n" +
+	"\tvariables\x18\x06 \x03(\v2\x16.common.ServerVariableR\tvariablesB\x06\n" +
 	"\x04_lidB\x06\n" +
 	"\x04_nid\"%\n" +
 	"\x11NewServerResponse\x12\x10\n" +
@@ -713,7 +870,15 @@ const file_backend_Client_proto_rawDesc = "" +
 	"daemonHost\x12<\n" +
 	"\x0eresource_limit\x18\b \x01(\v2\x15.common.ResourceLimitR\rresourceLimit\x12\x1a\n" +
 	"\blocation\x18\t \x01(\tR\blocationB\x12\n" +
-	"\x10_main_allocation2\xbb\x03\n" +
+	"\x10_main_allocation\"a\n" +
+	"\x12ServerVariableInfo\x125\n" +
+	"\bvariable\x18\x01 \x01(\v2\x19.common.BlueprintVariableR\bvariable\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"L\n" +
+	"\x0fServerVariables\x129\n" +
+	"\tvariables\x18\x01 \x03(\v2\x1b.backend.ServerVariableInfoR\tvariables\"f\n" +
+	"\x1cUpdateServerVariablesRequest\x12\x10\n" +
+	"\x03sid\x18\x01 \x01(\tR\x03sid\x124\n" +
+	"\tvariables\x18\x02 \x03(\v2\x16.common.ServerVariableR\tvariables2\xdc\x04\n" +
 	"\rClientService\x12-\n" +
 	"\aGetInfo\x12\r.common.Empty\x1a\x13.backend.ClientInfo\x123\n" +
 	"\rGetServerList\x12\r.common.Empty\x1a\x13.backend.ServerList\x129\n" +
@@ -721,7 +886,9 @@ const file_backend_Client_proto_rawDesc = "" +
 	"\x16GetAvailableBlueprints\x12\r.common.Empty\x1a\x1c.backend.AvailableBlueprints\x12C\n" +
 	"\x15GetAvailableLocations\x12\r.common.Empty\x1a\x1b.backend.AvailableLocations\x12;\n" +
 	"\x11GetAvailableNodes\x12\r.common.Empty\x1a\x17.backend.AvailableNodes\x12B\n" +
-	"\tNewServer\x12\x19.backend.NewServerRequest\x1a\x1a.backend.NewServerResponseB\x1fZ\x1dpanelium/proto_gen_go/backendb\x06proto3"
+	"\tNewServer\x12\x19.backend.NewServerRequest\x1a\x1a.backend.NewServerResponse\x12G\n" +
+	"\x12GetServerVariables\x12\x17.common.SimpleIDMessage\x1a\x18.backend.ServerVariables\x12V\n" +
+	"\x15UpdateServerVariables\x12%.backend.UpdateServerVariablesRequest\x1a\x16.common.SuccessMessageB\x1fZ\x1dpanelium/proto_gen_go/backendb\x06proto3"
 
 var (
 	file_backend_Client_proto_rawDescOnce sync.Once
@@ -735,50 +902,64 @@ func file_backend_Client_proto_rawDescGZIP() []byte {
 	return file_backend_Client_proto_rawDescData
 }
 
-var file_backend_Client_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_backend_Client_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_backend_Client_proto_goTypes = []any{
-	(*AvailableBlueprint)(nil),           // 0: backend.AvailableBlueprint
-	(*AvailableBlueprints)(nil),          // 1: backend.AvailableBlueprints
-	(*AvailableLocation)(nil),            // 2: backend.AvailableLocation
-	(*AvailableLocations)(nil),           // 3: backend.AvailableLocations
-	(*AvailableNode)(nil),                // 4: backend.AvailableNode
-	(*AvailableNodes)(nil),               // 5: backend.AvailableNodes
-	(*NewServerRequest)(nil),             // 6: backend.NewServerRequest
-	(*NewServerResponse)(nil),            // 7: backend.NewServerResponse
-	(*ClientInfo)(nil),                   // 8: backend.ClientInfo
-	(*ServerList)(nil),                   // 9: backend.ServerList
-	(*ServerInfo)(nil),                   // 10: backend.ServerInfo
-	(*proto_gen_go.IPAllocation)(nil),    // 11: common.IPAllocation
-	(*proto_gen_go.ResourceLimit)(nil),   // 12: common.ResourceLimit
-	(*proto_gen_go.Empty)(nil),           // 13: common.Empty
-	(*proto_gen_go.SimpleIDMessage)(nil), // 14: common.SimpleIDMessage
+	(*AvailableBlueprint)(nil),             // 0: backend.AvailableBlueprint
+	(*AvailableBlueprints)(nil),            // 1: backend.AvailableBlueprints
+	(*AvailableLocation)(nil),              // 2: backend.AvailableLocation
+	(*AvailableLocations)(nil),             // 3: backend.AvailableLocations
+	(*AvailableNode)(nil),                  // 4: backend.AvailableNode
+	(*AvailableNodes)(nil),                 // 5: backend.AvailableNodes
+	(*NewServerRequest)(nil),               // 6: backend.NewServerRequest
+	(*NewServerResponse)(nil),              // 7: backend.NewServerResponse
+	(*ClientInfo)(nil),                     // 8: backend.ClientInfo
+	(*ServerList)(nil),                     // 9: backend.ServerList
+	(*ServerInfo)(nil),                     // 10: backend.ServerInfo
+	(*ServerVariableInfo)(nil),             // 11: backend.ServerVariableInfo
+	(*ServerVariables)(nil),                // 12: backend.ServerVariables
+	(*UpdateServerVariablesRequest)(nil),   // 13: backend.UpdateServerVariablesRequest
+	(*proto_gen_go.ServerVariable)(nil),    // 14: common.ServerVariable
+	(*proto_gen_go.IPAllocation)(nil),      // 15: common.IPAllocation
+	(*proto_gen_go.ResourceLimit)(nil),     // 16: common.ResourceLimit
+	(*proto_gen_go.BlueprintVariable)(nil), // 17: common.BlueprintVariable
+	(*proto_gen_go.Empty)(nil),             // 18: common.Empty
+	(*proto_gen_go.SimpleIDMessage)(nil),   // 19: common.SimpleIDMessage
+	(*proto_gen_go.SuccessMessage)(nil),    // 20: common.SuccessMessage
 }
 var file_backend_Client_proto_depIdxs = []int32{
 	0,  // 0: backend.AvailableBlueprints.blueprints:type_name -> backend.AvailableBlueprint
 	2,  // 1: backend.AvailableLocations.locations:type_name -> backend.AvailableLocation
 	4,  // 2: backend.AvailableNodes.nodes:type_name -> backend.AvailableNode
-	10, // 3: backend.ServerList.servers:type_name -> backend.ServerInfo
-	11, // 4: backend.ServerInfo.main_allocation:type_name -> common.IPAllocation
-	12, // 5: backend.ServerInfo.resource_limit:type_name -> common.ResourceLimit
-	13, // 6: backend.ClientService.GetInfo:input_type -> common.Empty
-	13, // 7: backend.ClientService.GetServerList:input_type -> common.Empty
-	14, // 8: backend.ClientService.GetServer:input_type -> common.SimpleIDMessage
-	13, // 9: backend.ClientService.GetAvailableBlueprints:input_type -> common.Empty
-	13, // 10: backend.ClientService.GetAvailableLocations:input_type -> common.Empty
-	13, // 11: backend.ClientService.GetAvailableNodes:input_type -> common.Empty
-	6,  // 12: backend.ClientService.NewServer:input_type -> backend.NewServerRequest
-	8,  // 13: backend.ClientService.GetInfo:output_type -> backend.ClientInfo
-	9,  // 14: backend.ClientService.GetServerList:output_type -> backend.ServerList
-	10, // 15: backend.ClientService.GetServer:output_type -> backend.ServerInfo
-	1,  // 16: backend.ClientService.GetAvailableBlueprints:output_type -> backend.AvailableBlueprints
-	3,  // 17: backend.ClientService.GetAvailableLocations:output_type -> backend.AvailableLocations
-	5,  // 18: backend.ClientService.GetAvailableNodes:output_type -> backend.AvailableNodes
-	7,  // 19: backend.ClientService.NewServer:output_type -> backend.NewServerResponse
-	13, // [13:20] is the sub-list for method output_type
-	6,  // [6:13] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	14, // 3: backend.NewServerRequest.variables:type_name -> common.ServerVariable
+	10, // 4: backend.ServerList.servers:type_name -> backend.ServerInfo
+	15, // 5: backend.ServerInfo.main_allocation:type_name -> common.IPAllocation
+	16, // 6: backend.ServerInfo.resource_limit:type_name -> common.ResourceLimit
+	17, // 7: backend.ServerVariableInfo.variable:type_name -> common.BlueprintVariable
+	11, // 8: backend.ServerVariables.variables:type_name -> backend.ServerVariableInfo
+	14, // 9: backend.UpdateServerVariablesRequest.variables:type_name -> common.ServerVariable
+	18, // 10: backend.ClientService.GetInfo:input_type -> common.Empty
+	18, // 11: backend.ClientService.GetServerList:input_type -> common.Empty
+	19, // 12: backend.ClientService.GetServer:input_type -> common.SimpleIDMessage
+	18, // 13: backend.ClientService.GetAvailableBlueprints:input_type -> common.Empty
+	18, // 14: backend.ClientService.GetAvailableLocations:input_type -> common.Empty
+	18, // 15: backend.ClientService.GetAvailableNodes:input_type -> common.Empty
+	6,  // 16: backend.ClientService.NewServer:input_type -> backend.NewServerRequest
+	19, // 17: backend.ClientService.GetServerVariables:input_type -> common.SimpleIDMessage
+	13, // 18: backend.ClientService.UpdateServerVariables:input_type -> backend.UpdateServerVariablesRequest
+	8,  // 19: backend.ClientService.GetInfo:output_type -> backend.ClientInfo
+	9,  // 20: backend.ClientService.GetServerList:output_type -> backend.ServerList
+	10, // 21: backend.ClientService.GetServer:output_type -> backend.ServerInfo
+	1,  // 22: backend.ClientService.GetAvailableBlueprints:output_type -> backend.AvailableBlueprints
+	3,  // 23: backend.ClientService.GetAvailableLocations:output_type -> backend.AvailableLocations
+	5,  // 24: backend.ClientService.GetAvailableNodes:output_type -> backend.AvailableNodes
+	7,  // 25: backend.ClientService.NewServer:output_type -> backend.NewServerResponse
+	12, // 26: backend.ClientService.GetServerVariables:output_type -> backend.ServerVariables
+	20, // 27: backend.ClientService.UpdateServerVariables:output_type -> common.SuccessMessage
+	19, // [19:28] is the sub-list for method output_type
+	10, // [10:19] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_backend_Client_proto_init() }
@@ -794,7 +975,7 @@ func file_backend_Client_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_Client_proto_rawDesc), len(file_backend_Client_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
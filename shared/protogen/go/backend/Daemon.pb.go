@@ -67,18 +67,24 @@ func (x *RegisterDaemonRequest) GetNodeToken() string {
 }
 
 type Blueprint struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Bid                    string                 `protobuf:"bytes,1,opt,name=bid,proto3" json:"bid,omitempty"`
-	Version                uint32                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	Flags                  []string               `protobuf:"bytes,3,rep,name=flags,proto3" json:"flags,omitempty"`
-	DockerImages           []string               `protobuf:"bytes,4,rep,name=docker_images,json=dockerImages,proto3" json:"docker_images,omitempty"` // only the uri
-	BlockedFiles           []*BlockedFile         `protobuf:"bytes,5,rep,name=blocked_files,json=blockedFiles,proto3" json:"blocked_files,omitempty"`
-	ServerBinary           string                 `protobuf:"bytes,6,opt,name=server_binary,json=serverBinary,proto3" json:"server_binary,omitempty"`
-	StartCommand           string                 `protobuf:"bytes,7,opt,name=start_command,json=startCommand,proto3" json:"start_command,omitempty"`
-	StopCommand            string                 `protobuf:"bytes,8,opt,name=stop_command,json=stopCommand,proto3" json:"stop_command,omitempty"`
-	SetupScriptBase64      string                 `protobuf:"bytes,9,opt,name=setup_script_base64,json=setupScriptBase64,proto3" json:"setup_script_base64,omitempty"`
-	SetupDockerImage       string                 `protobuf:"bytes,10,opt,name=setup_docker_image,json=setupDockerImage,proto3" json:"setup_docker_image,omitempty"`
-	SetupScriptInterpreter string                 `protobuf:"bytes,11,opt,name=setup_script_interpreter,json=setupScriptInterpreter,proto3" json:"setup_script_interpreter,omitempty"`
+	state                  protoimpl.MessageState            `protogen:"open.v1"`
+	Bid                    string                            `protobuf:"bytes,1,opt,name=bid,proto3" json:"bid,omitempty"`
+	Version                uint32                            `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Flags                  []string                          `protobuf:"bytes,3,rep,name=flags,proto3" json:"flags,omitempty"`
+	DockerImages           []string                          `protobuf:"bytes,4,rep,name=docker_images,json=dockerImages,proto3" json:"docker_images,omitempty"` // only the uri
+	BlockedFiles           []*BlockedFile                    `protobuf:"bytes,5,rep,name=blocked_files,json=blockedFiles,proto3" json:"blocked_files,omitempty"`
+	ServerBinary           string                            `protobuf:"bytes,6,opt,name=server_binary,json=serverBinary,proto3" json:"server_binary,omitempty"`
+	StartCommand           string                            `protobuf:"bytes,7,opt,name=start_command,json=startCommand,proto3" json:"start_command,omitempty"`
+	StopCommand            string                            `protobuf:"bytes,8,opt,name=stop_command,json=stopCommand,proto3" json:"stop_command,omitempty"`
+	SetupScriptBase64      string                            `protobuf:"bytes,9,opt,name=setup_script_base64,json=setupScriptBase64,proto3" json:"setup_script_base64,omitempty"`
+	SetupDockerImage       string                            `protobuf:"bytes,10,opt,name=setup_docker_image,json=setupDockerImage,proto3" json:"setup_docker_image,omitempty"`
+	SetupScriptInterpreter string                            `protobuf:"bytes,11,opt,name=setup_script_interpreter,json=setupScriptInterpreter,proto3" json:"setup_script_interpreter,omitempty"`
+	StartupDonePattern     string                            `protobuf:"bytes,12,opt,name=startup_done_pattern,json=startupDonePattern,proto3" json:"startup_done_pattern,omitempty"` // regex matched against console output, server is considered online after the first match
+	Ports                  []*proto_gen_go.BlueprintPort     `protobuf:"bytes,13,rep,name=ports,proto3" json:"ports,omitempty"`                                                       // the server's allocations are bound to these in order
+	Variables              []*proto_gen_go.BlueprintVariable `protobuf:"bytes,14,rep,name=variables,proto3" json:"variables,omitempty"`
+	EntrypointShell        string                            `protobuf:"bytes,15,opt,name=entrypoint_shell,json=entrypointShell,proto3" json:"entrypoint_shell,omitempty"`             // e.g. /bin/sh, runs the start command with "<shell> -c", empty runs it directly
+	TerminalShell          string                            `protobuf:"bytes,16,opt,name=terminal_shell,json=terminalShell,proto3" json:"terminal_shell,omitempty"`                   // shell of terminal sessions, e.g. /bin/bash, empty uses the first of bash, sh and ash found in the container
+	SecurityRelaxations    []string                          `protobuf:"bytes,17,rep,name=security_relaxations,json=securityRelaxations,proto3" json:"security_relaxations,omitempty"` // loosening of the node security profile requested by the blueprint and approved by an admin
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -190,6 +196,48 @@ func (x *Blueprint) GetSetupScriptInterpreter() string {
 	return ""
 }
 
+func (x *Blueprint) GetStartupDonePattern() string {
+	if x != nil {
+		return x.StartupDonePattern
+	}
+	return ""
+}
+
+func (x *Blueprint) GetPorts() []*proto_gen_go.BlueprintPort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *Blueprint) GetVariables() []*proto_gen_go.BlueprintVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *Blueprint) GetEntrypointShell() string {
+	if x != nil {
+		return x.EntrypointShell
+	}
+	return ""
+}
+
+func (x *Blueprint) GetTerminalShell() string {
+	if x != nil {
+		return x.TerminalShell
+	}
+	return ""
+}
+
+func (x *Blueprint) GetSecurityRelaxations() []string {
+	if x != nil {
+		return x.SecurityRelaxations
+	}
+	return nil
+}
+
 type BlockedFile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	File          string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
@@ -251,14 +299,16 @@ func (x *BlockedFile) GetReadable() bool {
 }
 
 type Server struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Sid           string                       `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
-	OwnerId       string                       `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	UserIds       []string                     `protobuf:"bytes,3,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
-	Allocations   []*proto_gen_go.IPAllocation `protobuf:"bytes,4,rep,name=allocations,proto3" json:"allocations,omitempty"`
-	ResourceLimit *proto_gen_go.ResourceLimit  `protobuf:"bytes,5,opt,name=resource_limit,json=resourceLimit,proto3" json:"resource_limit,omitempty"`
-	DockerImage   string                       `protobuf:"bytes,6,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
-	Bid           string                       `protobuf:"bytes,7,opt,name=bid,proto3" json:"bid,omitempty"`
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Sid           string                         `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+	OwnerId       string                         `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	UserIds       []string                       `protobuf:"bytes,3,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Allocations   []*proto_gen_go.IPAllocation   `protobuf:"bytes,4,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	ResourceLimit *proto_gen_go.ResourceLimit    `protobuf:"bytes,5,opt,name=resource_limit,json=resourceLimit,proto3" json:"resource_limit,omitempty"`
+	DockerImage   string                         `protobuf:"bytes,6,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
+	Bid           string                         `protobuf:"bytes,7,opt,name=bid,proto3" json:"bid,omitempty"`
+	RestartPolicy proto_gen_go.RestartPolicy     `protobuf:"varint,8,opt,name=restart_policy,json=restartPolicy,proto3,enum=common.RestartPolicy" json:"restart_policy,omitempty"`
+	Variables     []*proto_gen_go.ServerVariable `protobuf:"bytes,9,rep,name=variables,proto3" json:"variables,omitempty"` // values of the blueprint's variables, missing ones use the default
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -342,6 +392,20 @@ func (x *Server) GetBid() string {
 	return ""
 }
 
+func (x *Server) GetRestartPolicy() proto_gen_go.RestartPolicy {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return proto_gen_go.RestartPolicy(0)
+}
+
+func (x *Server) GetVariables() []*proto_gen_go.ServerVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
 var File_backend_Daemon_proto protoreflect.FileDescriptor
 
 const file_backend_Daemon_proto_rawDesc = "" +
@@ -349,7 +413,7 @@ const file_backend_Daemon_proto_rawDesc = "" +
 	"\x14backend/Daemon.proto\x12\abackend\x1a\fcommon.proto\"6\n" +
 	"\x15RegisterDaemonRequest\x12\x1d\n" +
 	"\n" +
-	"node_token\x18\x01 \x01(\tR\tnodeToken\"\xb2\x03\n" +
+	"node_token\x18\x01 \x01(\tR\tnodeToken\"\xcf\x05\n" +
 	"\tBlueprint\x12\x10\n" +
 	"\x03bid\x18\x01 \x01(\tR\x03bid\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\rR\aversion\x12\x14\n" +
@@ -362,11 +426,17 @@ const file_backend_Daemon_proto_rawDesc = "" +
 	"\x13setup_script_base64\x18\t \x01(\tR\x11setupScriptBase64\x12,\n" +
 	"\x12setup_docker_image\x18\n" +
 	" \x01(\tR\x10setupDockerImage\x128\n" +
-	"\x18setup_script_interpreter\x18\v \x01(\tR\x16setupScriptInterpreter\"W\n" +
+	"\x18setup_script_interpreter\x18\v \x01(\tR\x16setupScriptInterpreter\x120\n" +
+	"\x14startup_done_pattern\x18\f \x01(\tR\x12startupDonePattern\x12+\n" +
+	"\x05ports\x18\r \x03(\v2\x15.common.BlueprintPortR\x05ports\x127\n" +
+	"\tvariables\x18\x0e \x03(\v2\x19.common.BlueprintVariableR\tvariables\x12)\n" +
+	"\x10entrypoint_shell\x18\x0f \x01(\tR\x0fentrypointShell\x12%\n" +
+	"\x0eterminal_shell\x18\x10 \x01(\tR\rterminalShell\x121\n" +
+	"\x14security_relaxations\x18\x11 \x03(\tR\x13securityRelaxations\"W\n" +
 	"\vBlockedFile\x12\x12\n" +
 	"\x04file\x18\x01 \x01(\tR\x04file\x12\x18\n" +
 	"\avisible\x18\x02 \x01(\bR\avisible\x12\x1a\n" +
-	"\breadable\x18\x03 \x01(\bR\breadable\"\xfb\x01\n" +
+	"\breadable\x18\x03 \x01(\bR\breadable\"\xef\x02\n" +
 	"\x06Server\x12\x10\n" +
 	"\x03sid\x18\x01 \x01(\tR\x03sid\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x19\n" +
@@ -374,7 +444,9 @@ const file_backend_Daemon_proto_rawDesc = "" +
 	"\vallocations\x18\x04 \x03(\v2\x14.common.IPAllocationR\vallocations\x12<\n" +
 	"\x0eresource_limit\x18\x05 \x01(\v2\x15.common.ResourceLimitR\rresourceLimit\x12!\n" +
 	"\fdocker_image\x18\x06 \x01(\tR\vdockerImage\x12\x10\n" +
-	"\x03bid\x18\a \x01(\tR\x03bid2\xb5\x02\n" +
+	"\x03bid\x18\a \x01(\tR\x03bid\x12<\n" +
+	"\x0erestart_policy\x18\b \x01(\x0e2\x15.common.RestartPolicyR\rrestartPolicy\x124\n" +
+	"\tvariables\x18\t \x03(\v2\x16.common.ServerVariableR\tvariables2\xb5\x02\n" +
 	"\rDaemonService\x12H\n" +
 	"\x0eRegisterDaemon\x12\x1e.backend.RegisterDaemonRequest\x1a\x16.common.SuccessMessage\x125\n" +
 	"\x0eSyncBlueprints\x12\r.common.Empty\x1a\x12.backend.Blueprint0\x01\x12;\n" +
@@ -396,35 +468,43 @@ func file_backend_Daemon_proto_rawDescGZIP() []byte {
 
 var file_backend_Daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_backend_Daemon_proto_goTypes = []any{
-	(*RegisterDaemonRequest)(nil),        // 0: backend.RegisterDaemonRequest
-	(*Blueprint)(nil),                    // 1: backend.Blueprint
-	(*BlockedFile)(nil),                  // 2: backend.BlockedFile
-	(*Server)(nil),                       // 3: backend.Server
-	(*proto_gen_go.IPAllocation)(nil),    // 4: common.IPAllocation
-	(*proto_gen_go.ResourceLimit)(nil),   // 5: common.ResourceLimit
-	(*proto_gen_go.Empty)(nil),           // 6: common.Empty
-	(*proto_gen_go.SimpleIDMessage)(nil), // 7: common.SimpleIDMessage
-	(*proto_gen_go.SuccessMessage)(nil),  // 8: common.SuccessMessage
+	(*RegisterDaemonRequest)(nil),          // 0: backend.RegisterDaemonRequest
+	(*Blueprint)(nil),                      // 1: backend.Blueprint
+	(*BlockedFile)(nil),                    // 2: backend.BlockedFile
+	(*Server)(nil),                         // 3: backend.Server
+	(*proto_gen_go.BlueprintPort)(nil),     // 4: common.BlueprintPort
+	(*proto_gen_go.BlueprintVariable)(nil), // 5: common.BlueprintVariable
+	(*proto_gen_go.IPAllocation)(nil),      // 6: common.IPAllocation
+	(*proto_gen_go.ResourceLimit)(nil),     // 7: common.ResourceLimit
+	(proto_gen_go.RestartPolicy)(0),        // 8: common.RestartPolicy
+	(*proto_gen_go.ServerVariable)(nil),    // 9: common.ServerVariable
+	(*proto_gen_go.Empty)(nil),             // 10: common.Empty
+	(*proto_gen_go.SimpleIDMessage)(nil),   // 11: common.SimpleIDMessage
+	(*proto_gen_go.SuccessMessage)(nil),    // 12: common.SuccessMessage
 }
 var file_backend_Daemon_proto_depIdxs = []int32{
-	2, // 0: backend.Blueprint.blocked_files:type_name -> backend.BlockedFile
-	4, // 1: backend.Server.allocations:type_name -> common.IPAllocation
-	5, // 2: backend.Server.resource_limit:type_name -> common.ResourceLimit
-	0, // 3: backend.DaemonService.RegisterDaemon:input_type -> backend.RegisterDaemonRequest
-	6, // 4: backend.DaemonService.SyncBlueprints:input_type -> common.Empty
-	7, // 5: backend.DaemonService.GetBlueprint:input_type -> common.SimpleIDMessage
-	6, // 6: backend.DaemonService.SyncServers:input_type -> common.Empty
-	7, // 7: backend.DaemonService.GetServer:input_type -> common.SimpleIDMessage
-	8, // 8: backend.DaemonService.RegisterDaemon:output_type -> common.SuccessMessage
-	1, // 9: backend.DaemonService.SyncBlueprints:output_type -> backend.Blueprint
-	1, // 10: backend.DaemonService.GetBlueprint:output_type -> backend.Blueprint
-	3, // 11: backend.DaemonService.SyncServers:output_type -> backend.Server
-	3, // 12: backend.DaemonService.GetServer:output_type -> backend.Server
-	8, // [8:13] is the sub-list for method output_type
-	3, // [3:8] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2,  // 0: backend.Blueprint.blocked_files:type_name -> backend.BlockedFile
+	4,  // 1: backend.Blueprint.ports:type_name -> common.BlueprintPort
+	5,  // 2: backend.Blueprint.variables:type_name -> common.BlueprintVariable
+	6,  // 3: backend.Server.allocations:type_name -> common.IPAllocation
+	7,  // 4: backend.Server.resource_limit:type_name -> common.ResourceLimit
+	8,  // 5: backend.Server.restart_policy:type_name -> common.RestartPolicy
+	9,  // 6: backend.Server.variables:type_name -> common.ServerVariable
+	0,  // 7: backend.DaemonService.RegisterDaemon:input_type -> backend.RegisterDaemonRequest
+	10, // 8: backend.DaemonService.SyncBlueprints:input_type -> common.Empty
+	11, // 9: backend.DaemonService.GetBlueprint:input_type -> common.SimpleIDMessage
+	10, // 10: backend.DaemonService.SyncServers:input_type -> common.Empty
+	11, // 11: backend.DaemonService.GetServer:input_type -> common.SimpleIDMessage
+	12, // 12: backend.DaemonService.RegisterDaemon:output_type -> common.SuccessMessage
+	1,  // 13: backend.DaemonService.SyncBlueprints:output_type -> backend.Blueprint
+	1,  // 14: backend.DaemonService.GetBlueprint:output_type -> backend.Blueprint
+	3,  // 15: backend.DaemonService.SyncServers:output_type -> backend.Server
+	3,  // 16: backend.DaemonService.GetServer:output_type -> backend.Server
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_backend_Daemon_proto_init() }
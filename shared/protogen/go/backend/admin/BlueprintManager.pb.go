@@ -135,25 +135,32 @@ func (x *BlockedFile) GetReadable() bool {
 }
 
 type Blueprint struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	FormatVersion          uint32                 `protobuf:"varint,1,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
-	Bid                    string                 `protobuf:"bytes,2,opt,name=bid,proto3" json:"bid,omitempty"`
-	Version                uint32                 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	UpdateUrl              string                 `protobuf:"bytes,4,opt,name=update_url,json=updateUrl,proto3" json:"update_url,omitempty"`
-	Name                   string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Description            string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	Category               string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
-	Icon                   string                 `protobuf:"bytes,8,opt,name=icon,proto3" json:"icon,omitempty"`
-	Banner                 string                 `protobuf:"bytes,9,opt,name=banner,proto3" json:"banner,omitempty"`
-	Flags                  []string               `protobuf:"bytes,10,rep,name=flags,proto3" json:"flags,omitempty"`
-	DockerImages           []*DockerImage         `protobuf:"bytes,11,rep,name=docker_images,json=dockerImages,proto3" json:"docker_images,omitempty"`
-	BlockedFiles           []*BlockedFile         `protobuf:"bytes,12,rep,name=blocked_files,json=blockedFiles,proto3" json:"blocked_files,omitempty"`
-	ServerBinary           string                 `protobuf:"bytes,13,opt,name=server_binary,json=serverBinary,proto3" json:"server_binary,omitempty"`
-	StartCommand           string                 `protobuf:"bytes,14,opt,name=start_command,json=startCommand,proto3" json:"start_command,omitempty"`
-	StopCommand            string                 `protobuf:"bytes,15,opt,name=stop_command,json=stopCommand,proto3" json:"stop_command,omitempty"`
-	SetupScriptBase64      string                 `protobuf:"bytes,16,opt,name=setup_script_base64,json=setupScriptBase64,proto3" json:"setup_script_base64,omitempty"`
-	SetupDockerImage       string                 `protobuf:"bytes,17,opt,name=setup_docker_image,json=setupDockerImage,proto3" json:"setup_docker_image,omitempty"`
-	SetupScriptInterpreter string                 `protobuf:"bytes,18,opt,name=setup_script_interpreter,json=setupScriptInterpreter,proto3" json:"setup_script_interpreter,omitempty"`
+	state                  protoimpl.MessageState            `protogen:"open.v1"`
+	FormatVersion          uint32                            `protobuf:"varint,1,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	Bid                    string                            `protobuf:"bytes,2,opt,name=bid,proto3" json:"bid,omitempty"`
+	Version                uint32                            `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	UpdateUrl              string                            `protobuf:"bytes,4,opt,name=update_url,json=updateUrl,proto3" json:"update_url,omitempty"`
+	Name                   string                            `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Description            string                            `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Category               string                            `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Icon                   string                            `protobuf:"bytes,8,opt,name=icon,proto3" json:"icon,omitempty"`
+	Banner                 string                            `protobuf:"bytes,9,opt,name=banner,proto3" json:"banner,omitempty"`
+	Flags                  []string                          `protobuf:"bytes,10,rep,name=flags,proto3" json:"flags,omitempty"`
+	DockerImages           []*DockerImage                    `protobuf:"bytes,11,rep,name=docker_images,json=dockerImages,proto3" json:"docker_images,omitempty"`
+	BlockedFiles           []*BlockedFile                    `protobuf:"bytes,12,rep,name=blocked_files,json=blockedFiles,proto3" json:"blocked_files,omitempty"`
+	ServerBinary           string                            `protobuf:"bytes,13,opt,name=server_binary,json=serverBinary,proto3" json:"server_binary,omitempty"`
+	StartCommand           string                            `protobuf:"bytes,14,opt,name=start_command,json=startCommand,proto3" json:"start_command,omitempty"`
+	StopCommand            string                            `protobuf:"bytes,15,opt,name=stop_command,json=stopCommand,proto3" json:"stop_command,omitempty"`
+	SetupScriptBase64      string                            `protobuf:"bytes,16,opt,name=setup_script_base64,json=setupScriptBase64,proto3" json:"setup_script_base64,omitempty"`
+	SetupDockerImage       string                            `protobuf:"bytes,17,opt,name=setup_docker_image,json=setupDockerImage,proto3" json:"setup_docker_image,omitempty"`
+	SetupScriptInterpreter string                            `protobuf:"bytes,18,opt,name=setup_script_interpreter,json=setupScriptInterpreter,proto3" json:"setup_script_interpreter,omitempty"`
+	StartupDonePattern     string                            `protobuf:"bytes,19,opt,name=startup_done_pattern,json=startupDonePattern,proto3" json:"startup_done_pattern,omitempty"`
+	Ports                  []*proto_gen_go.BlueprintPort     `protobuf:"bytes,20,rep,name=ports,proto3" json:"ports,omitempty"`
+	Variables              []*proto_gen_go.BlueprintVariable `protobuf:"bytes,21,rep,name=variables,proto3" json:"variables,omitempty"`
+	EntrypointShell        string                            `protobuf:"bytes,22,opt,name=entrypoint_shell,json=entrypointShell,proto3" json:"entrypoint_shell,omitempty"`
+	TerminalShell          string                            `protobuf:"bytes,23,opt,name=terminal_shell,json=terminalShell,proto3" json:"terminal_shell,omitempty"`
+	SecurityRelaxations    []string                          `protobuf:"bytes,24,rep,name=security_relaxations,json=securityRelaxations,proto3" json:"security_relaxations,omitempty"` // requested loosening of the node security profile, e.g. writable_rootfs or cap:NET_RAW
+	ApprovedRelaxations    []string                          `protobuf:"bytes,25,rep,name=approved_relaxations,json=approvedRelaxations,proto3" json:"approved_relaxations,omitempty"` // the requested relaxations an admin approved, only these are applied, ignored on create and update
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -314,6 +321,55 @@ func (x *Blueprint) GetSetupScriptInterpreter() string {
 	return ""
 }
 
+func (x *Blueprint) GetStartupDonePattern() string {
+	if x != nil {
+		return x.StartupDonePattern
+	}
+	return ""
+}
+
+func (x *Blueprint) GetPorts() []*proto_gen_go.BlueprintPort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *Blueprint) GetVariables() []*proto_gen_go.BlueprintVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *Blueprint) GetEntrypointShell() string {
+	if x != nil {
+		return x.EntrypointShell
+	}
+	return ""
+}
+
+func (x *Blueprint) GetTerminalShell() string {
+	if x != nil {
+		return x.TerminalShell
+	}
+	return ""
+}
+
+func (x *Blueprint) GetSecurityRelaxations() []string {
+	if x != nil {
+		return x.SecurityRelaxations
+	}
+	return nil
+}
+
+func (x *Blueprint) GetApprovedRelaxations() []string {
+	if x != nil {
+		return x.ApprovedRelaxations
+	}
+	return nil
+}
+
 type GetBlueprintsRequest struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Pagination    *proto_gen_go.Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -838,6 +894,102 @@ func (x *DeleteBlueprintResponse) GetSuccess() bool {
 	return false
 }
 
+type ApproveBlueprintRelaxationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bid           string                 `protobuf:"bytes,1,opt,name=bid,proto3" json:"bid,omitempty"`
+	Relaxations   []string               `protobuf:"bytes,2,rep,name=relaxations,proto3" json:"relaxations,omitempty"` // replaces the approved relaxations, all of them have to be requested by the blueprint
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveBlueprintRelaxationsRequest) Reset() {
+	*x = ApproveBlueprintRelaxationsRequest{}
+	mi := &file_backend_admin_BlueprintManager_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveBlueprintRelaxationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveBlueprintRelaxationsRequest) ProtoMessage() {}
+
+func (x *ApproveBlueprintRelaxationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_admin_BlueprintManager_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveBlueprintRelaxationsRequest.ProtoReflect.Descriptor instead.
+func (*ApproveBlueprintRelaxationsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_admin_BlueprintManager_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApproveBlueprintRelaxationsRequest) GetBid() string {
+	if x != nil {
+		return x.Bid
+	}
+	return ""
+}
+
+func (x *ApproveBlueprintRelaxationsRequest) GetRelaxations() []string {
+	if x != nil {
+		return x.Relaxations
+	}
+	return nil
+}
+
+type ApproveBlueprintRelaxationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveBlueprintRelaxationsResponse) Reset() {
+	*x = ApproveBlueprintRelaxationsResponse{}
+	mi := &file_backend_admin_BlueprintManager_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveBlueprintRelaxationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveBlueprintRelaxationsResponse) ProtoMessage() {}
+
+func (x *ApproveBlueprintRelaxationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_admin_BlueprintManager_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveBlueprintRelaxationsResponse.ProtoReflect.Descriptor instead.
+func (*ApproveBlueprintRelaxationsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_admin_BlueprintManager_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApproveBlueprintRelaxationsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 var File_backend_admin_BlueprintManager_proto protoreflect.FileDescriptor
 
 const file_backend_admin_BlueprintManager_proto_rawDesc = "" +
@@ -849,7 +1001,7 @@ const file_backend_admin_BlueprintManager_proto_rawDesc = "" +
 	"\vBlockedFile\x12\x12\n" +
 	"\x04file\x18\x01 \x01(\tR\x04file\x12\x18\n" +
 	"\avisible\x18\x02 \x01(\bR\avisible\x12\x1a\n" +
-	"\breadable\x18\x03 \x01(\bR\breadable\"\x98\x05\n" +
+	"\breadable\x18\x03 \x01(\bR\breadable\"\xe8\a\n" +
 	"\tBlueprint\x12%\n" +
 	"\x0eformat_version\x18\x01 \x01(\rR\rformatVersion\x12\x10\n" +
 	"\x03bid\x18\x02 \x01(\tR\x03bid\x12\x18\n" +
@@ -870,7 +1022,14 @@ const file_backend_admin_BlueprintManager_proto_rawDesc = "" +
 	"\fstop_command\x18\x0f \x01(\tR\vstopCommand\x12.\n" +
 	"\x13setup_script_base64\x18\x10 \x01(\tR\x11setupScriptBase64\x12,\n" +
 	"\x12setup_docker_image\x18\x11 \x01(\tR\x10setupDockerImage\x128\n" +
-	"\x18setup_script_interpreter\x18\x12 \x01(\tR\x16setupScriptInterpreter\"J\n" +
+	"\x18setup_script_interpreter\x18\x12 \x01(\tR\x16setupScriptInterpreter\x120\n" +
+	"\x14startup_done_pattern\x18\x13 \x01(\tR\x12startupDonePattern\x12+\n" +
+	"\x05ports\x18\x14 \x03(\v2\x15.common.BlueprintPortR\x05ports\x127\n" +
+	"\tvariables\x18\x15 \x03(\v2\x19.common.BlueprintVariableR\tvariables\x12)\n" +
+	"\x10entrypoint_shell\x18\x16 \x01(\tR\x0fentrypointShell\x12%\n" +
+	"\x0eterminal_shell\x18\x17 \x01(\tR\rterminalShell\x121\n" +
+	"\x14security_relaxations\x18\x18 \x03(\tR\x13securityRelaxations\x121\n" +
+	"\x14approved_relaxations\x18\x19 \x03(\tR\x13approvedRelaxations\"J\n" +
 	"\x14GetBlueprintsRequest\x122\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x12.common.PaginationR\n" +
@@ -901,13 +1060,19 @@ const file_backend_admin_BlueprintManager_proto_rawDesc = "" +
 	"\x16DeleteBlueprintRequest\x12\x10\n" +
 	"\x03bid\x18\x01 \x01(\tR\x03bid\"3\n" +
 	"\x17DeleteBlueprintResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xf4\x03\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"X\n" +
+	"\"ApproveBlueprintRelaxationsRequest\x12\x10\n" +
+	"\x03bid\x18\x01 \x01(\tR\x03bid\x12 \n" +
+	"\vrelaxations\x18\x02 \x03(\tR\vrelaxations\"?\n" +
+	"#ApproveBlueprintRelaxationsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xfb\x04\n" +
 	"\x17BlueprintManagerService\x12Z\n" +
 	"\rGetBlueprints\x12#.backend_admin.GetBlueprintsRequest\x1a$.backend_admin.GetBlueprintsResponse\x12W\n" +
 	"\fGetBlueprint\x12\".backend_admin.GetBlueprintRequest\x1a#.backend_admin.GetBlueprintResponse\x12`\n" +
 	"\x0fCreateBlueprint\x12%.backend_admin.CreateBlueprintRequest\x1a&.backend_admin.CreateBlueprintResponse\x12`\n" +
 	"\x0fUpdateBlueprint\x12%.backend_admin.UpdateBlueprintRequest\x1a&.backend_admin.UpdateBlueprintResponse\x12`\n" +
-	"\x0fDeleteBlueprint\x12%.backend_admin.DeleteBlueprintRequest\x1a&.backend_admin.DeleteBlueprintResponseB%Z#panelium/proto_gen_go/backend/adminb\x06proto3"
+	"\x0fDeleteBlueprint\x12%.backend_admin.DeleteBlueprintRequest\x1a&.backend_admin.DeleteBlueprintResponse\x12\x84\x01\n" +
+	"\x1bApproveBlueprintRelaxations\x121.backend_admin.ApproveBlueprintRelaxationsRequest\x1a2.backend_admin.ApproveBlueprintRelaxationsResponseB%Z#panelium/proto_gen_go/backend/adminb\x06proto3"
 
 var (
 	file_backend_admin_BlueprintManager_proto_rawDescOnce sync.Once
@@ -921,47 +1086,55 @@ func file_backend_admin_BlueprintManager_proto_rawDescGZIP() []byte {
 	return file_backend_admin_BlueprintManager_proto_rawDescData
 }
 
-var file_backend_admin_BlueprintManager_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_backend_admin_BlueprintManager_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_backend_admin_BlueprintManager_proto_goTypes = []any{
-	(*DockerImage)(nil),             // 0: backend_admin.DockerImage
-	(*BlockedFile)(nil),             // 1: backend_admin.BlockedFile
-	(*Blueprint)(nil),               // 2: backend_admin.Blueprint
-	(*GetBlueprintsRequest)(nil),    // 3: backend_admin.GetBlueprintsRequest
-	(*GetBlueprintsResponse)(nil),   // 4: backend_admin.GetBlueprintsResponse
-	(*GetBlueprintRequest)(nil),     // 5: backend_admin.GetBlueprintRequest
-	(*GetBlueprintResponse)(nil),    // 6: backend_admin.GetBlueprintResponse
-	(*CreateBlueprintRequest)(nil),  // 7: backend_admin.CreateBlueprintRequest
-	(*CreateBlueprintResponse)(nil), // 8: backend_admin.CreateBlueprintResponse
-	(*UpdateBlueprintRequest)(nil),  // 9: backend_admin.UpdateBlueprintRequest
-	(*UpdateBlueprintResponse)(nil), // 10: backend_admin.UpdateBlueprintResponse
-	(*DeleteBlueprintRequest)(nil),  // 11: backend_admin.DeleteBlueprintRequest
-	(*DeleteBlueprintResponse)(nil), // 12: backend_admin.DeleteBlueprintResponse
-	(*proto_gen_go.Pagination)(nil), // 13: common.Pagination
+	(*DockerImage)(nil),                         // 0: backend_admin.DockerImage
+	(*BlockedFile)(nil),                         // 1: backend_admin.BlockedFile
+	(*Blueprint)(nil),                           // 2: backend_admin.Blueprint
+	(*GetBlueprintsRequest)(nil),                // 3: backend_admin.GetBlueprintsRequest
+	(*GetBlueprintsResponse)(nil),               // 4: backend_admin.GetBlueprintsResponse
+	(*GetBlueprintRequest)(nil),                 // 5: backend_admin.GetBlueprintRequest
+	(*GetBlueprintResponse)(nil),                // 6: backend_admin.GetBlueprintResponse
+	(*CreateBlueprintRequest)(nil),              // 7: backend_admin.CreateBlueprintRequest
+	(*CreateBlueprintResponse)(nil),             // 8: backend_admin.CreateBlueprintResponse
+	(*UpdateBlueprintRequest)(nil),              // 9: backend_admin.UpdateBlueprintRequest
+	(*UpdateBlueprintResponse)(nil),             // 10: backend_admin.UpdateBlueprintResponse
+	(*DeleteBlueprintRequest)(nil),              // 11: backend_admin.DeleteBlueprintRequest
+	(*DeleteBlueprintResponse)(nil),             // 12: backend_admin.DeleteBlueprintResponse
+	(*ApproveBlueprintRelaxationsRequest)(nil),  // 13: backend_admin.ApproveBlueprintRelaxationsRequest
+	(*ApproveBlueprintRelaxationsResponse)(nil), // 14: backend_admin.ApproveBlueprintRelaxationsResponse
+	(*proto_gen_go.BlueprintPort)(nil),          // 15: common.BlueprintPort
+	(*proto_gen_go.BlueprintVariable)(nil),      // 16: common.BlueprintVariable
+	(*proto_gen_go.Pagination)(nil),             // 17: common.Pagination
 }
 var file_backend_admin_BlueprintManager_proto_depIdxs = []int32{
 	0,  // 0: backend_admin.Blueprint.docker_images:type_name -> backend_admin.DockerImage
 	1,  // 1: backend_admin.Blueprint.blocked_files:type_name -> backend_admin.BlockedFile
-	13, // 2: backend_admin.GetBlueprintsRequest.pagination:type_name -> common.Pagination
-	2,  // 3: backend_admin.GetBlueprintsResponse.blueprints:type_name -> backend_admin.Blueprint
-	13, // 4: backend_admin.GetBlueprintsResponse.pagination:type_name -> common.Pagination
-	2,  // 5: backend_admin.GetBlueprintResponse.blueprint:type_name -> backend_admin.Blueprint
-	2,  // 6: backend_admin.CreateBlueprintRequest.blueprint:type_name -> backend_admin.Blueprint
-	2,  // 7: backend_admin.UpdateBlueprintRequest.blueprint:type_name -> backend_admin.Blueprint
-	3,  // 8: backend_admin.BlueprintManagerService.GetBlueprints:input_type -> backend_admin.GetBlueprintsRequest
-	5,  // 9: backend_admin.BlueprintManagerService.GetBlueprint:input_type -> backend_admin.GetBlueprintRequest
-	7,  // 10: backend_admin.BlueprintManagerService.CreateBlueprint:input_type -> backend_admin.CreateBlueprintRequest
-	9,  // 11: backend_admin.BlueprintManagerService.UpdateBlueprint:input_type -> backend_admin.UpdateBlueprintRequest
-	11, // 12: backend_admin.BlueprintManagerService.DeleteBlueprint:input_type -> backend_admin.DeleteBlueprintRequest
-	4,  // 13: backend_admin.BlueprintManagerService.GetBlueprints:output_type -> backend_admin.GetBlueprintsResponse
-	6,  // 14: backend_admin.BlueprintManagerService.GetBlueprint:output_type -> backend_admin.GetBlueprintResponse
-	8,  // 15: backend_admin.BlueprintManagerService.CreateBlueprint:output_type -> backend_admin.CreateBlueprintResponse
-	10, // 16: backend_admin.BlueprintManagerService.UpdateBlueprint:output_type -> backend_admin.UpdateBlueprintResponse
-	12, // 17: backend_admin.BlueprintManagerService.DeleteBlueprint:output_type -> backend_admin.DeleteBlueprintResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	15, // 2: backend_admin.Blueprint.ports:type_name -> common.BlueprintPort
+	16, // 3: backend_admin.Blueprint.variables:type_name -> common.BlueprintVariable
+	17, // 4: backend_admin.GetBlueprintsRequest.pagination:type_name -> common.Pagination
+	2,  // 5: backend_admin.GetBlueprintsResponse.blueprints:type_name -> backend_admin.Blueprint
+	17, // 6: backend_admin.GetBlueprintsResponse.pagination:type_name -> common.Pagination
+	2,  // 7: backend_admin.GetBlueprintResponse.blueprint:type_name -> backend_admin.Blueprint
+	2,  // 8: backend_admin.CreateBlueprintRequest.blueprint:type_name -> backend_admin.Blueprint
+	2,  // 9: backend_admin.UpdateBlueprintRequest.blueprint:type_name -> backend_admin.Blueprint
+	3,  // 10: backend_admin.BlueprintManagerService.GetBlueprints:input_type -> backend_admin.GetBlueprintsRequest
+	5,  // 11: backend_admin.BlueprintManagerService.GetBlueprint:input_type -> backend_admin.GetBlueprintRequest
+	7,  // 12: backend_admin.BlueprintManagerService.CreateBlueprint:input_type -> backend_admin.CreateBlueprintRequest
+	9,  // 13: backend_admin.BlueprintManagerService.UpdateBlueprint:input_type -> backend_admin.UpdateBlueprintRequest
+	11, // 14: backend_admin.BlueprintManagerService.DeleteBlueprint:input_type -> backend_admin.DeleteBlueprintRequest
+	13, // 15: backend_admin.BlueprintManagerService.ApproveBlueprintRelaxations:input_type -> backend_admin.ApproveBlueprintRelaxationsRequest
+	4,  // 16: backend_admin.BlueprintManagerService.GetBlueprints:output_type -> backend_admin.GetBlueprintsResponse
+	6,  // 17: backend_admin.BlueprintManagerService.GetBlueprint:output_type -> backend_admin.GetBlueprintResponse
+	8,  // 18: backend_admin.BlueprintManagerService.CreateBlueprint:output_type -> backend_admin.CreateBlueprintResponse
+	10, // 19: backend_admin.BlueprintManagerService.UpdateBlueprint:output_type -> backend_admin.UpdateBlueprintResponse
+	12, // 20: backend_admin.BlueprintManagerService.DeleteBlueprint:output_type -> backend_admin.DeleteBlueprintResponse
+	14, // 21: backend_admin.BlueprintManagerService.ApproveBlueprintRelaxations:output_type -> backend_admin.ApproveBlueprintRelaxationsResponse
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_backend_admin_BlueprintManager_proto_init() }
@@ -983,7 +1156,7 @@ func file_backend_admin_BlueprintManager_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_admin_BlueprintManager_proto_rawDesc), len(file_backend_admin_BlueprintManager_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
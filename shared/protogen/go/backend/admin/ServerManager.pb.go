@@ -9,6 +9,7 @@ package admin
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	proto_gen_go "panelium/proto_gen_go"
 	reflect "reflect"
 	sync "sync"
@@ -23,16 +24,18 @@ const (
 )
 
 type Server struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Sid           string                      `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"` // ignored with Create
-	Name          string                      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                      `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	OwnerUid      string                      `protobuf:"bytes,4,opt,name=owner_uid,json=ownerUid,proto3" json:"owner_uid,omitempty"`
-	Nid           string                      `protobuf:"bytes,5,opt,name=nid,proto3" json:"nid,omitempty"`
-	Uids          []string                    `protobuf:"bytes,6,rep,name=uids,proto3" json:"uids,omitempty"`
-	ResourceLimit *proto_gen_go.ResourceLimit `protobuf:"bytes,7,opt,name=resource_limit,json=resourceLimit,proto3" json:"resource_limit,omitempty"`
-	DockerImage   string                      `protobuf:"bytes,8,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
-	Bid           string                      `protobuf:"bytes,9,opt,name=bid,proto3" json:"bid,omitempty"`
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Sid           string                         `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"` // ignored with Create
+	Name          string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	OwnerUid      string                         `protobuf:"bytes,4,opt,name=owner_uid,json=ownerUid,proto3" json:"owner_uid,omitempty"`
+	Nid           string                         `protobuf:"bytes,5,opt,name=nid,proto3" json:"nid,omitempty"`
+	Uids          []string                       `protobuf:"bytes,6,rep,name=uids,proto3" json:"uids,omitempty"`
+	ResourceLimit *proto_gen_go.ResourceLimit    `protobuf:"bytes,7,opt,name=resource_limit,json=resourceLimit,proto3" json:"resource_limit,omitempty"`
+	DockerImage   string                         `protobuf:"bytes,8,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
+	Bid           string                         `protobuf:"bytes,9,opt,name=bid,proto3" json:"bid,omitempty"`
+	RestartPolicy proto_gen_go.RestartPolicy     `protobuf:"varint,10,opt,name=restart_policy,json=restartPolicy,proto3,enum=common.RestartPolicy" json:"restart_policy,omitempty"`
+	Variables     []*proto_gen_go.ServerVariable `protobuf:"bytes,11,rep,name=variables,proto3" json:"variables,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -130,6 +133,20 @@ func (x *Server) GetBid() string {
 	return ""
 }
 
+func (x *Server) GetRestartPolicy() proto_gen_go.RestartPolicy {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return proto_gen_go.RestartPolicy(0)
+}
+
+func (x *Server) GetVariables() []*proto_gen_go.ServerVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
 type GetServersRequest struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Pagination    *proto_gen_go.Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -618,11 +635,287 @@ func (x *DeleteServerResponse) GetSuccess() bool {
 	return false
 }
 
+type TerminalSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sid           string                 `protobuf:"bytes,2,opt,name=sid,proto3" json:"sid,omitempty"`
+	OwnerUid      string                 `protobuf:"bytes,3,opt,name=owner_uid,json=ownerUid,proto3" json:"owner_uid,omitempty"` // user who started it, empty for sessions started without user authentication
+	Shell         string                 `protobuf:"bytes,4,opt,name=shell,proto3" json:"shell,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	LastActivity  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_activity,json=lastActivity,proto3" json:"last_activity,omitempty"`
+	Attached      uint32                 `protobuf:"varint,7,opt,name=attached,proto3" json:"attached,omitempty"` // streams currently attached
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalSession) Reset() {
+	*x = TerminalSession{}
+	mi := &file_backend_admin_ServerManager_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalSession) ProtoMessage() {}
+
+func (x *TerminalSession) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_admin_ServerManager_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalSession.ProtoReflect.Descriptor instead.
+func (*TerminalSession) Descriptor() ([]byte, []int) {
+	return file_backend_admin_ServerManager_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TerminalSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TerminalSession) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *TerminalSession) GetOwnerUid() string {
+	if x != nil {
+		return x.OwnerUid
+	}
+	return ""
+}
+
+func (x *TerminalSession) GetShell() string {
+	if x != nil {
+		return x.Shell
+	}
+	return ""
+}
+
+func (x *TerminalSession) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *TerminalSession) GetLastActivity() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivity
+	}
+	return nil
+}
+
+func (x *TerminalSession) GetAttached() uint32 {
+	if x != nil {
+		return x.Attached
+	}
+	return 0
+}
+
+type ListTerminalSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sid           string                 `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTerminalSessionsRequest) Reset() {
+	*x = ListTerminalSessionsRequest{}
+	mi := &file_backend_admin_ServerManager_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTerminalSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTerminalSessionsRequest) ProtoMessage() {}
+
+func (x *ListTerminalSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_admin_ServerManager_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTerminalSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTerminalSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_admin_ServerManager_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListTerminalSessionsRequest) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+type ListTerminalSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*TerminalSession     `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTerminalSessionsResponse) Reset() {
+	*x = ListTerminalSessionsResponse{}
+	mi := &file_backend_admin_ServerManager_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTerminalSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTerminalSessionsResponse) ProtoMessage() {}
+
+func (x *ListTerminalSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_admin_ServerManager_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTerminalSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTerminalSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_admin_ServerManager_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTerminalSessionsResponse) GetSessions() []*TerminalSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type KillTerminalSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sid           string                 `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KillTerminalSessionRequest) Reset() {
+	*x = KillTerminalSessionRequest{}
+	mi := &file_backend_admin_ServerManager_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillTerminalSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillTerminalSessionRequest) ProtoMessage() {}
+
+func (x *KillTerminalSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_admin_ServerManager_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillTerminalSessionRequest.ProtoReflect.Descriptor instead.
+func (*KillTerminalSessionRequest) Descriptor() ([]byte, []int) {
+	return file_backend_admin_ServerManager_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *KillTerminalSessionRequest) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *KillTerminalSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type KillTerminalSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KillTerminalSessionResponse) Reset() {
+	*x = KillTerminalSessionResponse{}
+	mi := &file_backend_admin_ServerManager_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillTerminalSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillTerminalSessionResponse) ProtoMessage() {}
+
+func (x *KillTerminalSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_admin_ServerManager_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillTerminalSessionResponse.ProtoReflect.Descriptor instead.
+func (*KillTerminalSessionResponse) Descriptor() ([]byte, []int) {
+	return file_backend_admin_ServerManager_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *KillTerminalSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 var File_backend_admin_ServerManager_proto protoreflect.FileDescriptor
 
 const file_backend_admin_ServerManager_proto_rawDesc = "" +
 	"\n" +
-	"!backend/admin/ServerManager.proto\x12\rbackend_admin\x1a\fcommon.proto\"\x86\x02\n" +
+	"!backend/admin/ServerManager.proto\x12\rbackend_admin\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfa\x02\n" +
 	"\x06Server\x12\x10\n" +
 	"\x03sid\x18\x01 \x01(\tR\x03sid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -632,7 +925,10 @@ const file_backend_admin_ServerManager_proto_rawDesc = "" +
 	"\x04uids\x18\x06 \x03(\tR\x04uids\x12<\n" +
 	"\x0eresource_limit\x18\a \x01(\v2\x15.common.ResourceLimitR\rresourceLimit\x12!\n" +
 	"\fdocker_image\x18\b \x01(\tR\vdockerImage\x12\x10\n" +
-	"\x03bid\x18\t \x01(\tR\x03bid\"\x87\x02\n" +
+	"\x03bid\x18\t \x01(\tR\x03bid\x12<\n" +
+	"\x0erestart_policy\x18\n" +
+	" \x01(\x0e2\x15.common.RestartPolicyR\rrestartPolicy\x124\n" +
+	"\tvariables\x18\v \x03(\v2\x16.common.ServerVariableR\tvariables\"\x87\x02\n" +
 	"\x11GetServersRequest\x122\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x12.common.PaginationR\n" +
@@ -669,14 +965,34 @@ const file_backend_admin_ServerManager_proto_rawDesc = "" +
 	"\x13DeleteServerRequest\x12\x10\n" +
 	"\x03sid\x18\x01 \x01(\tR\x03sid\"0\n" +
 	"\x14DeleteServerResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xc4\x03\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf9\x01\n" +
+	"\x0fTerminalSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03sid\x18\x02 \x01(\tR\x03sid\x12\x1b\n" +
+	"\towner_uid\x18\x03 \x01(\tR\bownerUid\x12\x14\n" +
+	"\x05shell\x18\x04 \x01(\tR\x05shell\x124\n" +
+	"\acreated\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12?\n" +
+	"\rlast_activity\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flastActivity\x12\x1a\n" +
+	"\battached\x18\a \x01(\rR\battached\"/\n" +
+	"\x1bListTerminalSessionsRequest\x12\x10\n" +
+	"\x03sid\x18\x01 \x01(\tR\x03sid\"Z\n" +
+	"\x1cListTerminalSessionsResponse\x12:\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1e.backend_admin.TerminalSessionR\bsessions\"M\n" +
+	"\x1aKillTerminalSessionRequest\x12\x10\n" +
+	"\x03sid\x18\x01 \x01(\tR\x03sid\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"7\n" +
+	"\x1bKillTerminalSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xa3\x05\n" +
 	"\x14ServerManagerService\x12Q\n" +
 	"\n" +
 	"GetServers\x12 .backend_admin.GetServersRequest\x1a!.backend_admin.GetServersResponse\x12N\n" +
 	"\tGetServer\x12\x1f.backend_admin.GetServerRequest\x1a .backend_admin.GetServerResponse\x12W\n" +
 	"\fCreateServer\x12\".backend_admin.CreateServerRequest\x1a#.backend_admin.CreateServerResponse\x12W\n" +
 	"\fUpdateServer\x12\".backend_admin.UpdateServerRequest\x1a#.backend_admin.UpdateServerResponse\x12W\n" +
-	"\fDeleteServer\x12\".backend_admin.DeleteServerRequest\x1a#.backend_admin.DeleteServerResponseB%Z#panelium/proto_gen_go/backend/adminb\x06proto3"
+	"\fDeleteServer\x12\".backend_admin.DeleteServerRequest\x1a#.backend_admin.DeleteServerResponse\x12o\n" +
+	"\x14ListTerminalSessions\x12*.backend_admin.ListTerminalSessionsRequest\x1a+.backend_admin.ListTerminalSessionsResponse\x12l\n" +
+	"\x13KillTerminalSession\x12).backend_admin.KillTerminalSessionRequest\x1a*.backend_admin.KillTerminalSessionResponseB%Z#panelium/proto_gen_go/backend/adminb\x06proto3"
 
 var (
 	file_backend_admin_ServerManager_proto_rawDescOnce sync.Once
@@ -690,45 +1006,62 @@ func file_backend_admin_ServerManager_proto_rawDescGZIP() []byte {
 	return file_backend_admin_ServerManager_proto_rawDescData
 }
 
-var file_backend_admin_ServerManager_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_backend_admin_ServerManager_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_backend_admin_ServerManager_proto_goTypes = []any{
-	(*Server)(nil),                     // 0: backend_admin.Server
-	(*GetServersRequest)(nil),          // 1: backend_admin.GetServersRequest
-	(*GetServersResponse)(nil),         // 2: backend_admin.GetServersResponse
-	(*GetServerRequest)(nil),           // 3: backend_admin.GetServerRequest
-	(*GetServerResponse)(nil),          // 4: backend_admin.GetServerResponse
-	(*CreateServerRequest)(nil),        // 5: backend_admin.CreateServerRequest
-	(*CreateServerResponse)(nil),       // 6: backend_admin.CreateServerResponse
-	(*UpdateServerRequest)(nil),        // 7: backend_admin.UpdateServerRequest
-	(*UpdateServerResponse)(nil),       // 8: backend_admin.UpdateServerResponse
-	(*DeleteServerRequest)(nil),        // 9: backend_admin.DeleteServerRequest
-	(*DeleteServerResponse)(nil),       // 10: backend_admin.DeleteServerResponse
-	(*proto_gen_go.ResourceLimit)(nil), // 11: common.ResourceLimit
-	(*proto_gen_go.Pagination)(nil),    // 12: common.Pagination
+	(*Server)(nil),                       // 0: backend_admin.Server
+	(*GetServersRequest)(nil),            // 1: backend_admin.GetServersRequest
+	(*GetServersResponse)(nil),           // 2: backend_admin.GetServersResponse
+	(*GetServerRequest)(nil),             // 3: backend_admin.GetServerRequest
+	(*GetServerResponse)(nil),            // 4: backend_admin.GetServerResponse
+	(*CreateServerRequest)(nil),          // 5: backend_admin.CreateServerRequest
+	(*CreateServerResponse)(nil),         // 6: backend_admin.CreateServerResponse
+	(*UpdateServerRequest)(nil),          // 7: backend_admin.UpdateServerRequest
+	(*UpdateServerResponse)(nil),         // 8: backend_admin.UpdateServerResponse
+	(*DeleteServerRequest)(nil),          // 9: backend_admin.DeleteServerRequest
+	(*DeleteServerResponse)(nil),         // 10: backend_admin.DeleteServerResponse
+	(*TerminalSession)(nil),              // 11: backend_admin.TerminalSession
+	(*ListTerminalSessionsRequest)(nil),  // 12: backend_admin.ListTerminalSessionsRequest
+	(*ListTerminalSessionsResponse)(nil), // 13: backend_admin.ListTerminalSessionsResponse
+	(*KillTerminalSessionRequest)(nil),   // 14: backend_admin.KillTerminalSessionRequest
+	(*KillTerminalSessionResponse)(nil),  // 15: backend_admin.KillTerminalSessionResponse
+	(*proto_gen_go.ResourceLimit)(nil),   // 16: common.ResourceLimit
+	(proto_gen_go.RestartPolicy)(0),      // 17: common.RestartPolicy
+	(*proto_gen_go.ServerVariable)(nil),  // 18: common.ServerVariable
+	(*proto_gen_go.Pagination)(nil),      // 19: common.Pagination
+	(*timestamppb.Timestamp)(nil),        // 20: google.protobuf.Timestamp
 }
 var file_backend_admin_ServerManager_proto_depIdxs = []int32{
-	11, // 0: backend_admin.Server.resource_limit:type_name -> common.ResourceLimit
-	12, // 1: backend_admin.GetServersRequest.pagination:type_name -> common.Pagination
-	0,  // 2: backend_admin.GetServersResponse.servers:type_name -> backend_admin.Server
-	12, // 3: backend_admin.GetServersResponse.pagination:type_name -> common.Pagination
-	0,  // 4: backend_admin.GetServerResponse.server:type_name -> backend_admin.Server
-	0,  // 5: backend_admin.CreateServerRequest.server:type_name -> backend_admin.Server
-	0,  // 6: backend_admin.UpdateServerRequest.server:type_name -> backend_admin.Server
-	1,  // 7: backend_admin.ServerManagerService.GetServers:input_type -> backend_admin.GetServersRequest
-	3,  // 8: backend_admin.ServerManagerService.GetServer:input_type -> backend_admin.GetServerRequest
-	5,  // 9: backend_admin.ServerManagerService.CreateServer:input_type -> backend_admin.CreateServerRequest
-	7,  // 10: backend_admin.ServerManagerService.UpdateServer:input_type -> backend_admin.UpdateServerRequest
-	9,  // 11: backend_admin.ServerManagerService.DeleteServer:input_type -> backend_admin.DeleteServerRequest
-	2,  // 12: backend_admin.ServerManagerService.GetServers:output_type -> backend_admin.GetServersResponse
-	4,  // 13: backend_admin.ServerManagerService.GetServer:output_type -> backend_admin.GetServerResponse
-	6,  // 14: backend_admin.ServerManagerService.CreateServer:output_type -> backend_admin.CreateServerResponse
-	8,  // 15: backend_admin.ServerManagerService.UpdateServer:output_type -> backend_admin.UpdateServerResponse
-	10, // 16: backend_admin.ServerManagerService.DeleteServer:output_type -> backend_admin.DeleteServerResponse
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	16, // 0: backend_admin.Server.resource_limit:type_name -> common.ResourceLimit
+	17, // 1: backend_admin.Server.restart_policy:type_name -> common.RestartPolicy
+	18, // 2: backend_admin.Server.variables:type_name -> common.ServerVariable
+	19, // 3: backend_admin.GetServersRequest.pagination:type_name -> common.Pagination
+	0,  // 4: backend_admin.GetServersResponse.servers:type_name -> backend_admin.Server
+	19, // 5: backend_admin.GetServersResponse.pagination:type_name -> common.Pagination
+	0,  // 6: backend_admin.GetServerResponse.server:type_name -> backend_admin.Server
+	0,  // 7: backend_admin.CreateServerRequest.server:type_name -> backend_admin.Server
+	0,  // 8: backend_admin.UpdateServerRequest.server:type_name -> backend_admin.Server
+	20, // 9: backend_admin.TerminalSession.created:type_name -> google.protobuf.Timestamp
+	20, // 10: backend_admin.TerminalSession.last_activity:type_name -> google.protobuf.Timestamp
+	11, // 11: backend_admin.ListTerminalSessionsResponse.sessions:type_name -> backend_admin.TerminalSession
+	1,  // 12: backend_admin.ServerManagerService.GetServers:input_type -> backend_admin.GetServersRequest
+	3,  // 13: backend_admin.ServerManagerService.GetServer:input_type -> backend_admin.GetServerRequest
+	5,  // 14: backend_admin.ServerManagerService.CreateServer:input_type -> backend_admin.CreateServerRequest
+	7,  // 15: backend_admin.ServerManagerService.UpdateServer:input_type -> backend_admin.UpdateServerRequest
+	9,  // 16: backend_admin.ServerManagerService.DeleteServer:input_type -> backend_admin.DeleteServerRequest
+	12, // 17: backend_admin.ServerManagerService.ListTerminalSessions:input_type -> backend_admin.ListTerminalSessionsRequest
+	14, // 18: backend_admin.ServerManagerService.KillTerminalSession:input_type -> backend_admin.KillTerminalSessionRequest
+	2,  // 19: backend_admin.ServerManagerService.GetServers:output_type -> backend_admin.GetServersResponse
+	4,  // 20: backend_admin.ServerManagerService.GetServer:output_type -> backend_admin.GetServerResponse
+	6,  // 21: backend_admin.ServerManagerService.CreateServer:output_type -> backend_admin.CreateServerResponse
+	8,  // 22: backend_admin.ServerManagerService.UpdateServer:output_type -> backend_admin.UpdateServerResponse
+	10, // 23: backend_admin.ServerManagerService.DeleteServer:output_type -> backend_admin.DeleteServerResponse
+	13, // 24: backend_admin.ServerManagerService.ListTerminalSessions:output_type -> backend_admin.ListTerminalSessionsResponse
+	15, // 25: backend_admin.ServerManagerService.KillTerminalSession:output_type -> backend_admin.KillTerminalSessionResponse
+	19, // [19:26] is the sub-list for method output_type
+	12, // [12:19] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_backend_admin_ServerManager_proto_init() }
@@ -743,7 +1076,7 @@ func file_backend_admin_ServerManager_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_admin_ServerManager_proto_rawDesc), len(file_backend_admin_ServerManager_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
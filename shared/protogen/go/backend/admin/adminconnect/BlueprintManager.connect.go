@@ -48,6 +48,9 @@ const (
 	// BlueprintManagerServiceDeleteBlueprintProcedure is the fully-qualified name of the
 	// BlueprintManagerService's DeleteBlueprint RPC.
 	BlueprintManagerServiceDeleteBlueprintProcedure = "/backend_admin.BlueprintManagerService/DeleteBlueprint"
+	// BlueprintManagerServiceApproveBlueprintRelaxationsProcedure is the fully-qualified name of the
+	// BlueprintManagerService's ApproveBlueprintRelaxations RPC.
+	BlueprintManagerServiceApproveBlueprintRelaxationsProcedure = "/backend_admin.BlueprintManagerService/ApproveBlueprintRelaxations"
 )
 
 // BlueprintManagerServiceClient is a client for the backend_admin.BlueprintManagerService service.
@@ -57,6 +60,7 @@ type BlueprintManagerServiceClient interface {
 	CreateBlueprint(context.Context, *connect.Request[admin.CreateBlueprintRequest]) (*connect.Response[admin.CreateBlueprintResponse], error)
 	UpdateBlueprint(context.Context, *connect.Request[admin.UpdateBlueprintRequest]) (*connect.Response[admin.UpdateBlueprintResponse], error)
 	DeleteBlueprint(context.Context, *connect.Request[admin.DeleteBlueprintRequest]) (*connect.Response[admin.DeleteBlueprintResponse], error)
+	ApproveBlueprintRelaxations(context.Context, *connect.Request[admin.ApproveBlueprintRelaxationsRequest]) (*connect.Response[admin.ApproveBlueprintRelaxationsResponse], error)
 }
 
 // NewBlueprintManagerServiceClient constructs a client for the
@@ -100,16 +104,23 @@ func NewBlueprintManagerServiceClient(httpClient connect.HTTPClient, baseURL str
 			connect.WithSchema(blueprintManagerServiceMethods.ByName("DeleteBlueprint")),
 			connect.WithClientOptions(opts...),
 		),
+		approveBlueprintRelaxations: connect.NewClient[admin.ApproveBlueprintRelaxationsRequest, admin.ApproveBlueprintRelaxationsResponse](
+			httpClient,
+			baseURL+BlueprintManagerServiceApproveBlueprintRelaxationsProcedure,
+			connect.WithSchema(blueprintManagerServiceMethods.ByName("ApproveBlueprintRelaxations")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // blueprintManagerServiceClient implements BlueprintManagerServiceClient.
 type blueprintManagerServiceClient struct {
-	getBlueprints   *connect.Client[admin.GetBlueprintsRequest, admin.GetBlueprintsResponse]
-	getBlueprint    *connect.Client[admin.GetBlueprintRequest, admin.GetBlueprintResponse]
-	createBlueprint *connect.Client[admin.CreateBlueprintRequest, admin.CreateBlueprintResponse]
-	updateBlueprint *connect.Client[admin.UpdateBlueprintRequest, admin.UpdateBlueprintResponse]
-	deleteBlueprint *connect.Client[admin.DeleteBlueprintRequest, admin.DeleteBlueprintResponse]
+	getBlueprints               *connect.Client[admin.GetBlueprintsRequest, admin.GetBlueprintsResponse]
+	getBlueprint                *connect.Client[admin.GetBlueprintRequest, admin.GetBlueprintResponse]
+	createBlueprint             *connect.Client[admin.CreateBlueprintRequest, admin.CreateBlueprintResponse]
+	updateBlueprint             *connect.Client[admin.UpdateBlueprintRequest, admin.UpdateBlueprintResponse]
+	deleteBlueprint             *connect.Client[admin.DeleteBlueprintRequest, admin.DeleteBlueprintResponse]
+	approveBlueprintRelaxations *connect.Client[admin.ApproveBlueprintRelaxationsRequest, admin.ApproveBlueprintRelaxationsResponse]
 }
 
 // GetBlueprints calls backend_admin.BlueprintManagerService.GetBlueprints.
@@ -137,6 +148,12 @@ func (c *blueprintManagerServiceClient) DeleteBlueprint(ctx context.Context, req
 	return c.deleteBlueprint.CallUnary(ctx, req)
 }
 
+// ApproveBlueprintRelaxations calls
+// backend_admin.BlueprintManagerService.ApproveBlueprintRelaxations.
+func (c *blueprintManagerServiceClient) ApproveBlueprintRelaxations(ctx context.Context, req *connect.Request[admin.ApproveBlueprintRelaxationsRequest]) (*connect.Response[admin.ApproveBlueprintRelaxationsResponse], error) {
+	return c.approveBlueprintRelaxations.CallUnary(ctx, req)
+}
+
 // BlueprintManagerServiceHandler is an implementation of the backend_admin.BlueprintManagerService
 // service.
 type BlueprintManagerServiceHandler interface {
@@ -145,6 +162,7 @@ type BlueprintManagerServiceHandler interface {
 	CreateBlueprint(context.Context, *connect.Request[admin.CreateBlueprintRequest]) (*connect.Response[admin.CreateBlueprintResponse], error)
 	UpdateBlueprint(context.Context, *connect.Request[admin.UpdateBlueprintRequest]) (*connect.Response[admin.UpdateBlueprintResponse], error)
 	DeleteBlueprint(context.Context, *connect.Request[admin.DeleteBlueprintRequest]) (*connect.Response[admin.DeleteBlueprintResponse], error)
+	ApproveBlueprintRelaxations(context.Context, *connect.Request[admin.ApproveBlueprintRelaxationsRequest]) (*connect.Response[admin.ApproveBlueprintRelaxationsResponse], error)
 }
 
 // NewBlueprintManagerServiceHandler builds an HTTP handler from the service implementation. It
@@ -184,6 +202,12 @@ func NewBlueprintManagerServiceHandler(svc BlueprintManagerServiceHandler, opts
 		connect.WithSchema(blueprintManagerServiceMethods.ByName("DeleteBlueprint")),
 		connect.WithHandlerOptions(opts...),
 	)
+	blueprintManagerServiceApproveBlueprintRelaxationsHandler := connect.NewUnaryHandler(
+		BlueprintManagerServiceApproveBlueprintRelaxationsProcedure,
+		svc.ApproveBlueprintRelaxations,
+		connect.WithSchema(blueprintManagerServiceMethods.ByName("ApproveBlueprintRelaxations")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/backend_admin.BlueprintManagerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BlueprintManagerServiceGetBlueprintsProcedure:
@@ -196,6 +220,8 @@ func NewBlueprintManagerServiceHandler(svc BlueprintManagerServiceHandler, opts
 			blueprintManagerServiceUpdateBlueprintHandler.ServeHTTP(w, r)
 		case BlueprintManagerServiceDeleteBlueprintProcedure:
 			blueprintManagerServiceDeleteBlueprintHandler.ServeHTTP(w, r)
+		case BlueprintManagerServiceApproveBlueprintRelaxationsProcedure:
+			blueprintManagerServiceApproveBlueprintRelaxationsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -224,3 +250,7 @@ func (UnimplementedBlueprintManagerServiceHandler) UpdateBlueprint(context.Conte
 func (UnimplementedBlueprintManagerServiceHandler) DeleteBlueprint(context.Context, *connect.Request[admin.DeleteBlueprintRequest]) (*connect.Response[admin.DeleteBlueprintResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backend_admin.BlueprintManagerService.DeleteBlueprint is not implemented"))
 }
+
+func (UnimplementedBlueprintManagerServiceHandler) ApproveBlueprintRelaxations(context.Context, *connect.Request[admin.ApproveBlueprintRelaxationsRequest]) (*connect.Response[admin.ApproveBlueprintRelaxationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backend_admin.BlueprintManagerService.ApproveBlueprintRelaxations is not implemented"))
+}
@@ -48,6 +48,12 @@ const (
 	// ServerManagerServiceDeleteServerProcedure is the fully-qualified name of the
 	// ServerManagerService's DeleteServer RPC.
 	ServerManagerServiceDeleteServerProcedure = "/backend_admin.ServerManagerService/DeleteServer"
+	// ServerManagerServiceListTerminalSessionsProcedure is the fully-qualified name of the
+	// ServerManagerService's ListTerminalSessions RPC.
+	ServerManagerServiceListTerminalSessionsProcedure = "/backend_admin.ServerManagerService/ListTerminalSessions"
+	// ServerManagerServiceKillTerminalSessionProcedure is the fully-qualified name of the
+	// ServerManagerService's KillTerminalSession RPC.
+	ServerManagerServiceKillTerminalSessionProcedure = "/backend_admin.ServerManagerService/KillTerminalSession"
 )
 
 // ServerManagerServiceClient is a client for the backend_admin.ServerManagerService service.
@@ -57,6 +63,8 @@ type ServerManagerServiceClient interface {
 	CreateServer(context.Context, *connect.Request[admin.CreateServerRequest]) (*connect.Response[admin.CreateServerResponse], error)
 	UpdateServer(context.Context, *connect.Request[admin.UpdateServerRequest]) (*connect.Response[admin.UpdateServerResponse], error)
 	DeleteServer(context.Context, *connect.Request[admin.DeleteServerRequest]) (*connect.Response[admin.DeleteServerResponse], error)
+	ListTerminalSessions(context.Context, *connect.Request[admin.ListTerminalSessionsRequest]) (*connect.Response[admin.ListTerminalSessionsResponse], error)
+	KillTerminalSession(context.Context, *connect.Request[admin.KillTerminalSessionRequest]) (*connect.Response[admin.KillTerminalSessionResponse], error)
 }
 
 // NewServerManagerServiceClient constructs a client for the backend_admin.ServerManagerService
@@ -100,16 +108,30 @@ func NewServerManagerServiceClient(httpClient connect.HTTPClient, baseURL string
 			connect.WithSchema(serverManagerServiceMethods.ByName("DeleteServer")),
 			connect.WithClientOptions(opts...),
 		),
+		listTerminalSessions: connect.NewClient[admin.ListTerminalSessionsRequest, admin.ListTerminalSessionsResponse](
+			httpClient,
+			baseURL+ServerManagerServiceListTerminalSessionsProcedure,
+			connect.WithSchema(serverManagerServiceMethods.ByName("ListTerminalSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		killTerminalSession: connect.NewClient[admin.KillTerminalSessionRequest, admin.KillTerminalSessionResponse](
+			httpClient,
+			baseURL+ServerManagerServiceKillTerminalSessionProcedure,
+			connect.WithSchema(serverManagerServiceMethods.ByName("KillTerminalSession")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // serverManagerServiceClient implements ServerManagerServiceClient.
 type serverManagerServiceClient struct {
-	getServers   *connect.Client[admin.GetServersRequest, admin.GetServersResponse]
-	getServer    *connect.Client[admin.GetServerRequest, admin.GetServerResponse]
-	createServer *connect.Client[admin.CreateServerRequest, admin.CreateServerResponse]
-	updateServer *connect.Client[admin.UpdateServerRequest, admin.UpdateServerResponse]
-	deleteServer *connect.Client[admin.DeleteServerRequest, admin.DeleteServerResponse]
+	getServers           *connect.Client[admin.GetServersRequest, admin.GetServersResponse]
+	getServer            *connect.Client[admin.GetServerRequest, admin.GetServerResponse]
+	createServer         *connect.Client[admin.CreateServerRequest, admin.CreateServerResponse]
+	updateServer         *connect.Client[admin.UpdateServerRequest, admin.UpdateServerResponse]
+	deleteServer         *connect.Client[admin.DeleteServerRequest, admin.DeleteServerResponse]
+	listTerminalSessions *connect.Client[admin.ListTerminalSessionsRequest, admin.ListTerminalSessionsResponse]
+	killTerminalSession  *connect.Client[admin.KillTerminalSessionRequest, admin.KillTerminalSessionResponse]
 }
 
 // GetServers calls backend_admin.ServerManagerService.GetServers.
@@ -137,6 +159,16 @@ func (c *serverManagerServiceClient) DeleteServer(ctx context.Context, req *conn
 	return c.deleteServer.CallUnary(ctx, req)
 }
 
+// ListTerminalSessions calls backend_admin.ServerManagerService.ListTerminalSessions.
+func (c *serverManagerServiceClient) ListTerminalSessions(ctx context.Context, req *connect.Request[admin.ListTerminalSessionsRequest]) (*connect.Response[admin.ListTerminalSessionsResponse], error) {
+	return c.listTerminalSessions.CallUnary(ctx, req)
+}
+
+// KillTerminalSession calls backend_admin.ServerManagerService.KillTerminalSession.
+func (c *serverManagerServiceClient) KillTerminalSession(ctx context.Context, req *connect.Request[admin.KillTerminalSessionRequest]) (*connect.Response[admin.KillTerminalSessionResponse], error) {
+	return c.killTerminalSession.CallUnary(ctx, req)
+}
+
 // ServerManagerServiceHandler is an implementation of the backend_admin.ServerManagerService
 // service.
 type ServerManagerServiceHandler interface {
@@ -145,6 +177,8 @@ type ServerManagerServiceHandler interface {
 	CreateServer(context.Context, *connect.Request[admin.CreateServerRequest]) (*connect.Response[admin.CreateServerResponse], error)
 	UpdateServer(context.Context, *connect.Request[admin.UpdateServerRequest]) (*connect.Response[admin.UpdateServerResponse], error)
 	DeleteServer(context.Context, *connect.Request[admin.DeleteServerRequest]) (*connect.Response[admin.DeleteServerResponse], error)
+	ListTerminalSessions(context.Context, *connect.Request[admin.ListTerminalSessionsRequest]) (*connect.Response[admin.ListTerminalSessionsResponse], error)
+	KillTerminalSession(context.Context, *connect.Request[admin.KillTerminalSessionRequest]) (*connect.Response[admin.KillTerminalSessionResponse], error)
 }
 
 // NewServerManagerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -184,6 +218,18 @@ func NewServerManagerServiceHandler(svc ServerManagerServiceHandler, opts ...con
 		connect.WithSchema(serverManagerServiceMethods.ByName("DeleteServer")),
 		connect.WithHandlerOptions(opts...),
 	)
+	serverManagerServiceListTerminalSessionsHandler := connect.NewUnaryHandler(
+		ServerManagerServiceListTerminalSessionsProcedure,
+		svc.ListTerminalSessions,
+		connect.WithSchema(serverManagerServiceMethods.ByName("ListTerminalSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverManagerServiceKillTerminalSessionHandler := connect.NewUnaryHandler(
+		ServerManagerServiceKillTerminalSessionProcedure,
+		svc.KillTerminalSession,
+		connect.WithSchema(serverManagerServiceMethods.ByName("KillTerminalSession")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/backend_admin.ServerManagerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ServerManagerServiceGetServersProcedure:
@@ -196,6 +242,10 @@ func NewServerManagerServiceHandler(svc ServerManagerServiceHandler, opts ...con
 			serverManagerServiceUpdateServerHandler.ServeHTTP(w, r)
 		case ServerManagerServiceDeleteServerProcedure:
 			serverManagerServiceDeleteServerHandler.ServeHTTP(w, r)
+		case ServerManagerServiceListTerminalSessionsProcedure:
+			serverManagerServiceListTerminalSessionsHandler.ServeHTTP(w, r)
+		case ServerManagerServiceKillTerminalSessionProcedure:
+			serverManagerServiceKillTerminalSessionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -224,3 +274,11 @@ func (UnimplementedServerManagerServiceHandler) UpdateServer(context.Context, *c
 func (UnimplementedServerManagerServiceHandler) DeleteServer(context.Context, *connect.Request[admin.DeleteServerRequest]) (*connect.Response[admin.DeleteServerResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backend_admin.ServerManagerService.DeleteServer is not implemented"))
 }
+
+func (UnimplementedServerManagerServiceHandler) ListTerminalSessions(context.Context, *connect.Request[admin.ListTerminalSessionsRequest]) (*connect.Response[admin.ListTerminalSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backend_admin.ServerManagerService.ListTerminalSessions is not implemented"))
+}
+
+func (UnimplementedServerManagerServiceHandler) KillTerminalSession(context.Context, *connect.Request[admin.KillTerminalSessionRequest]) (*connect.Response[admin.KillTerminalSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backend_admin.ServerManagerService.KillTerminalSession is not implemented"))
+}
@@ -52,6 +52,12 @@ const (
 	ClientServiceGetAvailableNodesProcedure = "/backend.ClientService/GetAvailableNodes"
 	// ClientServiceNewServerProcedure is the fully-qualified name of the ClientService's NewServer RPC.
 	ClientServiceNewServerProcedure = "/backend.ClientService/NewServer"
+	// ClientServiceGetServerVariablesProcedure is the fully-qualified name of the ClientService's
+	// GetServerVariables RPC.
+	ClientServiceGetServerVariablesProcedure = "/backend.ClientService/GetServerVariables"
+	// ClientServiceUpdateServerVariablesProcedure is the fully-qualified name of the ClientService's
+	// UpdateServerVariables RPC.
+	ClientServiceUpdateServerVariablesProcedure = "/backend.ClientService/UpdateServerVariables"
 )
 
 // ClientServiceClient is a client for the backend.ClientService service.
@@ -63,6 +69,8 @@ type ClientServiceClient interface {
 	GetAvailableLocations(context.Context, *connect.Request[proto_gen_go.Empty]) (*connect.Response[backend.AvailableLocations], error)
 	GetAvailableNodes(context.Context, *connect.Request[proto_gen_go.Empty]) (*connect.Response[backend.AvailableNodes], error)
 	NewServer(context.Context, *connect.Request[backend.NewServerRequest]) (*connect.Response[backend.NewServerResponse], error)
+	GetServerVariables(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[backend.ServerVariables], error)
+	UpdateServerVariables(context.Context, *connect.Request[backend.UpdateServerVariablesRequest]) (*connect.Response[proto_gen_go.SuccessMessage], error)
 }
 
 // NewClientServiceClient constructs a client for the backend.ClientService service. By default, it
@@ -118,6 +126,18 @@ func NewClientServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(clientServiceMethods.ByName("NewServer")),
 			connect.WithClientOptions(opts...),
 		),
+		getServerVariables: connect.NewClient[proto_gen_go.SimpleIDMessage, backend.ServerVariables](
+			httpClient,
+			baseURL+ClientServiceGetServerVariablesProcedure,
+			connect.WithSchema(clientServiceMethods.ByName("GetServerVariables")),
+			connect.WithClientOptions(opts...),
+		),
+		updateServerVariables: connect.NewClient[backend.UpdateServerVariablesRequest, proto_gen_go.SuccessMessage](
+			httpClient,
+			baseURL+ClientServiceUpdateServerVariablesProcedure,
+			connect.WithSchema(clientServiceMethods.ByName("UpdateServerVariables")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -130,6 +150,8 @@ type clientServiceClient struct {
 	getAvailableLocations  *connect.Client[proto_gen_go.Empty, backend.AvailableLocations]
 	getAvailableNodes      *connect.Client[proto_gen_go.Empty, backend.AvailableNodes]
 	newServer              *connect.Client[backend.NewServerRequest, backend.NewServerResponse]
+	getServerVariables     *connect.Client[proto_gen_go.SimpleIDMessage, backend.ServerVariables]
+	updateServerVariables  *connect.Client[backend.UpdateServerVariablesRequest, proto_gen_go.SuccessMessage]
 }
 
 // GetInfo calls backend.ClientService.GetInfo.
@@ -167,6 +189,16 @@ func (c *clientServiceClient) NewServer(ctx context.Context, req *connect.Reques
 	return c.newServer.CallUnary(ctx, req)
 }
 
+// GetServerVariables calls backend.ClientService.GetServerVariables.
+func (c *clientServiceClient) GetServerVariables(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[backend.ServerVariables], error) {
+	return c.getServerVariables.CallUnary(ctx, req)
+}
+
+// UpdateServerVariables calls backend.ClientService.UpdateServerVariables.
+func (c *clientServiceClient) UpdateServerVariables(ctx context.Context, req *connect.Request[backend.UpdateServerVariablesRequest]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
+	return c.updateServerVariables.CallUnary(ctx, req)
+}
+
 // ClientServiceHandler is an implementation of the backend.ClientService service.
 type ClientServiceHandler interface {
 	GetInfo(context.Context, *connect.Request[proto_gen_go.Empty]) (*connect.Response[backend.ClientInfo], error)
@@ -176,6 +208,8 @@ type ClientServiceHandler interface {
 	GetAvailableLocations(context.Context, *connect.Request[proto_gen_go.Empty]) (*connect.Response[backend.AvailableLocations], error)
 	GetAvailableNodes(context.Context, *connect.Request[proto_gen_go.Empty]) (*connect.Response[backend.AvailableNodes], error)
 	NewServer(context.Context, *connect.Request[backend.NewServerRequest]) (*connect.Response[backend.NewServerResponse], error)
+	GetServerVariables(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[backend.ServerVariables], error)
+	UpdateServerVariables(context.Context, *connect.Request[backend.UpdateServerVariablesRequest]) (*connect.Response[proto_gen_go.SuccessMessage], error)
 }
 
 // NewClientServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -227,6 +261,18 @@ func NewClientServiceHandler(svc ClientServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(clientServiceMethods.ByName("NewServer")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clientServiceGetServerVariablesHandler := connect.NewUnaryHandler(
+		ClientServiceGetServerVariablesProcedure,
+		svc.GetServerVariables,
+		connect.WithSchema(clientServiceMethods.ByName("GetServerVariables")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServiceUpdateServerVariablesHandler := connect.NewUnaryHandler(
+		ClientServiceUpdateServerVariablesProcedure,
+		svc.UpdateServerVariables,
+		connect.WithSchema(clientServiceMethods.ByName("UpdateServerVariables")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/backend.ClientService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ClientServiceGetInfoProcedure:
@@ -243,6 +289,10 @@ func NewClientServiceHandler(svc ClientServiceHandler, opts ...connect.HandlerOp
 			clientServiceGetAvailableNodesHandler.ServeHTTP(w, r)
 		case ClientServiceNewServerProcedure:
 			clientServiceNewServerHandler.ServeHTTP(w, r)
+		case ClientServiceGetServerVariablesProcedure:
+			clientServiceGetServerVariablesHandler.ServeHTTP(w, r)
+		case ClientServiceUpdateServerVariablesProcedure:
+			clientServiceUpdateServerVariablesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -279,3 +329,11 @@ func (UnimplementedClientServiceHandler) GetAvailableNodes(context.Context, *con
 func (UnimplementedClientServiceHandler) NewServer(context.Context, *connect.Request[backend.NewServerRequest]) (*connect.Response[backend.NewServerResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backend.ClientService.NewServer is not implemented"))
 }
+
+func (UnimplementedClientServiceHandler) GetServerVariables(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[backend.ServerVariables], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backend.ClientService.GetServerVariables is not implemented"))
+}
+
+func (UnimplementedClientServiceHandler) UpdateServerVariables(context.Context, *connect.Request[backend.UpdateServerVariablesRequest]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backend.ClientService.UpdateServerVariables is not implemented"))
+}
@@ -21,6 +21,153 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RestartPolicy int32
+
+const (
+	RestartPolicy_RESTART_POLICY_NEVER    RestartPolicy = 0
+	RestartPolicy_RESTART_POLICY_ON_CRASH RestartPolicy = 1 // restart when the server exits with a non-zero exit code
+	RestartPolicy_RESTART_POLICY_ALWAYS   RestartPolicy = 2 // restart whenever the server exits without being stopped through the panel
+)
+
+// Enum value maps for RestartPolicy.
+var (
+	RestartPolicy_name = map[int32]string{
+		0: "RESTART_POLICY_NEVER",
+		1: "RESTART_POLICY_ON_CRASH",
+		2: "RESTART_POLICY_ALWAYS",
+	}
+	RestartPolicy_value = map[string]int32{
+		"RESTART_POLICY_NEVER":    0,
+		"RESTART_POLICY_ON_CRASH": 1,
+		"RESTART_POLICY_ALWAYS":   2,
+	}
+)
+
+func (x RestartPolicy) Enum() *RestartPolicy {
+	p := new(RestartPolicy)
+	*p = x
+	return p
+}
+
+func (x RestartPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RestartPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[0].Descriptor()
+}
+
+func (RestartPolicy) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[0]
+}
+
+func (x RestartPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RestartPolicy.Descriptor instead.
+func (RestartPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{0}
+}
+
+type PortProtocol int32
+
+const (
+	PortProtocol_PORT_PROTOCOL_TCP_UDP PortProtocol = 0
+	PortProtocol_PORT_PROTOCOL_TCP     PortProtocol = 1
+	PortProtocol_PORT_PROTOCOL_UDP     PortProtocol = 2
+)
+
+// Enum value maps for PortProtocol.
+var (
+	PortProtocol_name = map[int32]string{
+		0: "PORT_PROTOCOL_TCP_UDP",
+		1: "PORT_PROTOCOL_TCP",
+		2: "PORT_PROTOCOL_UDP",
+	}
+	PortProtocol_value = map[string]int32{
+		"PORT_PROTOCOL_TCP_UDP": 0,
+		"PORT_PROTOCOL_TCP":     1,
+		"PORT_PROTOCOL_UDP":     2,
+	}
+)
+
+func (x PortProtocol) Enum() *PortProtocol {
+	p := new(PortProtocol)
+	*p = x
+	return p
+}
+
+func (x PortProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PortProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[1].Descriptor()
+}
+
+func (PortProtocol) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[1]
+}
+
+func (x PortProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PortProtocol.Descriptor instead.
+func (PortProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{1}
+}
+
+type VariableType int32
+
+const (
+	VariableType_VARIABLE_TYPE_STRING  VariableType = 0
+	VariableType_VARIABLE_TYPE_INTEGER VariableType = 1
+	VariableType_VARIABLE_TYPE_BOOLEAN VariableType = 2 // "true" or "false"
+)
+
+// Enum value maps for VariableType.
+var (
+	VariableType_name = map[int32]string{
+		0: "VARIABLE_TYPE_STRING",
+		1: "VARIABLE_TYPE_INTEGER",
+		2: "VARIABLE_TYPE_BOOLEAN",
+	}
+	VariableType_value = map[string]int32{
+		"VARIABLE_TYPE_STRING":  0,
+		"VARIABLE_TYPE_INTEGER": 1,
+		"VARIABLE_TYPE_BOOLEAN": 2,
+	}
+)
+
+func (x VariableType) Enum() *VariableType {
+	p := new(VariableType)
+	*p = x
+	return p
+}
+
+func (x VariableType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VariableType) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[2].Descriptor()
+}
+
+func (VariableType) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[2]
+}
+
+func (x VariableType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VariableType.Descriptor instead.
+func (VariableType) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{2}
+}
+
 type Empty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -370,12 +517,23 @@ func (x *ResourceLimit) GetStorage() uint32 {
 }
 
 type ResourceUsage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cpu           float32                `protobuf:"fixed32,1,opt,name=cpu,proto3" json:"cpu,omitempty"`         // CPU in percentage (100% = 1 vCore)
-	Ram           float32                `protobuf:"fixed32,2,opt,name=ram,proto3" json:"ram,omitempty"`         // RAM in MB
-	Storage       float32                `protobuf:"fixed32,3,opt,name=storage,proto3" json:"storage,omitempty"` // Storage in MB
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Cpu             float32                `protobuf:"fixed32,1,opt,name=cpu,proto3" json:"cpu,omitempty"`                                                  // CPU in percentage (100% = 1 vCore)
+	Ram             float32                `protobuf:"fixed32,2,opt,name=ram,proto3" json:"ram,omitempty"`                                                  // RAM in MB, without the page cache the kernel can reclaim
+	Storage         float32                `protobuf:"fixed32,3,opt,name=storage,proto3" json:"storage,omitempty"`                                          // Storage in MB
+	CpuOfLimit      float32                `protobuf:"fixed32,4,opt,name=cpu_of_limit,json=cpuOfLimit,proto3" json:"cpu_of_limit,omitempty"`                // CPU in percentage of the CPU limit, 0 without a limit
+	CpuCores        []float32              `protobuf:"fixed32,5,rep,packed,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`                 // CPU per online core in percentage, empty if the host doesn't report it (cgroup v2)
+	NetworkRxBytes  int64                  `protobuf:"varint,6,opt,name=network_rx_bytes,json=networkRxBytes,proto3" json:"network_rx_bytes,omitempty"`     // received since the container started
+	NetworkTxBytes  int64                  `protobuf:"varint,7,opt,name=network_tx_bytes,json=networkTxBytes,proto3" json:"network_tx_bytes,omitempty"`     // sent since the container started
+	NetworkRxRate   float32                `protobuf:"fixed32,8,opt,name=network_rx_rate,json=networkRxRate,proto3" json:"network_rx_rate,omitempty"`       // bytes per second
+	NetworkTxRate   float32                `protobuf:"fixed32,9,opt,name=network_tx_rate,json=networkTxRate,proto3" json:"network_tx_rate,omitempty"`       // bytes per second
+	BlockReadBytes  int64                  `protobuf:"varint,10,opt,name=block_read_bytes,json=blockReadBytes,proto3" json:"block_read_bytes,omitempty"`    // read from block devices since the container started
+	BlockWriteBytes int64                  `protobuf:"varint,11,opt,name=block_write_bytes,json=blockWriteBytes,proto3" json:"block_write_bytes,omitempty"` // written to block devices since the container started
+	Pids            int64                  `protobuf:"varint,12,opt,name=pids,proto3" json:"pids,omitempty"`                                                // processes and threads in the container
+	Uptime          int64                  `protobuf:"varint,13,opt,name=uptime,proto3" json:"uptime,omitempty"`                                            // seconds since the container started, 0 if it isn't running
+	Limit           *ResourceLimit         `protobuf:"bytes,14,opt,name=limit,proto3" json:"limit,omitempty"`                                               // the limits the container runs with
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ResourceUsage) Reset() {
@@ -429,6 +587,83 @@ func (x *ResourceUsage) GetStorage() float32 {
 	return 0
 }
 
+func (x *ResourceUsage) GetCpuOfLimit() float32 {
+	if x != nil {
+		return x.CpuOfLimit
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetCpuCores() []float32 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return nil
+}
+
+func (x *ResourceUsage) GetNetworkRxBytes() int64 {
+	if x != nil {
+		return x.NetworkRxBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetNetworkTxBytes() int64 {
+	if x != nil {
+		return x.NetworkTxBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetNetworkRxRate() float32 {
+	if x != nil {
+		return x.NetworkRxRate
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetNetworkTxRate() float32 {
+	if x != nil {
+		return x.NetworkTxRate
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetBlockReadBytes() int64 {
+	if x != nil {
+		return x.BlockReadBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetBlockWriteBytes() int64 {
+	if x != nil {
+		return x.BlockWriteBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetPids() int64 {
+	if x != nil {
+		return x.Pids
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetUptime() int64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetLimit() *ResourceLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
 type IPAllocation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
@@ -481,6 +716,234 @@ func (x *IPAllocation) GetPort() uint32 {
 	return 0
 }
 
+type BlueprintPort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`  // e.g. game or rcon, exposed to the server as SERVER_PORT_<NAME>
+	Port          uint32                 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"` // port inside the container
+	Protocol      PortProtocol           `protobuf:"varint,3,opt,name=protocol,proto3,enum=common.PortProtocol" json:"protocol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlueprintPort) Reset() {
+	*x = BlueprintPort{}
+	mi := &file_common_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlueprintPort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlueprintPort) ProtoMessage() {}
+
+func (x *BlueprintPort) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlueprintPort.ProtoReflect.Descriptor instead.
+func (*BlueprintPort) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BlueprintPort) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BlueprintPort) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *BlueprintPort) GetProtocol() PortProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return PortProtocol_PORT_PROTOCOL_TCP_UDP
+}
+
+type BlueprintVariable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                   // shown to the user
+	EnvKey        string                 `protobuf:"bytes,2,opt,name=env_key,json=envKey,proto3" json:"env_key,omitempty"` // e.g. MAX_PLAYERS, passed to the server as environment variable and usable as {{$env::MAX_PLAYERS}}
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type          VariableType           `protobuf:"varint,4,opt,name=type,proto3,enum=common.VariableType" json:"type,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,5,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	UserEditable  bool                   `protobuf:"varint,6,opt,name=user_editable,json=userEditable,proto3" json:"user_editable,omitempty"` // whether the server's users can change the value, otherwise only admins can
+	Regex         string                 `protobuf:"bytes,7,opt,name=regex,proto3" json:"regex,omitempty"`                                    // the value has to match, empty means any value
+	Options       []string               `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty"`                                // the value has to be one of these, empty means any value
+	Min           *int64                 `protobuf:"varint,9,opt,name=min,proto3,oneof" json:"min,omitempty"`                                 // minimum value for integers, minimum length for strings
+	Max           *int64                 `protobuf:"varint,10,opt,name=max,proto3,oneof" json:"max,omitempty"`                                // maximum value for integers, maximum length for strings
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlueprintVariable) Reset() {
+	*x = BlueprintVariable{}
+	mi := &file_common_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlueprintVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlueprintVariable) ProtoMessage() {}
+
+func (x *BlueprintVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlueprintVariable.ProtoReflect.Descriptor instead.
+func (*BlueprintVariable) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BlueprintVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BlueprintVariable) GetEnvKey() string {
+	if x != nil {
+		return x.EnvKey
+	}
+	return ""
+}
+
+func (x *BlueprintVariable) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BlueprintVariable) GetType() VariableType {
+	if x != nil {
+		return x.Type
+	}
+	return VariableType_VARIABLE_TYPE_STRING
+}
+
+func (x *BlueprintVariable) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *BlueprintVariable) GetUserEditable() bool {
+	if x != nil {
+		return x.UserEditable
+	}
+	return false
+}
+
+func (x *BlueprintVariable) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+func (x *BlueprintVariable) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *BlueprintVariable) GetMin() int64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *BlueprintVariable) GetMax() int64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+type ServerVariable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnvKey        string                 `protobuf:"bytes,1,opt,name=env_key,json=envKey,proto3" json:"env_key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerVariable) Reset() {
+	*x = ServerVariable{}
+	mi := &file_common_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerVariable) ProtoMessage() {}
+
+func (x *ServerVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerVariable.ProtoReflect.Descriptor instead.
+func (*ServerVariable) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ServerVariable) GetEnvKey() string {
+	if x != nil {
+		return x.EnvKey
+	}
+	return ""
+}
+
+func (x *ServerVariable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
@@ -506,14 +969,60 @@ const file_common_proto_rawDesc = "" +
 	"\x03cpu\x18\x01 \x01(\rR\x03cpu\x12\x10\n" +
 	"\x03ram\x18\x02 \x01(\rR\x03ram\x12\x12\n" +
 	"\x04swap\x18\x03 \x01(\rR\x04swap\x12\x18\n" +
-	"\astorage\x18\x04 \x01(\rR\astorage\"M\n" +
+	"\astorage\x18\x04 \x01(\rR\astorage\"\xdf\x03\n" +
 	"\rResourceUsage\x12\x10\n" +
 	"\x03cpu\x18\x01 \x01(\x02R\x03cpu\x12\x10\n" +
 	"\x03ram\x18\x02 \x01(\x02R\x03ram\x12\x18\n" +
-	"\astorage\x18\x03 \x01(\x02R\astorage\"2\n" +
+	"\astorage\x18\x03 \x01(\x02R\astorage\x12 \n" +
+	"\fcpu_of_limit\x18\x04 \x01(\x02R\n" +
+	"cpuOfLimit\x12\x1b\n" +
+	"\tcpu_cores\x18\x05 \x03(\x02R\bcpuCores\x12(\n" +
+	"\x10network_rx_bytes\x18\x06 \x01(\x03R\x0enetworkRxBytes\x12(\n" +
+	"\x10network_tx_bytes\x18\a \x01(\x03R\x0enetworkTxBytes\x12&\n" +
+	"\x0fnetwork_rx_rate\x18\b \x01(\x02R\rnetworkRxRate\x12&\n" +
+	"\x0fnetwork_tx_rate\x18\t \x01(\x02R\rnetworkTxRate\x12(\n" +
+	"\x10block_read_bytes\x18\n" +
+	" \x01(\x03R\x0eblockReadBytes\x12*\n" +
+	"\x11block_write_bytes\x18\v \x01(\x03R\x0fblockWriteBytes\x12\x12\n" +
+	"\x04pids\x18\f \x01(\x03R\x04pids\x12\x16\n" +
+	"\x06uptime\x18\r \x01(\x03R\x06uptime\x12+\n" +
+	"\x05limit\x18\x0e \x01(\v2\x15.common.ResourceLimitR\x05limit\"2\n" +
 	"\fIPAllocation\x12\x0e\n" +
 	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x12\n" +
-	"\x04port\x18\x02 \x01(\rR\x04portB\x17Z\x15panelium/proto_gen_gob\x06proto3"
+	"\x04port\x18\x02 \x01(\rR\x04port\"i\n" +
+	"\rBlueprintPort\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\rR\x04port\x120\n" +
+	"\bprotocol\x18\x03 \x01(\x0e2\x14.common.PortProtocolR\bprotocol\"\xc4\x02\n" +
+	"\x11BlueprintVariable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\aenv_key\x18\x02 \x01(\tR\x06envKey\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12(\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x14.common.VariableTypeR\x04type\x12#\n" +
+	"\rdefault_value\x18\x05 \x01(\tR\fdefaultValue\x12#\n" +
+	"\ruser_editable\x18\x06 \x01(\bR\fuserEditable\x12\x14\n" +
+	"\x05regex\x18\a \x01(\tR\x05regex\x12\x18\n" +
+	"\aoptions\x18\b \x03(\tR\aoptions\x12\x15\n" +
+	"\x03min\x18\t \x01(\x03H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\n" +
+	" \x01(\x03H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"?\n" +
+	"\x0eServerVariable\x12\x17\n" +
+	"\aenv_key\x18\x01 \x01(\tR\x06envKey\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value*a\n" +
+	"\rRestartPolicy\x12\x18\n" +
+	"\x14RESTART_POLICY_NEVER\x10\x00\x12\x1b\n" +
+	"\x17RESTART_POLICY_ON_CRASH\x10\x01\x12\x19\n" +
+	"\x15RESTART_POLICY_ALWAYS\x10\x02*W\n" +
+	"\fPortProtocol\x12\x19\n" +
+	"\x15PORT_PROTOCOL_TCP_UDP\x10\x00\x12\x15\n" +
+	"\x11PORT_PROTOCOL_TCP\x10\x01\x12\x15\n" +
+	"\x11PORT_PROTOCOL_UDP\x10\x02*^\n" +
+	"\fVariableType\x12\x18\n" +
+	"\x14VARIABLE_TYPE_STRING\x10\x00\x12\x19\n" +
+	"\x15VARIABLE_TYPE_INTEGER\x10\x01\x12\x19\n" +
+	"\x15VARIABLE_TYPE_BOOLEAN\x10\x02B\x17Z\x15panelium/proto_gen_gob\x06proto3"
 
 var (
 	file_common_proto_rawDescOnce sync.Once
@@ -527,24 +1036,34 @@ func file_common_proto_rawDescGZIP() []byte {
 	return file_common_proto_rawDescData
 }
 
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_common_proto_goTypes = []any{
-	(*Empty)(nil),           // 0: common.Empty
-	(*SimpleIDMessage)(nil), // 1: common.SimpleIDMessage
-	(*IDMessage)(nil),       // 2: common.IDMessage
-	(*SimpleMessage)(nil),   // 3: common.SimpleMessage
-	(*SuccessMessage)(nil),  // 4: common.SuccessMessage
-	(*Pagination)(nil),      // 5: common.Pagination
-	(*ResourceLimit)(nil),   // 6: common.ResourceLimit
-	(*ResourceUsage)(nil),   // 7: common.ResourceUsage
-	(*IPAllocation)(nil),    // 8: common.IPAllocation
+	(RestartPolicy)(0),        // 0: common.RestartPolicy
+	(PortProtocol)(0),         // 1: common.PortProtocol
+	(VariableType)(0),         // 2: common.VariableType
+	(*Empty)(nil),             // 3: common.Empty
+	(*SimpleIDMessage)(nil),   // 4: common.SimpleIDMessage
+	(*IDMessage)(nil),         // 5: common.IDMessage
+	(*SimpleMessage)(nil),     // 6: common.SimpleMessage
+	(*SuccessMessage)(nil),    // 7: common.SuccessMessage
+	(*Pagination)(nil),        // 8: common.Pagination
+	(*ResourceLimit)(nil),     // 9: common.ResourceLimit
+	(*ResourceUsage)(nil),     // 10: common.ResourceUsage
+	(*IPAllocation)(nil),      // 11: common.IPAllocation
+	(*BlueprintPort)(nil),     // 12: common.BlueprintPort
+	(*BlueprintVariable)(nil), // 13: common.BlueprintVariable
+	(*ServerVariable)(nil),    // 14: common.ServerVariable
 }
 var file_common_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	9, // 0: common.ResourceUsage.limit:type_name -> common.ResourceLimit
+	1, // 1: common.BlueprintPort.protocol:type_name -> common.PortProtocol
+	2, // 2: common.BlueprintVariable.type:type_name -> common.VariableType
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_common_proto_init() }
@@ -553,18 +1072,20 @@ func file_common_proto_init() {
 		return
 	}
 	file_common_proto_msgTypes[5].OneofWrappers = []any{}
+	file_common_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_common_proto_goTypes,
 		DependencyIndexes: file_common_proto_depIdxs,
+		EnumInfos:         file_common_proto_enumTypes,
 		MessageInfos:      file_common_proto_msgTypes,
 	}.Build()
 	File_common_proto = out.File
@@ -23,14 +23,16 @@ const (
 )
 
 type Server struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Sid           string                       `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
-	OwnerId       string                       `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	UserIds       []string                     `protobuf:"bytes,3,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
-	Allocations   []*proto_gen_go.IPAllocation `protobuf:"bytes,4,rep,name=allocations,proto3" json:"allocations,omitempty"`
-	ResourceLimit *proto_gen_go.ResourceLimit  `protobuf:"bytes,5,opt,name=resource_limit,json=resourceLimit,proto3" json:"resource_limit,omitempty"`
-	DockerImage   string                       `protobuf:"bytes,6,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
-	Bid           string                       `protobuf:"bytes,7,opt,name=bid,proto3" json:"bid,omitempty"`
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Sid           string                         `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+	OwnerId       string                         `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	UserIds       []string                       `protobuf:"bytes,3,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Allocations   []*proto_gen_go.IPAllocation   `protobuf:"bytes,4,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	ResourceLimit *proto_gen_go.ResourceLimit    `protobuf:"bytes,5,opt,name=resource_limit,json=resourceLimit,proto3" json:"resource_limit,omitempty"`
+	DockerImage   string                         `protobuf:"bytes,6,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
+	Bid           string                         `protobuf:"bytes,7,opt,name=bid,proto3" json:"bid,omitempty"`
+	RestartPolicy proto_gen_go.RestartPolicy     `protobuf:"varint,8,opt,name=restart_policy,json=restartPolicy,proto3,enum=common.RestartPolicy" json:"restart_policy,omitempty"`
+	Variables     []*proto_gen_go.ServerVariable `protobuf:"bytes,9,rep,name=variables,proto3" json:"variables,omitempty"` // values of the blueprint's variables, missing ones use the default
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,11 +116,25 @@ func (x *Server) GetBid() string {
 	return ""
 }
 
+func (x *Server) GetRestartPolicy() proto_gen_go.RestartPolicy {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return proto_gen_go.RestartPolicy(0)
+}
+
+func (x *Server) GetVariables() []*proto_gen_go.ServerVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
 var File_daemon_Backend_proto protoreflect.FileDescriptor
 
 const file_daemon_Backend_proto_rawDesc = "" +
 	"\n" +
-	"\x14daemon/Backend.proto\x12\x06daemon\x1a\fcommon.proto\"\xfb\x01\n" +
+	"\x14daemon/Backend.proto\x12\x06daemon\x1a\fcommon.proto\x1a\x13daemon/Server.proto\"\xef\x02\n" +
 	"\x06Server\x12\x10\n" +
 	"\x03sid\x18\x01 \x01(\tR\x03sid\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x19\n" +
@@ -126,11 +142,15 @@ const file_daemon_Backend_proto_rawDesc = "" +
 	"\vallocations\x18\x04 \x03(\v2\x14.common.IPAllocationR\vallocations\x12<\n" +
 	"\x0eresource_limit\x18\x05 \x01(\v2\x15.common.ResourceLimitR\rresourceLimit\x12!\n" +
 	"\fdocker_image\x18\x06 \x01(\tR\vdockerImage\x12\x10\n" +
-	"\x03bid\x18\a \x01(\tR\x03bid2\xc1\x01\n" +
+	"\x03bid\x18\a \x01(\tR\x03bid\x12<\n" +
+	"\x0erestart_policy\x18\b \x01(\x0e2\x15.common.RestartPolicyR\rrestartPolicy\x124\n" +
+	"\tvariables\x18\t \x03(\v2\x16.common.ServerVariableR\tvariables2\xd6\x02\n" +
 	"\x0eBackendService\x126\n" +
 	"\fCreateServer\x12\x0e.daemon.Server\x1a\x16.common.SuccessMessage\x126\n" +
 	"\fUpdateServer\x12\x0e.daemon.Server\x1a\x16.common.SuccessMessage\x12?\n" +
-	"\fDeleteServer\x12\x17.common.SimpleIDMessage\x1a\x16.common.SuccessMessageB\x1eZ\x1cpanelium/proto_gen_go/daemonb\x06proto3"
+	"\fDeleteServer\x12\x17.common.SimpleIDMessage\x1a\x16.common.SuccessMessage\x12K\n" +
+	"\x14ListTerminalSessions\x12\r.common.Empty\x1a$.daemon.ListTerminalSessionsResponse\x12F\n" +
+	"\x13KillTerminalSession\x12\x17.common.SimpleIDMessage\x1a\x16.common.SuccessMessageB\x1eZ\x1cpanelium/proto_gen_go/daemonb\x06proto3"
 
 var (
 	file_daemon_Backend_proto_rawDescOnce sync.Once
@@ -149,23 +169,33 @@ var file_daemon_Backend_proto_goTypes = []any{
 	(*Server)(nil),                       // 0: daemon.Server
 	(*proto_gen_go.IPAllocation)(nil),    // 1: common.IPAllocation
 	(*proto_gen_go.ResourceLimit)(nil),   // 2: common.ResourceLimit
-	(*proto_gen_go.SimpleIDMessage)(nil), // 3: common.SimpleIDMessage
-	(*proto_gen_go.SuccessMessage)(nil),  // 4: common.SuccessMessage
+	(proto_gen_go.RestartPolicy)(0),      // 3: common.RestartPolicy
+	(*proto_gen_go.ServerVariable)(nil),  // 4: common.ServerVariable
+	(*proto_gen_go.SimpleIDMessage)(nil), // 5: common.SimpleIDMessage
+	(*proto_gen_go.Empty)(nil),           // 6: common.Empty
+	(*proto_gen_go.SuccessMessage)(nil),  // 7: common.SuccessMessage
+	(*ListTerminalSessionsResponse)(nil), // 8: daemon.ListTerminalSessionsResponse
 }
 var file_daemon_Backend_proto_depIdxs = []int32{
 	1, // 0: daemon.Server.allocations:type_name -> common.IPAllocation
 	2, // 1: daemon.Server.resource_limit:type_name -> common.ResourceLimit
-	0, // 2: daemon.BackendService.CreateServer:input_type -> daemon.Server
-	0, // 3: daemon.BackendService.UpdateServer:input_type -> daemon.Server
-	3, // 4: daemon.BackendService.DeleteServer:input_type -> common.SimpleIDMessage
-	4, // 5: daemon.BackendService.CreateServer:output_type -> common.SuccessMessage
-	4, // 6: daemon.BackendService.UpdateServer:output_type -> common.SuccessMessage
-	4, // 7: daemon.BackendService.DeleteServer:output_type -> common.SuccessMessage
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: daemon.Server.restart_policy:type_name -> common.RestartPolicy
+	4, // 3: daemon.Server.variables:type_name -> common.ServerVariable
+	0, // 4: daemon.BackendService.CreateServer:input_type -> daemon.Server
+	0, // 5: daemon.BackendService.UpdateServer:input_type -> daemon.Server
+	5, // 6: daemon.BackendService.DeleteServer:input_type -> common.SimpleIDMessage
+	6, // 7: daemon.BackendService.ListTerminalSessions:input_type -> common.Empty
+	5, // 8: daemon.BackendService.KillTerminalSession:input_type -> common.SimpleIDMessage
+	7, // 9: daemon.BackendService.CreateServer:output_type -> common.SuccessMessage
+	7, // 10: daemon.BackendService.UpdateServer:output_type -> common.SuccessMessage
+	7, // 11: daemon.BackendService.DeleteServer:output_type -> common.SuccessMessage
+	8, // 12: daemon.BackendService.ListTerminalSessions:output_type -> daemon.ListTerminalSessionsResponse
+	7, // 13: daemon.BackendService.KillTerminalSession:output_type -> common.SuccessMessage
+	9, // [9:14] is the sub-list for method output_type
+	4, // [4:9] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_daemon_Backend_proto_init() }
@@ -173,6 +203,7 @@ func file_daemon_Backend_proto_init() {
 	if File_daemon_Backend_proto != nil {
 		return
 	}
+	file_daemon_Server_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -84,11 +84,12 @@ func (ServerStatusType) EnumDescriptor() ([]byte, []int) {
 type ServerOfflineReason int32
 
 const (
-	ServerOfflineReason_SERVER_OFFLINE_REASON_UNKNOWN ServerOfflineReason = 0
-	ServerOfflineReason_SERVER_OFFLINE_REASON_CREATED ServerOfflineReason = 1 // created or installed
-	ServerOfflineReason_SERVER_OFFLINE_REASON_STOPPED ServerOfflineReason = 2
-	ServerOfflineReason_SERVER_OFFLINE_REASON_KILLED  ServerOfflineReason = 3
-	ServerOfflineReason_SERVER_OFFLINE_REASON_ERROR   ServerOfflineReason = 4
+	ServerOfflineReason_SERVER_OFFLINE_REASON_UNKNOWN    ServerOfflineReason = 0
+	ServerOfflineReason_SERVER_OFFLINE_REASON_CREATED    ServerOfflineReason = 1 // created or installed
+	ServerOfflineReason_SERVER_OFFLINE_REASON_STOPPED    ServerOfflineReason = 2
+	ServerOfflineReason_SERVER_OFFLINE_REASON_KILLED     ServerOfflineReason = 3
+	ServerOfflineReason_SERVER_OFFLINE_REASON_ERROR      ServerOfflineReason = 4
+	ServerOfflineReason_SERVER_OFFLINE_REASON_TERMINATED ServerOfflineReason = 5 // did not exit after the stop command, stopped with SIGTERM
 )
 
 // Enum value maps for ServerOfflineReason.
@@ -99,13 +100,15 @@ var (
 		2: "SERVER_OFFLINE_REASON_STOPPED",
 		3: "SERVER_OFFLINE_REASON_KILLED",
 		4: "SERVER_OFFLINE_REASON_ERROR",
+		5: "SERVER_OFFLINE_REASON_TERMINATED",
 	}
 	ServerOfflineReason_value = map[string]int32{
-		"SERVER_OFFLINE_REASON_UNKNOWN": 0,
-		"SERVER_OFFLINE_REASON_CREATED": 1,
-		"SERVER_OFFLINE_REASON_STOPPED": 2,
-		"SERVER_OFFLINE_REASON_KILLED":  3,
-		"SERVER_OFFLINE_REASON_ERROR":   4,
+		"SERVER_OFFLINE_REASON_UNKNOWN":    0,
+		"SERVER_OFFLINE_REASON_CREATED":    1,
+		"SERVER_OFFLINE_REASON_STOPPED":    2,
+		"SERVER_OFFLINE_REASON_KILLED":     3,
+		"SERVER_OFFLINE_REASON_ERROR":      4,
+		"SERVER_OFFLINE_REASON_TERMINATED": 5,
 	}
 )
 
@@ -191,12 +194,111 @@ func (PowerAction) EnumDescriptor() ([]byte, []int) {
 	return file_daemon_Server_proto_rawDescGZIP(), []int{2}
 }
 
+type ResourceHistoryResolution int32
+
+const (
+	ResourceHistoryResolution_RESOURCE_HISTORY_RESOLUTION_AUTO         ResourceHistoryResolution = 0 // 5 seconds if the range starts within the last hour, otherwise 1 minute
+	ResourceHistoryResolution_RESOURCE_HISTORY_RESOLUTION_FIVE_SECONDS ResourceHistoryResolution = 1 // kept for the last hour
+	ResourceHistoryResolution_RESOURCE_HISTORY_RESOLUTION_ONE_MINUTE   ResourceHistoryResolution = 2 // kept for the last day
+)
+
+// Enum value maps for ResourceHistoryResolution.
+var (
+	ResourceHistoryResolution_name = map[int32]string{
+		0: "RESOURCE_HISTORY_RESOLUTION_AUTO",
+		1: "RESOURCE_HISTORY_RESOLUTION_FIVE_SECONDS",
+		2: "RESOURCE_HISTORY_RESOLUTION_ONE_MINUTE",
+	}
+	ResourceHistoryResolution_value = map[string]int32{
+		"RESOURCE_HISTORY_RESOLUTION_AUTO":         0,
+		"RESOURCE_HISTORY_RESOLUTION_FIVE_SECONDS": 1,
+		"RESOURCE_HISTORY_RESOLUTION_ONE_MINUTE":   2,
+	}
+)
+
+func (x ResourceHistoryResolution) Enum() *ResourceHistoryResolution {
+	p := new(ResourceHistoryResolution)
+	*p = x
+	return p
+}
+
+func (x ResourceHistoryResolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceHistoryResolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_daemon_Server_proto_enumTypes[3].Descriptor()
+}
+
+func (ResourceHistoryResolution) Type() protoreflect.EnumType {
+	return &file_daemon_Server_proto_enumTypes[3]
+}
+
+func (x ResourceHistoryResolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceHistoryResolution.Descriptor instead.
+func (ResourceHistoryResolution) EnumDescriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{3}
+}
+
+type InstallMode int32
+
+const (
+	InstallMode_INSTALL_MODE_KEEP_FILES         InstallMode = 0 // run the setup script over the existing data and recreate the container
+	InstallMode_INSTALL_MODE_CLEAN              InstallMode = 1 // wipe the data except the preserved paths, then run the setup script and recreate the container
+	InstallMode_INSTALL_MODE_RECREATE_CONTAINER InstallMode = 2 // only recreate the container, e.g. to apply image or limit changes, the data is not touched
+)
+
+// Enum value maps for InstallMode.
+var (
+	InstallMode_name = map[int32]string{
+		0: "INSTALL_MODE_KEEP_FILES",
+		1: "INSTALL_MODE_CLEAN",
+		2: "INSTALL_MODE_RECREATE_CONTAINER",
+	}
+	InstallMode_value = map[string]int32{
+		"INSTALL_MODE_KEEP_FILES":         0,
+		"INSTALL_MODE_CLEAN":              1,
+		"INSTALL_MODE_RECREATE_CONTAINER": 2,
+	}
+)
+
+func (x InstallMode) Enum() *InstallMode {
+	p := new(InstallMode)
+	*p = x
+	return p
+}
+
+func (x InstallMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstallMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_daemon_Server_proto_enumTypes[4].Descriptor()
+}
+
+func (InstallMode) Type() protoreflect.EnumType {
+	return &file_daemon_Server_proto_enumTypes[4]
+}
+
+func (x InstallMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstallMode.Descriptor instead.
+func (InstallMode) EnumDescriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{4}
+}
+
 type ServerStatus struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Status         ServerStatusType       `protobuf:"varint,1,opt,name=status,proto3,enum=daemon.ServerStatusType" json:"status,omitempty"`
 	TimestampStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp_start,json=timestampStart,proto3,oneof" json:"timestamp_start,omitempty"`
 	TimestampEnd   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp_end,json=timestampEnd,proto3,oneof" json:"timestamp_end,omitempty"`
 	OfflineReason  *ServerOfflineReason   `protobuf:"varint,4,opt,name=offline_reason,json=offlineReason,proto3,enum=daemon.ServerOfflineReason,oneof" json:"offline_reason,omitempty"`
+	ExitCode       *int32                 `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"` // set when the server exited on its own with a non-zero exit code
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -259,6 +361,65 @@ func (x *ServerStatus) GetOfflineReason() ServerOfflineReason {
 	return ServerOfflineReason_SERVER_OFFLINE_REASON_UNKNOWN
 }
 
+func (x *ServerStatus) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+type ServerStatusEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *ServerStatus          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // when the transition happened
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerStatusEvent) Reset() {
+	*x = ServerStatusEvent{}
+	mi := &file_daemon_Server_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStatusEvent) ProtoMessage() {}
+
+func (x *ServerStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStatusEvent.ProtoReflect.Descriptor instead.
+func (*ServerStatusEvent) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServerStatusEvent) GetStatus() *ServerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ServerStatusEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
 type PowerActionMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
@@ -269,7 +430,7 @@ type PowerActionMessage struct {
 
 func (x *PowerActionMessage) Reset() {
 	*x = PowerActionMessage{}
-	mi := &file_daemon_Server_proto_msgTypes[1]
+	mi := &file_daemon_Server_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -281,7 +442,7 @@ func (x *PowerActionMessage) String() string {
 func (*PowerActionMessage) ProtoMessage() {}
 
 func (x *PowerActionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_Server_proto_msgTypes[1]
+	mi := &file_daemon_Server_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,7 +455,7 @@ func (x *PowerActionMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PowerActionMessage.ProtoReflect.Descriptor instead.
 func (*PowerActionMessage) Descriptor() ([]byte, []int) {
-	return file_daemon_Server_proto_rawDescGZIP(), []int{1}
+	return file_daemon_Server_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *PowerActionMessage) GetServerId() string {
@@ -314,13 +475,14 @@ func (x *PowerActionMessage) GetAction() PowerAction {
 type ResourceUsageMessage struct {
 	state         protoimpl.MessageState      `protogen:"open.v1"`
 	Usage         *proto_gen_go.ResourceUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	Timestamp     *timestamppb.Timestamp      `protobuf:"bytes,2,opt,name=timestamp,proto3,oneof" json:"timestamp,omitempty"` // when the sample was taken, for history the start of the averaged interval
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ResourceUsageMessage) Reset() {
 	*x = ResourceUsageMessage{}
-	mi := &file_daemon_Server_proto_msgTypes[2]
+	mi := &file_daemon_Server_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -332,7 +494,7 @@ func (x *ResourceUsageMessage) String() string {
 func (*ResourceUsageMessage) ProtoMessage() {}
 
 func (x *ResourceUsageMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_Server_proto_msgTypes[2]
+	mi := &file_daemon_Server_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,7 +507,7 @@ func (x *ResourceUsageMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceUsageMessage.ProtoReflect.Descriptor instead.
 func (*ResourceUsageMessage) Descriptor() ([]byte, []int) {
-	return file_daemon_Server_proto_rawDescGZIP(), []int{2}
+	return file_daemon_Server_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ResourceUsageMessage) GetUsage() *proto_gen_go.ResourceUsage {
@@ -355,125 +517,2039 @@ func (x *ResourceUsageMessage) GetUsage() *proto_gen_go.ResourceUsage {
 	return nil
 }
 
-var File_daemon_Server_proto protoreflect.FileDescriptor
+func (x *ResourceUsageMessage) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
 
-const file_daemon_Server_proto_rawDesc = "" +
-	"\n" +
-	"\x13daemon/Server.proto\x12\x06daemon\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd2\x02\n" +
-	"\fServerStatus\x120\n" +
-	"\x06status\x18\x01 \x01(\x0e2\x18.daemon.ServerStatusTypeR\x06status\x12H\n" +
-	"\x0ftimestamp_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0etimestampStart\x88\x01\x01\x12D\n" +
-	"\rtimestamp_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\ftimestampEnd\x88\x01\x01\x12G\n" +
-	"\x0eoffline_reason\x18\x04 \x01(\x0e2\x1b.daemon.ServerOfflineReasonH\x02R\rofflineReason\x88\x01\x01B\x12\n" +
-	"\x10_timestamp_startB\x10\n" +
-	"\x0e_timestamp_endB\x11\n" +
-	"\x0f_offline_reason\"^\n" +
-	"\x12PowerActionMessage\x12\x1b\n" +
-	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12+\n" +
-	"\x06action\x18\x02 \x01(\x0e2\x13.daemon.PowerActionR\x06action\"C\n" +
-	"\x14ResourceUsageMessage\x12+\n" +
-	"\x05usage\x18\x01 \x01(\v2\x15.common.ResourceUsageR\x05usage*\xd6\x01\n" +
-	"\x10ServerStatusType\x12\x1e\n" +
-	"\x1aSERVER_STATUS_TYPE_UNKNOWN\x10\x00\x12\x1f\n" +
-	"\x1bSERVER_STATUS_TYPE_STARTING\x10\x01\x12\x1d\n" +
-	"\x19SERVER_STATUS_TYPE_ONLINE\x10\x02\x12\x1f\n" +
-	"\x1bSERVER_STATUS_TYPE_STOPPING\x10\x03\x12\x1e\n" +
-	"\x1aSERVER_STATUS_TYPE_OFFLINE\x10\x04\x12!\n" +
-	"\x1dSERVER_STATUS_TYPE_INSTALLING\x10\x05*\xc1\x01\n" +
-	"\x13ServerOfflineReason\x12!\n" +
-	"\x1dSERVER_OFFLINE_REASON_UNKNOWN\x10\x00\x12!\n" +
-	"\x1dSERVER_OFFLINE_REASON_CREATED\x10\x01\x12!\n" +
-	"\x1dSERVER_OFFLINE_REASON_STOPPED\x10\x02\x12 \n" +
-	"\x1cSERVER_OFFLINE_REASON_KILLED\x10\x03\x12\x1f\n" +
-	"\x1bSERVER_OFFLINE_REASON_ERROR\x10\x04*\x8b\x01\n" +
-	"\vPowerAction\x12\x1c\n" +
-	"\x18POWER_ACTION_UNSPECIFIED\x10\x00\x12\x16\n" +
-	"\x12POWER_ACTION_START\x10\x01\x12\x18\n" +
-	"\x14POWER_ACTION_RESTART\x10\x02\x12\x15\n" +
-	"\x11POWER_ACTION_STOP\x10\x03\x12\x15\n" +
-	"\x11POWER_ACTION_KILL\x10\x042\xf5\x03\n" +
-	"\rServerService\x12;\n" +
-	"\aConsole\x12\x17.common.SimpleIDMessage\x1a\x15.common.SimpleMessage0\x01\x122\n" +
-	"\x0eConsoleCommand\x12\x11.common.IDMessage\x1a\r.common.Empty\x12<\n" +
-	"\bTerminal\x12\x17.common.SimpleIDMessage\x1a\x15.common.SimpleMessage0\x01\x123\n" +
-	"\x0fTerminalCommand\x12\x11.common.IDMessage\x1a\r.common.Empty\x127\n" +
-	"\x06Status\x12\x17.common.SimpleIDMessage\x1a\x14.daemon.ServerStatus\x12H\n" +
-	"\rResourceUsage\x12\x17.common.SimpleIDMessage\x1a\x1c.daemon.ResourceUsageMessage0\x01\x12A\n" +
-	"\vPowerAction\x12\x1a.daemon.PowerActionMessage\x1a\x16.common.SuccessMessage\x12:\n" +
-	"\aInstall\x12\x17.common.SimpleIDMessage\x1a\x16.common.SuccessMessageB\x1eZ\x1cpanelium/proto_gen_go/daemonb\x06proto3"
+type GetResourceHistoryRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	ServerId      string                    `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	From          *timestamppb.Timestamp    `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=to,proto3,oneof" json:"to,omitempty"` // now if not set
+	Resolution    ResourceHistoryResolution `protobuf:"varint,4,opt,name=resolution,proto3,enum=daemon.ResourceHistoryResolution" json:"resolution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var (
-	file_daemon_Server_proto_rawDescOnce sync.Once
-	file_daemon_Server_proto_rawDescData []byte
-)
+func (x *GetResourceHistoryRequest) Reset() {
+	*x = GetResourceHistoryRequest{}
+	mi := &file_daemon_Server_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func file_daemon_Server_proto_rawDescGZIP() []byte {
-	file_daemon_Server_proto_rawDescOnce.Do(func() {
-		file_daemon_Server_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_daemon_Server_proto_rawDesc), len(file_daemon_Server_proto_rawDesc)))
-	})
-	return file_daemon_Server_proto_rawDescData
+func (x *GetResourceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_daemon_Server_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_daemon_Server_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
-var file_daemon_Server_proto_goTypes = []any{
-	(ServerStatusType)(0),                // 0: daemon.ServerStatusType
-	(ServerOfflineReason)(0),             // 1: daemon.ServerOfflineReason
-	(PowerAction)(0),                     // 2: daemon.PowerAction
-	(*ServerStatus)(nil),                 // 3: daemon.ServerStatus
-	(*PowerActionMessage)(nil),           // 4: daemon.PowerActionMessage
-	(*ResourceUsageMessage)(nil),         // 5: daemon.ResourceUsageMessage
-	(*timestamppb.Timestamp)(nil),        // 6: google.protobuf.Timestamp
-	(*proto_gen_go.ResourceUsage)(nil),   // 7: common.ResourceUsage
-	(*proto_gen_go.SimpleIDMessage)(nil), // 8: common.SimpleIDMessage
-	(*proto_gen_go.IDMessage)(nil),       // 9: common.IDMessage
-	(*proto_gen_go.SimpleMessage)(nil),   // 10: common.SimpleMessage
-	(*proto_gen_go.Empty)(nil),           // 11: common.Empty
-	(*proto_gen_go.SuccessMessage)(nil),  // 12: common.SuccessMessage
+func (*GetResourceHistoryRequest) ProtoMessage() {}
+
+func (x *GetResourceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_daemon_Server_proto_depIdxs = []int32{
-	0,  // 0: daemon.ServerStatus.status:type_name -> daemon.ServerStatusType
-	6,  // 1: daemon.ServerStatus.timestamp_start:type_name -> google.protobuf.Timestamp
-	6,  // 2: daemon.ServerStatus.timestamp_end:type_name -> google.protobuf.Timestamp
-	1,  // 3: daemon.ServerStatus.offline_reason:type_name -> daemon.ServerOfflineReason
-	2,  // 4: daemon.PowerActionMessage.action:type_name -> daemon.PowerAction
-	7,  // 5: daemon.ResourceUsageMessage.usage:type_name -> common.ResourceUsage
-	8,  // 6: daemon.ServerService.Console:input_type -> common.SimpleIDMessage
-	9,  // 7: daemon.ServerService.ConsoleCommand:input_type -> common.IDMessage
-	8,  // 8: daemon.ServerService.Terminal:input_type -> common.SimpleIDMessage
-	9,  // 9: daemon.ServerService.TerminalCommand:input_type -> common.IDMessage
-	8,  // 10: daemon.ServerService.Status:input_type -> common.SimpleIDMessage
-	8,  // 11: daemon.ServerService.ResourceUsage:input_type -> common.SimpleIDMessage
-	4,  // 12: daemon.ServerService.PowerAction:input_type -> daemon.PowerActionMessage
-	8,  // 13: daemon.ServerService.Install:input_type -> common.SimpleIDMessage
-	10, // 14: daemon.ServerService.Console:output_type -> common.SimpleMessage
-	11, // 15: daemon.ServerService.ConsoleCommand:output_type -> common.Empty
-	10, // 16: daemon.ServerService.Terminal:output_type -> common.SimpleMessage
-	11, // 17: daemon.ServerService.TerminalCommand:output_type -> common.Empty
-	3,  // 18: daemon.ServerService.Status:output_type -> daemon.ServerStatus
-	5,  // 19: daemon.ServerService.ResourceUsage:output_type -> daemon.ResourceUsageMessage
-	12, // 20: daemon.ServerService.PowerAction:output_type -> common.SuccessMessage
-	12, // 21: daemon.ServerService.Install:output_type -> common.SuccessMessage
-	14, // [14:22] is the sub-list for method output_type
-	6,  // [6:14] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+// Deprecated: Use GetResourceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{4}
 }
 
-func init() { file_daemon_Server_proto_init() }
-func file_daemon_Server_proto_init() {
-	if File_daemon_Server_proto != nil {
-		return
+func (x *GetResourceHistoryRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
 	}
-	file_daemon_Server_proto_msgTypes[0].OneofWrappers = []any{}
+	return ""
+}
+
+func (x *GetResourceHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetResourceHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetResourceHistoryRequest) GetResolution() ResourceHistoryResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return ResourceHistoryResolution_RESOURCE_HISTORY_RESOLUTION_AUTO
+}
+
+type ResourceHistory struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Resolution    ResourceHistoryResolution `protobuf:"varint,1,opt,name=resolution,proto3,enum=daemon.ResourceHistoryResolution" json:"resolution,omitempty"`
+	Samples       []*ResourceUsageMessage   `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"` // oldest first, intervals without samples, e.g. while the server was offline, are left out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceHistory) Reset() {
+	*x = ResourceHistory{}
+	mi := &file_daemon_Server_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceHistory) ProtoMessage() {}
+
+func (x *ResourceHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceHistory.ProtoReflect.Descriptor instead.
+func (*ResourceHistory) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResourceHistory) GetResolution() ResourceHistoryResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return ResourceHistoryResolution_RESOURCE_HISTORY_RESOLUTION_AUTO
+}
+
+func (x *ResourceHistory) GetSamples() []*ResourceUsageMessage {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type InstallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Mode          InstallMode            `protobuf:"varint,2,opt,name=mode,proto3,enum=daemon.InstallMode" json:"mode,omitempty"`
+	Preserve      []string               `protobuf:"bytes,3,rep,name=preserve,proto3" json:"preserve,omitempty"` // paths relative to the server root kept by a clean install
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallRequest) Reset() {
+	*x = InstallRequest{}
+	mi := &file_daemon_Server_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallRequest) ProtoMessage() {}
+
+func (x *InstallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallRequest.ProtoReflect.Descriptor instead.
+func (*InstallRequest) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InstallRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *InstallRequest) GetMode() InstallMode {
+	if x != nil {
+		return x.Mode
+	}
+	return InstallMode_INSTALL_MODE_KEEP_FILES
+}
+
+func (x *InstallRequest) GetPreserve() []string {
+	if x != nil {
+		return x.Preserve
+	}
+	return nil
+}
+
+type InstallProgressEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*InstallProgressEvent_ImagePull
+	//	*InstallProgressEvent_Output
+	//	*InstallProgressEvent_Result
+	Event         isInstallProgressEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallProgressEvent) Reset() {
+	*x = InstallProgressEvent{}
+	mi := &file_daemon_Server_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallProgressEvent) ProtoMessage() {}
+
+func (x *InstallProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallProgressEvent.ProtoReflect.Descriptor instead.
+func (*InstallProgressEvent) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InstallProgressEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *InstallProgressEvent) GetEvent() isInstallProgressEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *InstallProgressEvent) GetImagePull() *ImagePullProgress {
+	if x != nil {
+		if x, ok := x.Event.(*InstallProgressEvent_ImagePull); ok {
+			return x.ImagePull
+		}
+	}
+	return nil
+}
+
+func (x *InstallProgressEvent) GetOutput() *InstallOutput {
+	if x != nil {
+		if x, ok := x.Event.(*InstallProgressEvent_Output); ok {
+			return x.Output
+		}
+	}
+	return nil
+}
+
+func (x *InstallProgressEvent) GetResult() *InstallResult {
+	if x != nil {
+		if x, ok := x.Event.(*InstallProgressEvent_Result); ok {
+			return x.Result
+		}
+	}
+	return nil
+}
+
+type isInstallProgressEvent_Event interface {
+	isInstallProgressEvent_Event()
+}
+
+type InstallProgressEvent_ImagePull struct {
+	ImagePull *ImagePullProgress `protobuf:"bytes,2,opt,name=image_pull,json=imagePull,proto3,oneof"`
+}
+
+type InstallProgressEvent_Output struct {
+	Output *InstallOutput `protobuf:"bytes,3,opt,name=output,proto3,oneof"`
+}
+
+type InstallProgressEvent_Result struct {
+	Result *InstallResult `protobuf:"bytes,4,opt,name=result,proto3,oneof"` // always the last event of an install
+}
+
+func (*InstallProgressEvent_ImagePull) isInstallProgressEvent_Event() {}
+
+func (*InstallProgressEvent_Output) isInstallProgressEvent_Event() {}
+
+func (*InstallProgressEvent_Result) isInstallProgressEvent_Event() {}
+
+type ImagePullProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Layer         string                 `protobuf:"bytes,2,opt,name=layer,proto3" json:"layer,omitempty"`      // layer ID, empty for messages about the whole image
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`    // as reported by docker, e.g. "Downloading", "Extracting" or "Pull complete"
+	Current       int64                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"` // bytes
+	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`     // bytes, 0 if unknown
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImagePullProgress) Reset() {
+	*x = ImagePullProgress{}
+	mi := &file_daemon_Server_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImagePullProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImagePullProgress) ProtoMessage() {}
+
+func (x *ImagePullProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImagePullProgress.ProtoReflect.Descriptor instead.
+func (*ImagePullProgress) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ImagePullProgress) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ImagePullProgress) GetLayer() string {
+	if x != nil {
+		return x.Layer
+	}
+	return ""
+}
+
+func (x *ImagePullProgress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImagePullProgress) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *ImagePullProgress) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type InstallOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"` // a line of setup script stdout or stderr
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallOutput) Reset() {
+	*x = InstallOutput{}
+	mi := &file_daemon_Server_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallOutput) ProtoMessage() {}
+
+func (x *InstallOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallOutput.ProtoReflect.Descriptor instead.
+func (*InstallOutput) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InstallOutput) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type InstallResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ExitCode      *int32                 `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"` // exit code of the setup script, unset if the install failed before it ran
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallResult) Reset() {
+	*x = InstallResult{}
+	mi := &file_daemon_Server_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallResult) ProtoMessage() {}
+
+func (x *InstallResult) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallResult.ProtoReflect.Descriptor instead.
+func (*InstallResult) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InstallResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InstallResult) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *InstallResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type InstallRun struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Bid              string                 `protobuf:"bytes,2,opt,name=bid,proto3" json:"bid,omitempty"`
+	BlueprintVersion uint32                 `protobuf:"varint,3,opt,name=blueprint_version,json=blueprintVersion,proto3" json:"blueprint_version,omitempty"`
+	SetupDockerImage string                 `protobuf:"bytes,4,opt,name=setup_docker_image,json=setupDockerImage,proto3" json:"setup_docker_image,omitempty"`
+	DockerImage      string                 `protobuf:"bytes,5,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
+	TimestampStart   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp_start,json=timestampStart,proto3" json:"timestamp_start,omitempty"`
+	TimestampEnd     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp_end,json=timestampEnd,proto3,oneof" json:"timestamp_end,omitempty"` // unset while the install is running
+	Success          bool                   `protobuf:"varint,8,opt,name=success,proto3" json:"success,omitempty"`
+	ExitCode         *int32                 `protobuf:"varint,9,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"` // exit code of the setup script, unset if it did not run to completion
+	Error            string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	LogTruncated     bool                   `protobuf:"varint,11,opt,name=log_truncated,json=logTruncated,proto3" json:"log_truncated,omitempty"` // the output exceeded the maximum log size, the rest was dropped
+	Mode             InstallMode            `protobuf:"varint,12,opt,name=mode,proto3,enum=daemon.InstallMode" json:"mode,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InstallRun) Reset() {
+	*x = InstallRun{}
+	mi := &file_daemon_Server_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallRun) ProtoMessage() {}
+
+func (x *InstallRun) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallRun.ProtoReflect.Descriptor instead.
+func (*InstallRun) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InstallRun) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InstallRun) GetBid() string {
+	if x != nil {
+		return x.Bid
+	}
+	return ""
+}
+
+func (x *InstallRun) GetBlueprintVersion() uint32 {
+	if x != nil {
+		return x.BlueprintVersion
+	}
+	return 0
+}
+
+func (x *InstallRun) GetSetupDockerImage() string {
+	if x != nil {
+		return x.SetupDockerImage
+	}
+	return ""
+}
+
+func (x *InstallRun) GetDockerImage() string {
+	if x != nil {
+		return x.DockerImage
+	}
+	return ""
+}
+
+func (x *InstallRun) GetTimestampStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimestampStart
+	}
+	return nil
+}
+
+func (x *InstallRun) GetTimestampEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimestampEnd
+	}
+	return nil
+}
+
+func (x *InstallRun) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InstallRun) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *InstallRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *InstallRun) GetLogTruncated() bool {
+	if x != nil {
+		return x.LogTruncated
+	}
+	return false
+}
+
+func (x *InstallRun) GetMode() InstallMode {
+	if x != nil {
+		return x.Mode
+	}
+	return InstallMode_INSTALL_MODE_KEEP_FILES
+}
+
+type ListInstallRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*InstallRun          `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstallRunsResponse) Reset() {
+	*x = ListInstallRunsResponse{}
+	mi := &file_daemon_Server_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstallRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstallRunsResponse) ProtoMessage() {}
+
+func (x *ListInstallRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstallRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListInstallRunsResponse) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListInstallRunsResponse) GetRuns() []*InstallRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type GetInstallLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	RunId         uint32                 `protobuf:"varint,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstallLogRequest) Reset() {
+	*x = GetInstallLogRequest{}
+	mi := &file_daemon_Server_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstallLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstallLogRequest) ProtoMessage() {}
+
+func (x *GetInstallLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstallLogRequest.ProtoReflect.Descriptor instead.
+func (*GetInstallLogRequest) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetInstallLogRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *GetInstallLogRequest) GetRunId() uint32 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+type EulaStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Required      bool                   `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"` // whether the blueprint has the eula flag
+	Accepted      bool                   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	AcceptedBy    string                 `protobuf:"bytes,3,opt,name=accepted_by,json=acceptedBy,proto3" json:"accepted_by,omitempty"` // user ID of the owner who accepted
+	AcceptedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=accepted_at,json=acceptedAt,proto3,oneof" json:"accepted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EulaStatus) Reset() {
+	*x = EulaStatus{}
+	mi := &file_daemon_Server_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EulaStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EulaStatus) ProtoMessage() {}
+
+func (x *EulaStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EulaStatus.ProtoReflect.Descriptor instead.
+func (*EulaStatus) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EulaStatus) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *EulaStatus) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *EulaStatus) GetAcceptedBy() string {
+	if x != nil {
+		return x.AcceptedBy
+	}
+	return ""
+}
+
+func (x *EulaStatus) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return nil
+}
+
+type StorageUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UsedBytes     int64                  `protobuf:"varint,1,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	LimitBytes    int64                  `protobuf:"varint,2,opt,name=limit_bytes,json=limitBytes,proto3" json:"limit_bytes,omitempty"` // 0 if the server has no storage limit
+	Exceeded      bool                   `protobuf:"varint,3,opt,name=exceeded,proto3" json:"exceeded,omitempty"`
+	Backend       string                 `protobuf:"bytes,4,opt,name=backend,proto3" json:"backend,omitempty"` // project_quota or watcher, the watcher stops servers above their limit on its next check
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageUsage) Reset() {
+	*x = StorageUsage{}
+	mi := &file_daemon_Server_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageUsage) ProtoMessage() {}
+
+func (x *StorageUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageUsage.ProtoReflect.Descriptor instead.
+func (*StorageUsage) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StorageUsage) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *StorageUsage) GetLimitBytes() int64 {
+	if x != nil {
+		return x.LimitBytes
+	}
+	return 0
+}
+
+func (x *StorageUsage) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
+func (x *StorageUsage) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+type ConsoleLogFile struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TimestampStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp_start,json=timestampStart,proto3" json:"timestamp_start,omitempty"`
+	TimestampEnd   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp_end,json=timestampEnd,proto3" json:"timestamp_end,omitempty"` // last write
+	Size           int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`                                    // bytes on disk, archived files are compressed
+	Active         bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`                                // still written to
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConsoleLogFile) Reset() {
+	*x = ConsoleLogFile{}
+	mi := &file_daemon_Server_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsoleLogFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsoleLogFile) ProtoMessage() {}
+
+func (x *ConsoleLogFile) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsoleLogFile.ProtoReflect.Descriptor instead.
+func (*ConsoleLogFile) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ConsoleLogFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConsoleLogFile) GetTimestampStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimestampStart
+	}
+	return nil
+}
+
+func (x *ConsoleLogFile) GetTimestampEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimestampEnd
+	}
+	return nil
+}
+
+func (x *ConsoleLogFile) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ConsoleLogFile) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ListConsoleLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*ConsoleLogFile      `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConsoleLogsResponse) Reset() {
+	*x = ListConsoleLogsResponse{}
+	mi := &file_daemon_Server_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConsoleLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsoleLogsResponse) ProtoMessage() {}
+
+func (x *ListConsoleLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsoleLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListConsoleLogsResponse) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListConsoleLogsResponse) GetFiles() []*ConsoleLogFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type SearchConsoleLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3,oneof" json:"to,omitempty"` // now if not set
+	Query         string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"` // substring, or a regular expression if regex is set, empty matches every line
+	Regex         bool                   `protobuf:"varint,5,opt,name=regex,proto3" json:"regex,omitempty"`
+	Limit         uint32                 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"` // maximum number of lines, defaults to 1000 and is capped at 10000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchConsoleLogsRequest) Reset() {
+	*x = SearchConsoleLogsRequest{}
+	mi := &file_daemon_Server_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchConsoleLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchConsoleLogsRequest) ProtoMessage() {}
+
+func (x *SearchConsoleLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchConsoleLogsRequest.ProtoReflect.Descriptor instead.
+func (*SearchConsoleLogsRequest) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SearchConsoleLogsRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *SearchConsoleLogsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SearchConsoleLogsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *SearchConsoleLogsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchConsoleLogsRequest) GetRegex() bool {
+	if x != nil {
+		return x.Regex
+	}
+	return false
+}
+
+func (x *SearchConsoleLogsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ConsoleLogLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	File          string                 `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"` // name of the log file the line is in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsoleLogLine) Reset() {
+	*x = ConsoleLogLine{}
+	mi := &file_daemon_Server_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsoleLogLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsoleLogLine) ProtoMessage() {}
+
+func (x *ConsoleLogLine) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsoleLogLine.ProtoReflect.Descriptor instead.
+func (*ConsoleLogLine) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConsoleLogLine) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ConsoleLogLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ConsoleLogLine) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+type GetConsoleLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsoleLogRequest) Reset() {
+	*x = GetConsoleLogRequest{}
+	mi := &file_daemon_Server_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsoleLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsoleLogRequest) ProtoMessage() {}
+
+func (x *GetConsoleLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsoleLogRequest.ProtoReflect.Descriptor instead.
+func (*GetConsoleLogRequest) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetConsoleLogRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *GetConsoleLogRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PtyInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Input:
+	//
+	//	*PtyInput_Open
+	//	*PtyInput_Data
+	//	*PtyInput_Resize
+	Input         isPtyInput_Input `protobuf_oneof:"input"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PtyInput) Reset() {
+	*x = PtyInput{}
+	mi := &file_daemon_Server_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PtyInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PtyInput) ProtoMessage() {}
+
+func (x *PtyInput) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PtyInput.ProtoReflect.Descriptor instead.
+func (*PtyInput) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PtyInput) GetInput() isPtyInput_Input {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *PtyInput) GetOpen() *PtyOpen {
+	if x != nil {
+		if x, ok := x.Input.(*PtyInput_Open); ok {
+			return x.Open
+		}
+	}
+	return nil
+}
+
+func (x *PtyInput) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Input.(*PtyInput_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+func (x *PtyInput) GetResize() *PtySize {
+	if x != nil {
+		if x, ok := x.Input.(*PtyInput_Resize); ok {
+			return x.Resize
+		}
+	}
+	return nil
+}
+
+type isPtyInput_Input interface {
+	isPtyInput_Input()
+}
+
+type PtyInput_Open struct {
+	Open *PtyOpen `protobuf:"bytes,1,opt,name=open,proto3,oneof"` // first message only
+}
+
+type PtyInput_Data struct {
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"` // raw input as received from xterm.js onData or onBinary
+}
+
+type PtyInput_Resize struct {
+	Resize *PtySize `protobuf:"bytes,3,opt,name=resize,proto3,oneof"` // e.g. after xterm.js onResize
+}
+
+func (*PtyInput_Open) isPtyInput_Input() {}
+
+func (*PtyInput_Data) isPtyInput_Input() {}
+
+func (*PtyInput_Resize) isPtyInput_Input() {}
+
+type PtyOpen struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Size          *PtySize               `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	SessionId     *string                `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"` // attaches to a running session of the caller, a new one is started if not set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PtyOpen) Reset() {
+	*x = PtyOpen{}
+	mi := &file_daemon_Server_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PtyOpen) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PtyOpen) ProtoMessage() {}
+
+func (x *PtyOpen) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PtyOpen.ProtoReflect.Descriptor instead.
+func (*PtyOpen) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PtyOpen) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *PtyOpen) GetSize() *PtySize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+func (x *PtyOpen) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+type PtySize struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cols          uint32                 `protobuf:"varint,1,opt,name=cols,proto3" json:"cols,omitempty"`
+	Rows          uint32                 `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PtySize) Reset() {
+	*x = PtySize{}
+	mi := &file_daemon_Server_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PtySize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PtySize) ProtoMessage() {}
+
+func (x *PtySize) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PtySize.ProtoReflect.Descriptor instead.
+func (*PtySize) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PtySize) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *PtySize) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+type PtyOutput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Output:
+	//
+	//	*PtyOutput_Data
+	//	*PtyOutput_Exit
+	//	*PtyOutput_Session
+	Output        isPtyOutput_Output `protobuf_oneof:"output"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PtyOutput) Reset() {
+	*x = PtyOutput{}
+	mi := &file_daemon_Server_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PtyOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PtyOutput) ProtoMessage() {}
+
+func (x *PtyOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PtyOutput.ProtoReflect.Descriptor instead.
+func (*PtyOutput) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PtyOutput) GetOutput() isPtyOutput_Output {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *PtyOutput) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Output.(*PtyOutput_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+func (x *PtyOutput) GetExit() *PtyExit {
+	if x != nil {
+		if x, ok := x.Output.(*PtyOutput_Exit); ok {
+			return x.Exit
+		}
+	}
+	return nil
+}
+
+func (x *PtyOutput) GetSession() *TerminalSession {
+	if x != nil {
+		if x, ok := x.Output.(*PtyOutput_Session); ok {
+			return x.Session
+		}
+	}
+	return nil
+}
+
+type isPtyOutput_Output interface {
+	isPtyOutput_Output()
+}
+
+type PtyOutput_Data struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3,oneof"` // raw output, chunks can split characters and escape sequences, so pass them to xterm.js write as they are
+}
+
+type PtyOutput_Exit struct {
+	Exit *PtyExit `protobuf:"bytes,2,opt,name=exit,proto3,oneof"` // always the last message once the shell exited
+}
+
+type PtyOutput_Session struct {
+	Session *TerminalSession `protobuf:"bytes,3,opt,name=session,proto3,oneof"` // always the first message, followed by the recent output of an attached session
+}
+
+func (*PtyOutput_Data) isPtyOutput_Output() {}
+
+func (*PtyOutput_Exit) isPtyOutput_Output() {}
+
+func (*PtyOutput_Session) isPtyOutput_Output() {}
+
+type PtyExit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExitCode      *int32                 `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PtyExit) Reset() {
+	*x = PtyExit{}
+	mi := &file_daemon_Server_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PtyExit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PtyExit) ProtoMessage() {}
+
+func (x *PtyExit) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PtyExit.ProtoReflect.Descriptor instead.
+func (*PtyExit) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PtyExit) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+type TerminalSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // empty as long as users are not authenticated
+	Shell         string                 `protobuf:"bytes,4,opt,name=shell,proto3" json:"shell,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	LastActivity  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_activity,json=lastActivity,proto3" json:"last_activity,omitempty"` // last input or output, the session is closed once it was idle for too long
+	Attached      uint32                 `protobuf:"varint,7,opt,name=attached,proto3" json:"attached,omitempty"`                            // streams currently attached
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalSession) Reset() {
+	*x = TerminalSession{}
+	mi := &file_daemon_Server_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalSession) ProtoMessage() {}
+
+func (x *TerminalSession) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalSession.ProtoReflect.Descriptor instead.
+func (*TerminalSession) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TerminalSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TerminalSession) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *TerminalSession) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *TerminalSession) GetShell() string {
+	if x != nil {
+		return x.Shell
+	}
+	return ""
+}
+
+func (x *TerminalSession) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *TerminalSession) GetLastActivity() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivity
+	}
+	return nil
+}
+
+func (x *TerminalSession) GetAttached() uint32 {
+	if x != nil {
+		return x.Attached
+	}
+	return 0
+}
+
+type ListTerminalSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*TerminalSession     `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTerminalSessionsResponse) Reset() {
+	*x = ListTerminalSessionsResponse{}
+	mi := &file_daemon_Server_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTerminalSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTerminalSessionsResponse) ProtoMessage() {}
+
+func (x *ListTerminalSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_Server_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTerminalSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTerminalSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_daemon_Server_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListTerminalSessionsResponse) GetSessions() []*TerminalSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+var File_daemon_Server_proto protoreflect.FileDescriptor
+
+const file_daemon_Server_proto_rawDesc = "" +
+	"\n" +
+	"\x13daemon/Server.proto\x12\x06daemon\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x82\x03\n" +
+	"\fServerStatus\x120\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x18.daemon.ServerStatusTypeR\x06status\x12H\n" +
+	"\x0ftimestamp_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0etimestampStart\x88\x01\x01\x12D\n" +
+	"\rtimestamp_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\ftimestampEnd\x88\x01\x01\x12G\n" +
+	"\x0eoffline_reason\x18\x04 \x01(\x0e2\x1b.daemon.ServerOfflineReasonH\x02R\rofflineReason\x88\x01\x01\x12 \n" +
+	"\texit_code\x18\x05 \x01(\x05H\x03R\bexitCode\x88\x01\x01B\x12\n" +
+	"\x10_timestamp_startB\x10\n" +
+	"\x0e_timestamp_endB\x11\n" +
+	"\x0f_offline_reasonB\f\n" +
+	"\n" +
+	"_exit_code\"{\n" +
+	"\x11ServerStatusEvent\x12,\n" +
+	"\x06status\x18\x01 \x01(\v2\x14.daemon.ServerStatusR\x06status\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"^\n" +
+	"\x12PowerActionMessage\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12+\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x13.daemon.PowerActionR\x06action\"\x90\x01\n" +
+	"\x14ResourceUsageMessage\x12+\n" +
+	"\x05usage\x18\x01 \x01(\v2\x15.common.ResourceUsageR\x05usage\x12=\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\ttimestamp\x88\x01\x01B\f\n" +
+	"\n" +
+	"_timestamp\"\xe3\x01\n" +
+	"\x19GetResourceHistoryRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12/\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x02to\x88\x01\x01\x12A\n" +
+	"\n" +
+	"resolution\x18\x04 \x01(\x0e2!.daemon.ResourceHistoryResolutionR\n" +
+	"resolutionB\x05\n" +
+	"\x03_to\"\x8c\x01\n" +
+	"\x0fResourceHistory\x12A\n" +
+	"\n" +
+	"resolution\x18\x01 \x01(\x0e2!.daemon.ResourceHistoryResolutionR\n" +
+	"resolution\x126\n" +
+	"\asamples\x18\x02 \x03(\v2\x1c.daemon.ResourceUsageMessageR\asamples\"r\n" +
+	"\x0eInstallRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12'\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x13.daemon.InstallModeR\x04mode\x12\x1a\n" +
+	"\bpreserve\x18\x03 \x03(\tR\bpreserve\"\xf7\x01\n" +
+	"\x14InstallProgressEvent\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12:\n" +
+	"\n" +
+	"image_pull\x18\x02 \x01(\v2\x19.daemon.ImagePullProgressH\x00R\timagePull\x12/\n" +
+	"\x06output\x18\x03 \x01(\v2\x15.daemon.InstallOutputH\x00R\x06output\x12/\n" +
+	"\x06result\x18\x04 \x01(\v2\x15.daemon.InstallResultH\x00R\x06resultB\a\n" +
+	"\x05event\"\x87\x01\n" +
+	"\x11ImagePullProgress\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\tR\x05image\x12\x14\n" +
+	"\x05layer\x18\x02 \x01(\tR\x05layer\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
+	"\acurrent\x18\x04 \x01(\x03R\acurrent\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\"#\n" +
+	"\rInstallOutput\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"o\n" +
+	"\rInstallResult\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12 \n" +
+	"\texit_code\x18\x02 \x01(\x05H\x00R\bexitCode\x88\x01\x01\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05errorB\f\n" +
+	"\n" +
+	"_exit_code\"\xf7\x03\n" +
+	"\n" +
+	"InstallRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
+	"\x03bid\x18\x02 \x01(\tR\x03bid\x12+\n" +
+	"\x11blueprint_version\x18\x03 \x01(\rR\x10blueprintVersion\x12,\n" +
+	"\x12setup_docker_image\x18\x04 \x01(\tR\x10setupDockerImage\x12!\n" +
+	"\fdocker_image\x18\x05 \x01(\tR\vdockerImage\x12C\n" +
+	"\x0ftimestamp_start\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0etimestampStart\x12D\n" +
+	"\rtimestamp_end\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\ftimestampEnd\x88\x01\x01\x12\x18\n" +
+	"\asuccess\x18\b \x01(\bR\asuccess\x12 \n" +
+	"\texit_code\x18\t \x01(\x05H\x01R\bexitCode\x88\x01\x01\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12#\n" +
+	"\rlog_truncated\x18\v \x01(\bR\flogTruncated\x12'\n" +
+	"\x04mode\x18\f \x01(\x0e2\x13.daemon.InstallModeR\x04modeB\x10\n" +
+	"\x0e_timestamp_endB\f\n" +
+	"\n" +
+	"_exit_code\"A\n" +
+	"\x17ListInstallRunsResponse\x12&\n" +
+	"\x04runs\x18\x01 \x03(\v2\x12.daemon.InstallRunR\x04runs\"J\n" +
+	"\x14GetInstallLogRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\rR\x05runId\"\xb7\x01\n" +
+	"\n" +
+	"EulaStatus\x12\x1a\n" +
+	"\brequired\x18\x01 \x01(\bR\brequired\x12\x1a\n" +
+	"\baccepted\x18\x02 \x01(\bR\baccepted\x12\x1f\n" +
+	"\vaccepted_by\x18\x03 \x01(\tR\n" +
+	"acceptedBy\x12@\n" +
+	"\vaccepted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"acceptedAt\x88\x01\x01B\x0e\n" +
+	"\f_accepted_at\"\x84\x01\n" +
+	"\fStorageUsage\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x01 \x01(\x03R\tusedBytes\x12\x1f\n" +
+	"\vlimit_bytes\x18\x02 \x01(\x03R\n" +
+	"limitBytes\x12\x1a\n" +
+	"\bexceeded\x18\x03 \x01(\bR\bexceeded\x12\x18\n" +
+	"\abackend\x18\x04 \x01(\tR\abackend\"\xd6\x01\n" +
+	"\x0eConsoleLogFile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12C\n" +
+	"\x0ftimestamp_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0etimestampStart\x12?\n" +
+	"\rtimestamp_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ftimestampEnd\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\"G\n" +
+	"\x17ListConsoleLogsResponse\x12,\n" +
+	"\x05files\x18\x01 \x03(\v2\x16.daemon.ConsoleLogFileR\x05files\"\xe1\x01\n" +
+	"\x18SearchConsoleLogsRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12/\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x02to\x88\x01\x01\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12\x14\n" +
+	"\x05regex\x18\x05 \x01(\bR\x05regex\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\rR\x05limitB\x05\n" +
+	"\x03_to\"r\n" +
+	"\x0eConsoleLogLine\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x12\n" +
+	"\x04file\x18\x03 \x01(\tR\x04file\"G\n" +
+	"\x14GetConsoleLogRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"{\n" +
+	"\bPtyInput\x12%\n" +
+	"\x04open\x18\x01 \x01(\v2\x0f.daemon.PtyOpenH\x00R\x04open\x12\x14\n" +
+	"\x04data\x18\x02 \x01(\fH\x00R\x04data\x12)\n" +
+	"\x06resize\x18\x03 \x01(\v2\x0f.daemon.PtySizeH\x00R\x06resizeB\a\n" +
+	"\x05input\"~\n" +
+	"\aPtyOpen\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12#\n" +
+	"\x04size\x18\x02 \x01(\v2\x0f.daemon.PtySizeR\x04size\x12\"\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tH\x00R\tsessionId\x88\x01\x01B\r\n" +
+	"\v_session_id\"1\n" +
+	"\aPtySize\x12\x12\n" +
+	"\x04cols\x18\x01 \x01(\rR\x04cols\x12\x12\n" +
+	"\x04rows\x18\x02 \x01(\rR\x04rows\"\x87\x01\n" +
+	"\tPtyOutput\x12\x14\n" +
+	"\x04data\x18\x01 \x01(\fH\x00R\x04data\x12%\n" +
+	"\x04exit\x18\x02 \x01(\v2\x0f.daemon.PtyExitH\x00R\x04exit\x123\n" +
+	"\asession\x18\x03 \x01(\v2\x17.daemon.TerminalSessionH\x00R\asessionB\b\n" +
+	"\x06output\"9\n" +
+	"\aPtyExit\x12 \n" +
+	"\texit_code\x18\x01 \x01(\x05H\x00R\bexitCode\x88\x01\x01B\f\n" +
+	"\n" +
+	"_exit_code\"\x82\x02\n" +
+	"\x0fTerminalSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x14\n" +
+	"\x05shell\x18\x04 \x01(\tR\x05shell\x124\n" +
+	"\acreated\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12?\n" +
+	"\rlast_activity\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flastActivity\x12\x1a\n" +
+	"\battached\x18\a \x01(\rR\battached\"S\n" +
+	"\x1cListTerminalSessionsResponse\x123\n" +
+	"\bsessions\x18\x01 \x03(\v2\x17.daemon.TerminalSessionR\bsessions*\xd6\x01\n" +
+	"\x10ServerStatusType\x12\x1e\n" +
+	"\x1aSERVER_STATUS_TYPE_UNKNOWN\x10\x00\x12\x1f\n" +
+	"\x1bSERVER_STATUS_TYPE_STARTING\x10\x01\x12\x1d\n" +
+	"\x19SERVER_STATUS_TYPE_ONLINE\x10\x02\x12\x1f\n" +
+	"\x1bSERVER_STATUS_TYPE_STOPPING\x10\x03\x12\x1e\n" +
+	"\x1aSERVER_STATUS_TYPE_OFFLINE\x10\x04\x12!\n" +
+	"\x1dSERVER_STATUS_TYPE_INSTALLING\x10\x05*\xe7\x01\n" +
+	"\x13ServerOfflineReason\x12!\n" +
+	"\x1dSERVER_OFFLINE_REASON_UNKNOWN\x10\x00\x12!\n" +
+	"\x1dSERVER_OFFLINE_REASON_CREATED\x10\x01\x12!\n" +
+	"\x1dSERVER_OFFLINE_REASON_STOPPED\x10\x02\x12 \n" +
+	"\x1cSERVER_OFFLINE_REASON_KILLED\x10\x03\x12\x1f\n" +
+	"\x1bSERVER_OFFLINE_REASON_ERROR\x10\x04\x12$\n" +
+	" SERVER_OFFLINE_REASON_TERMINATED\x10\x05*\x8b\x01\n" +
+	"\vPowerAction\x12\x1c\n" +
+	"\x18POWER_ACTION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12POWER_ACTION_START\x10\x01\x12\x18\n" +
+	"\x14POWER_ACTION_RESTART\x10\x02\x12\x15\n" +
+	"\x11POWER_ACTION_STOP\x10\x03\x12\x15\n" +
+	"\x11POWER_ACTION_KILL\x10\x04*\x9b\x01\n" +
+	"\x19ResourceHistoryResolution\x12$\n" +
+	" RESOURCE_HISTORY_RESOLUTION_AUTO\x10\x00\x12,\n" +
+	"(RESOURCE_HISTORY_RESOLUTION_FIVE_SECONDS\x10\x01\x12*\n" +
+	"&RESOURCE_HISTORY_RESOLUTION_ONE_MINUTE\x10\x02*g\n" +
+	"\vInstallMode\x12\x1b\n" +
+	"\x17INSTALL_MODE_KEEP_FILES\x10\x00\x12\x16\n" +
+	"\x12INSTALL_MODE_CLEAN\x10\x01\x12#\n" +
+	"\x1fINSTALL_MODE_RECREATE_CONTAINER\x10\x022\xa0\v\n" +
+	"\rServerService\x12;\n" +
+	"\aConsole\x12\x17.common.SimpleIDMessage\x1a\x15.common.SimpleMessage0\x01\x122\n" +
+	"\x0eConsoleCommand\x12\x11.common.IDMessage\x1a\r.common.Empty\x12K\n" +
+	"\x0fListConsoleLogs\x12\x17.common.SimpleIDMessage\x1a\x1f.daemon.ListConsoleLogsResponse\x12O\n" +
+	"\x11SearchConsoleLogs\x12 .daemon.SearchConsoleLogsRequest\x1a\x16.daemon.ConsoleLogLine0\x01\x12F\n" +
+	"\rGetConsoleLog\x12\x1c.daemon.GetConsoleLogRequest\x1a\x15.common.SimpleMessage0\x01\x12<\n" +
+	"\bTerminal\x12\x17.common.SimpleIDMessage\x1a\x15.common.SimpleMessage0\x01\x123\n" +
+	"\x0fTerminalCommand\x12\x11.common.IDMessage\x1a\r.common.Empty\x126\n" +
+	"\vPtyTerminal\x12\x10.daemon.PtyInput\x1a\x11.daemon.PtyOutput(\x010\x01\x12U\n" +
+	"\x14ListTerminalSessions\x12\x17.common.SimpleIDMessage\x1a$.daemon.ListTerminalSessionsResponse\x127\n" +
+	"\x06Status\x12\x17.common.SimpleIDMessage\x1a\x14.daemon.ServerStatus\x12C\n" +
+	"\vWatchStatus\x12\x17.common.SimpleIDMessage\x1a\x19.daemon.ServerStatusEvent0\x01\x12H\n" +
+	"\rResourceUsage\x12\x17.common.SimpleIDMessage\x1a\x1c.daemon.ResourceUsageMessage0\x01\x12P\n" +
+	"\x12GetResourceHistory\x12!.daemon.GetResourceHistoryRequest\x1a\x17.daemon.ResourceHistory\x12A\n" +
+	"\vPowerAction\x12\x1a.daemon.PowerActionMessage\x1a\x16.common.SuccessMessage\x129\n" +
+	"\aInstall\x12\x16.daemon.InstallRequest\x1a\x16.common.SuccessMessage\x12J\n" +
+	"\x0fInstallProgress\x12\x17.common.SimpleIDMessage\x1a\x1c.daemon.InstallProgressEvent0\x01\x12K\n" +
+	"\x0fListInstallRuns\x12\x17.common.SimpleIDMessage\x1a\x1f.daemon.ListInstallRunsResponse\x12F\n" +
+	"\rGetInstallLog\x12\x1c.daemon.GetInstallLogRequest\x1a\x15.common.SimpleMessage0\x01\x12<\n" +
+	"\rGetEulaStatus\x12\x17.common.SimpleIDMessage\x1a\x12.daemon.EulaStatus\x12=\n" +
+	"\n" +
+	"AcceptEula\x12\x17.common.SimpleIDMessage\x1a\x16.common.SuccessMessage\x12@\n" +
+	"\x0fGetStorageUsage\x12\x17.common.SimpleIDMessage\x1a\x14.daemon.StorageUsageB\x1eZ\x1cpanelium/proto_gen_go/daemonb\x06proto3"
+
+var (
+	file_daemon_Server_proto_rawDescOnce sync.Once
+	file_daemon_Server_proto_rawDescData []byte
+)
+
+func file_daemon_Server_proto_rawDescGZIP() []byte {
+	file_daemon_Server_proto_rawDescOnce.Do(func() {
+		file_daemon_Server_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_daemon_Server_proto_rawDesc), len(file_daemon_Server_proto_rawDesc)))
+	})
+	return file_daemon_Server_proto_rawDescData
+}
+
+var file_daemon_Server_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_daemon_Server_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_daemon_Server_proto_goTypes = []any{
+	(ServerStatusType)(0),                // 0: daemon.ServerStatusType
+	(ServerOfflineReason)(0),             // 1: daemon.ServerOfflineReason
+	(PowerAction)(0),                     // 2: daemon.PowerAction
+	(ResourceHistoryResolution)(0),       // 3: daemon.ResourceHistoryResolution
+	(InstallMode)(0),                     // 4: daemon.InstallMode
+	(*ServerStatus)(nil),                 // 5: daemon.ServerStatus
+	(*ServerStatusEvent)(nil),            // 6: daemon.ServerStatusEvent
+	(*PowerActionMessage)(nil),           // 7: daemon.PowerActionMessage
+	(*ResourceUsageMessage)(nil),         // 8: daemon.ResourceUsageMessage
+	(*GetResourceHistoryRequest)(nil),    // 9: daemon.GetResourceHistoryRequest
+	(*ResourceHistory)(nil),              // 10: daemon.ResourceHistory
+	(*InstallRequest)(nil),               // 11: daemon.InstallRequest
+	(*InstallProgressEvent)(nil),         // 12: daemon.InstallProgressEvent
+	(*ImagePullProgress)(nil),            // 13: daemon.ImagePullProgress
+	(*InstallOutput)(nil),                // 14: daemon.InstallOutput
+	(*InstallResult)(nil),                // 15: daemon.InstallResult
+	(*InstallRun)(nil),                   // 16: daemon.InstallRun
+	(*ListInstallRunsResponse)(nil),      // 17: daemon.ListInstallRunsResponse
+	(*GetInstallLogRequest)(nil),         // 18: daemon.GetInstallLogRequest
+	(*EulaStatus)(nil),                   // 19: daemon.EulaStatus
+	(*StorageUsage)(nil),                 // 20: daemon.StorageUsage
+	(*ConsoleLogFile)(nil),               // 21: daemon.ConsoleLogFile
+	(*ListConsoleLogsResponse)(nil),      // 22: daemon.ListConsoleLogsResponse
+	(*SearchConsoleLogsRequest)(nil),     // 23: daemon.SearchConsoleLogsRequest
+	(*ConsoleLogLine)(nil),               // 24: daemon.ConsoleLogLine
+	(*GetConsoleLogRequest)(nil),         // 25: daemon.GetConsoleLogRequest
+	(*PtyInput)(nil),                     // 26: daemon.PtyInput
+	(*PtyOpen)(nil),                      // 27: daemon.PtyOpen
+	(*PtySize)(nil),                      // 28: daemon.PtySize
+	(*PtyOutput)(nil),                    // 29: daemon.PtyOutput
+	(*PtyExit)(nil),                      // 30: daemon.PtyExit
+	(*TerminalSession)(nil),              // 31: daemon.TerminalSession
+	(*ListTerminalSessionsResponse)(nil), // 32: daemon.ListTerminalSessionsResponse
+	(*timestamppb.Timestamp)(nil),        // 33: google.protobuf.Timestamp
+	(*proto_gen_go.ResourceUsage)(nil),   // 34: common.ResourceUsage
+	(*proto_gen_go.SimpleIDMessage)(nil), // 35: common.SimpleIDMessage
+	(*proto_gen_go.IDMessage)(nil),       // 36: common.IDMessage
+	(*proto_gen_go.SimpleMessage)(nil),   // 37: common.SimpleMessage
+	(*proto_gen_go.Empty)(nil),           // 38: common.Empty
+	(*proto_gen_go.SuccessMessage)(nil),  // 39: common.SuccessMessage
+}
+var file_daemon_Server_proto_depIdxs = []int32{
+	0,  // 0: daemon.ServerStatus.status:type_name -> daemon.ServerStatusType
+	33, // 1: daemon.ServerStatus.timestamp_start:type_name -> google.protobuf.Timestamp
+	33, // 2: daemon.ServerStatus.timestamp_end:type_name -> google.protobuf.Timestamp
+	1,  // 3: daemon.ServerStatus.offline_reason:type_name -> daemon.ServerOfflineReason
+	5,  // 4: daemon.ServerStatusEvent.status:type_name -> daemon.ServerStatus
+	33, // 5: daemon.ServerStatusEvent.timestamp:type_name -> google.protobuf.Timestamp
+	2,  // 6: daemon.PowerActionMessage.action:type_name -> daemon.PowerAction
+	34, // 7: daemon.ResourceUsageMessage.usage:type_name -> common.ResourceUsage
+	33, // 8: daemon.ResourceUsageMessage.timestamp:type_name -> google.protobuf.Timestamp
+	33, // 9: daemon.GetResourceHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	33, // 10: daemon.GetResourceHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	3,  // 11: daemon.GetResourceHistoryRequest.resolution:type_name -> daemon.ResourceHistoryResolution
+	3,  // 12: daemon.ResourceHistory.resolution:type_name -> daemon.ResourceHistoryResolution
+	8,  // 13: daemon.ResourceHistory.samples:type_name -> daemon.ResourceUsageMessage
+	4,  // 14: daemon.InstallRequest.mode:type_name -> daemon.InstallMode
+	33, // 15: daemon.InstallProgressEvent.timestamp:type_name -> google.protobuf.Timestamp
+	13, // 16: daemon.InstallProgressEvent.image_pull:type_name -> daemon.ImagePullProgress
+	14, // 17: daemon.InstallProgressEvent.output:type_name -> daemon.InstallOutput
+	15, // 18: daemon.InstallProgressEvent.result:type_name -> daemon.InstallResult
+	33, // 19: daemon.InstallRun.timestamp_start:type_name -> google.protobuf.Timestamp
+	33, // 20: daemon.InstallRun.timestamp_end:type_name -> google.protobuf.Timestamp
+	4,  // 21: daemon.InstallRun.mode:type_name -> daemon.InstallMode
+	16, // 22: daemon.ListInstallRunsResponse.runs:type_name -> daemon.InstallRun
+	33, // 23: daemon.EulaStatus.accepted_at:type_name -> google.protobuf.Timestamp
+	33, // 24: daemon.ConsoleLogFile.timestamp_start:type_name -> google.protobuf.Timestamp
+	33, // 25: daemon.ConsoleLogFile.timestamp_end:type_name -> google.protobuf.Timestamp
+	21, // 26: daemon.ListConsoleLogsResponse.files:type_name -> daemon.ConsoleLogFile
+	33, // 27: daemon.SearchConsoleLogsRequest.from:type_name -> google.protobuf.Timestamp
+	33, // 28: daemon.SearchConsoleLogsRequest.to:type_name -> google.protobuf.Timestamp
+	33, // 29: daemon.ConsoleLogLine.timestamp:type_name -> google.protobuf.Timestamp
+	27, // 30: daemon.PtyInput.open:type_name -> daemon.PtyOpen
+	28, // 31: daemon.PtyInput.resize:type_name -> daemon.PtySize
+	28, // 32: daemon.PtyOpen.size:type_name -> daemon.PtySize
+	30, // 33: daemon.PtyOutput.exit:type_name -> daemon.PtyExit
+	31, // 34: daemon.PtyOutput.session:type_name -> daemon.TerminalSession
+	33, // 35: daemon.TerminalSession.created:type_name -> google.protobuf.Timestamp
+	33, // 36: daemon.TerminalSession.last_activity:type_name -> google.protobuf.Timestamp
+	31, // 37: daemon.ListTerminalSessionsResponse.sessions:type_name -> daemon.TerminalSession
+	35, // 38: daemon.ServerService.Console:input_type -> common.SimpleIDMessage
+	36, // 39: daemon.ServerService.ConsoleCommand:input_type -> common.IDMessage
+	35, // 40: daemon.ServerService.ListConsoleLogs:input_type -> common.SimpleIDMessage
+	23, // 41: daemon.ServerService.SearchConsoleLogs:input_type -> daemon.SearchConsoleLogsRequest
+	25, // 42: daemon.ServerService.GetConsoleLog:input_type -> daemon.GetConsoleLogRequest
+	35, // 43: daemon.ServerService.Terminal:input_type -> common.SimpleIDMessage
+	36, // 44: daemon.ServerService.TerminalCommand:input_type -> common.IDMessage
+	26, // 45: daemon.ServerService.PtyTerminal:input_type -> daemon.PtyInput
+	35, // 46: daemon.ServerService.ListTerminalSessions:input_type -> common.SimpleIDMessage
+	35, // 47: daemon.ServerService.Status:input_type -> common.SimpleIDMessage
+	35, // 48: daemon.ServerService.WatchStatus:input_type -> common.SimpleIDMessage
+	35, // 49: daemon.ServerService.ResourceUsage:input_type -> common.SimpleIDMessage
+	9,  // 50: daemon.ServerService.GetResourceHistory:input_type -> daemon.GetResourceHistoryRequest
+	7,  // 51: daemon.ServerService.PowerAction:input_type -> daemon.PowerActionMessage
+	11, // 52: daemon.ServerService.Install:input_type -> daemon.InstallRequest
+	35, // 53: daemon.ServerService.InstallProgress:input_type -> common.SimpleIDMessage
+	35, // 54: daemon.ServerService.ListInstallRuns:input_type -> common.SimpleIDMessage
+	18, // 55: daemon.ServerService.GetInstallLog:input_type -> daemon.GetInstallLogRequest
+	35, // 56: daemon.ServerService.GetEulaStatus:input_type -> common.SimpleIDMessage
+	35, // 57: daemon.ServerService.AcceptEula:input_type -> common.SimpleIDMessage
+	35, // 58: daemon.ServerService.GetStorageUsage:input_type -> common.SimpleIDMessage
+	37, // 59: daemon.ServerService.Console:output_type -> common.SimpleMessage
+	38, // 60: daemon.ServerService.ConsoleCommand:output_type -> common.Empty
+	22, // 61: daemon.ServerService.ListConsoleLogs:output_type -> daemon.ListConsoleLogsResponse
+	24, // 62: daemon.ServerService.SearchConsoleLogs:output_type -> daemon.ConsoleLogLine
+	37, // 63: daemon.ServerService.GetConsoleLog:output_type -> common.SimpleMessage
+	37, // 64: daemon.ServerService.Terminal:output_type -> common.SimpleMessage
+	38, // 65: daemon.ServerService.TerminalCommand:output_type -> common.Empty
+	29, // 66: daemon.ServerService.PtyTerminal:output_type -> daemon.PtyOutput
+	32, // 67: daemon.ServerService.ListTerminalSessions:output_type -> daemon.ListTerminalSessionsResponse
+	5,  // 68: daemon.ServerService.Status:output_type -> daemon.ServerStatus
+	6,  // 69: daemon.ServerService.WatchStatus:output_type -> daemon.ServerStatusEvent
+	8,  // 70: daemon.ServerService.ResourceUsage:output_type -> daemon.ResourceUsageMessage
+	10, // 71: daemon.ServerService.GetResourceHistory:output_type -> daemon.ResourceHistory
+	39, // 72: daemon.ServerService.PowerAction:output_type -> common.SuccessMessage
+	39, // 73: daemon.ServerService.Install:output_type -> common.SuccessMessage
+	12, // 74: daemon.ServerService.InstallProgress:output_type -> daemon.InstallProgressEvent
+	17, // 75: daemon.ServerService.ListInstallRuns:output_type -> daemon.ListInstallRunsResponse
+	37, // 76: daemon.ServerService.GetInstallLog:output_type -> common.SimpleMessage
+	19, // 77: daemon.ServerService.GetEulaStatus:output_type -> daemon.EulaStatus
+	39, // 78: daemon.ServerService.AcceptEula:output_type -> common.SuccessMessage
+	20, // 79: daemon.ServerService.GetStorageUsage:output_type -> daemon.StorageUsage
+	59, // [59:80] is the sub-list for method output_type
+	38, // [38:59] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_daemon_Server_proto_init() }
+func file_daemon_Server_proto_init() {
+	if File_daemon_Server_proto != nil {
+		return
+	}
+	file_daemon_Server_proto_msgTypes[0].OneofWrappers = []any{}
+	file_daemon_Server_proto_msgTypes[3].OneofWrappers = []any{}
+	file_daemon_Server_proto_msgTypes[4].OneofWrappers = []any{}
+	file_daemon_Server_proto_msgTypes[7].OneofWrappers = []any{
+		(*InstallProgressEvent_ImagePull)(nil),
+		(*InstallProgressEvent_Output)(nil),
+		(*InstallProgressEvent_Result)(nil),
+	}
+	file_daemon_Server_proto_msgTypes[10].OneofWrappers = []any{}
+	file_daemon_Server_proto_msgTypes[11].OneofWrappers = []any{}
+	file_daemon_Server_proto_msgTypes[14].OneofWrappers = []any{}
+	file_daemon_Server_proto_msgTypes[18].OneofWrappers = []any{}
+	file_daemon_Server_proto_msgTypes[21].OneofWrappers = []any{
+		(*PtyInput_Open)(nil),
+		(*PtyInput_Data)(nil),
+		(*PtyInput_Resize)(nil),
+	}
+	file_daemon_Server_proto_msgTypes[22].OneofWrappers = []any{}
+	file_daemon_Server_proto_msgTypes[24].OneofWrappers = []any{
+		(*PtyOutput_Data)(nil),
+		(*PtyOutput_Exit)(nil),
+		(*PtyOutput_Session)(nil),
+	}
+	file_daemon_Server_proto_msgTypes[25].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_daemon_Server_proto_rawDesc), len(file_daemon_Server_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   3,
+			NumEnums:      5,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
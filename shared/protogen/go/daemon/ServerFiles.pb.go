@@ -433,6 +433,170 @@ func (x *GetDirectorySizeResponse) GetSize() int64 {
 	return 0
 }
 
+type GetDiskUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiskUsageRequest) Reset() {
+	*x = GetDiskUsageRequest{}
+	mi := &file_daemon_ServerFiles_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiskUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskUsageRequest) ProtoMessage() {}
+
+func (x *GetDiskUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_ServerFiles_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskUsageRequest) Descriptor() ([]byte, []int) {
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetDiskUsageRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type DiskUsageEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsDirectory   bool                   `protobuf:"varint,2,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"` // bytes allocated on disk, for directories everything below them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiskUsageEntry) Reset() {
+	*x = DiskUsageEntry{}
+	mi := &file_daemon_ServerFiles_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskUsageEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskUsageEntry) ProtoMessage() {}
+
+func (x *DiskUsageEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_ServerFiles_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskUsageEntry.ProtoReflect.Descriptor instead.
+func (*DiskUsageEntry) Descriptor() ([]byte, []int) {
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DiskUsageEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiskUsageEntry) GetIsDirectory() bool {
+	if x != nil {
+		return x.IsDirectory
+	}
+	return false
+}
+
+func (x *DiskUsageEntry) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type GetDiskUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Entries       []*DiskUsageEntry      `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`                   // largest first
+	LastScan      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_scan,json=lastScan,proto3" json:"last_scan,omitempty"` // time of the last full rescan, changes in between are tracked as they happen
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiskUsageResponse) Reset() {
+	*x = GetDiskUsageResponse{}
+	mi := &file_daemon_ServerFiles_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiskUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskUsageResponse) ProtoMessage() {}
+
+func (x *GetDiskUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_daemon_ServerFiles_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskUsageResponse) Descriptor() ([]byte, []int) {
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetDiskUsageResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetDiskUsageResponse) GetEntries() []*DiskUsageEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetDiskUsageResponse) GetLastScan() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastScan
+	}
+	return nil
+}
+
 // File operations
 type ReadFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -444,7 +608,7 @@ type ReadFileRequest struct {
 
 func (x *ReadFileRequest) Reset() {
 	*x = ReadFileRequest{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[7]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -456,7 +620,7 @@ func (x *ReadFileRequest) String() string {
 func (*ReadFileRequest) ProtoMessage() {}
 
 func (x *ReadFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[7]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,7 +633,7 @@ func (x *ReadFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadFileRequest.ProtoReflect.Descriptor instead.
 func (*ReadFileRequest) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{7}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ReadFileRequest) GetServerId() string {
@@ -496,7 +660,7 @@ type ReadFileResponse struct {
 
 func (x *ReadFileResponse) Reset() {
 	*x = ReadFileResponse{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[8]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -508,7 +672,7 @@ func (x *ReadFileResponse) String() string {
 func (*ReadFileResponse) ProtoMessage() {}
 
 func (x *ReadFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[8]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,7 +685,7 @@ func (x *ReadFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadFileResponse.ProtoReflect.Descriptor instead.
 func (*ReadFileResponse) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{8}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ReadFileResponse) GetContent() []byte {
@@ -549,7 +713,7 @@ type WriteFileRequest struct {
 
 func (x *WriteFileRequest) Reset() {
 	*x = WriteFileRequest{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[9]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -561,7 +725,7 @@ func (x *WriteFileRequest) String() string {
 func (*WriteFileRequest) ProtoMessage() {}
 
 func (x *WriteFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[9]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +738,7 @@ func (x *WriteFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteFileRequest.ProtoReflect.Descriptor instead.
 func (*WriteFileRequest) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{9}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *WriteFileRequest) GetServerId() string {
@@ -607,7 +771,7 @@ type WriteFileResponse struct {
 
 func (x *WriteFileResponse) Reset() {
 	*x = WriteFileResponse{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[10]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -619,7 +783,7 @@ func (x *WriteFileResponse) String() string {
 func (*WriteFileResponse) ProtoMessage() {}
 
 func (x *WriteFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[10]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,7 +796,7 @@ func (x *WriteFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteFileResponse.ProtoReflect.Descriptor instead.
 func (*WriteFileResponse) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{10}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *WriteFileResponse) GetSuccess() bool {
@@ -652,7 +816,7 @@ type DeleteFileRequest struct {
 
 func (x *DeleteFileRequest) Reset() {
 	*x = DeleteFileRequest{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[11]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -664,7 +828,7 @@ func (x *DeleteFileRequest) String() string {
 func (*DeleteFileRequest) ProtoMessage() {}
 
 func (x *DeleteFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[11]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,7 +841,7 @@ func (x *DeleteFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFileRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFileRequest) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{11}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteFileRequest) GetServerId() string {
@@ -703,7 +867,7 @@ type DeleteFileResponse struct {
 
 func (x *DeleteFileResponse) Reset() {
 	*x = DeleteFileResponse{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[12]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -715,7 +879,7 @@ func (x *DeleteFileResponse) String() string {
 func (*DeleteFileResponse) ProtoMessage() {}
 
 func (x *DeleteFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[12]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +892,7 @@ func (x *DeleteFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFileResponse.ProtoReflect.Descriptor instead.
 func (*DeleteFileResponse) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{12}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteFileResponse) GetSuccess() bool {
@@ -750,7 +914,7 @@ type MoveFileRequest struct {
 
 func (x *MoveFileRequest) Reset() {
 	*x = MoveFileRequest{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[13]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -762,7 +926,7 @@ func (x *MoveFileRequest) String() string {
 func (*MoveFileRequest) ProtoMessage() {}
 
 func (x *MoveFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[13]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +939,7 @@ func (x *MoveFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveFileRequest.ProtoReflect.Descriptor instead.
 func (*MoveFileRequest) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{13}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MoveFileRequest) GetServerId() string {
@@ -808,7 +972,7 @@ type MoveFileResponse struct {
 
 func (x *MoveFileResponse) Reset() {
 	*x = MoveFileResponse{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[14]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -820,7 +984,7 @@ func (x *MoveFileResponse) String() string {
 func (*MoveFileResponse) ProtoMessage() {}
 
 func (x *MoveFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[14]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,7 +997,7 @@ func (x *MoveFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveFileResponse.ProtoReflect.Descriptor instead.
 func (*MoveFileResponse) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{14}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *MoveFileResponse) GetSuccess() bool {
@@ -854,7 +1018,7 @@ type CopyFileRequest struct {
 
 func (x *CopyFileRequest) Reset() {
 	*x = CopyFileRequest{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[15]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -866,7 +1030,7 @@ func (x *CopyFileRequest) String() string {
 func (*CopyFileRequest) ProtoMessage() {}
 
 func (x *CopyFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[15]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,7 +1043,7 @@ func (x *CopyFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CopyFileRequest.ProtoReflect.Descriptor instead.
 func (*CopyFileRequest) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{15}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CopyFileRequest) GetServerId() string {
@@ -912,7 +1076,7 @@ type CopyFileResponse struct {
 
 func (x *CopyFileResponse) Reset() {
 	*x = CopyFileResponse{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[16]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -924,7 +1088,7 @@ func (x *CopyFileResponse) String() string {
 func (*CopyFileResponse) ProtoMessage() {}
 
 func (x *CopyFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[16]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,7 +1101,7 @@ func (x *CopyFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CopyFileResponse.ProtoReflect.Descriptor instead.
 func (*CopyFileResponse) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{16}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CopyFileResponse) GetSuccess() bool {
@@ -959,7 +1123,7 @@ type CompressFileRequest struct {
 
 func (x *CompressFileRequest) Reset() {
 	*x = CompressFileRequest{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[17]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -971,7 +1135,7 @@ func (x *CompressFileRequest) String() string {
 func (*CompressFileRequest) ProtoMessage() {}
 
 func (x *CompressFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[17]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,7 +1148,7 @@ func (x *CompressFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompressFileRequest.ProtoReflect.Descriptor instead.
 func (*CompressFileRequest) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{17}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CompressFileRequest) GetServerId() string {
@@ -1024,7 +1188,7 @@ type CompressFileResponse struct {
 
 func (x *CompressFileResponse) Reset() {
 	*x = CompressFileResponse{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[18]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1036,7 +1200,7 @@ func (x *CompressFileResponse) String() string {
 func (*CompressFileResponse) ProtoMessage() {}
 
 func (x *CompressFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[18]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,7 +1213,7 @@ func (x *CompressFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompressFileResponse.ProtoReflect.Descriptor instead.
 func (*CompressFileResponse) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{18}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CompressFileResponse) GetSuccess() bool {
@@ -1070,7 +1234,7 @@ type DecompressFileRequest struct {
 
 func (x *DecompressFileRequest) Reset() {
 	*x = DecompressFileRequest{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[19]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1082,7 +1246,7 @@ func (x *DecompressFileRequest) String() string {
 func (*DecompressFileRequest) ProtoMessage() {}
 
 func (x *DecompressFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[19]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1095,7 +1259,7 @@ func (x *DecompressFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecompressFileRequest.ProtoReflect.Descriptor instead.
 func (*DecompressFileRequest) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{19}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DecompressFileRequest) GetServerId() string {
@@ -1128,7 +1292,7 @@ type DecompressFileResponse struct {
 
 func (x *DecompressFileResponse) Reset() {
 	*x = DecompressFileResponse{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[20]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1140,7 +1304,7 @@ func (x *DecompressFileResponse) String() string {
 func (*DecompressFileResponse) ProtoMessage() {}
 
 func (x *DecompressFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[20]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1153,7 +1317,7 @@ func (x *DecompressFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecompressFileResponse.ProtoReflect.Descriptor instead.
 func (*DecompressFileResponse) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{20}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DecompressFileResponse) GetSuccess() bool {
@@ -1175,7 +1339,7 @@ type ChangeFilePermissionsRequest struct {
 
 func (x *ChangeFilePermissionsRequest) Reset() {
 	*x = ChangeFilePermissionsRequest{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[21]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1187,7 +1351,7 @@ func (x *ChangeFilePermissionsRequest) String() string {
 func (*ChangeFilePermissionsRequest) ProtoMessage() {}
 
 func (x *ChangeFilePermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[21]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1200,7 +1364,7 @@ func (x *ChangeFilePermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeFilePermissionsRequest.ProtoReflect.Descriptor instead.
 func (*ChangeFilePermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{21}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ChangeFilePermissionsRequest) GetServerId() string {
@@ -1233,7 +1397,7 @@ type ChangeFilePermissionsResponse struct {
 
 func (x *ChangeFilePermissionsResponse) Reset() {
 	*x = ChangeFilePermissionsResponse{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[22]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1245,7 +1409,7 @@ func (x *ChangeFilePermissionsResponse) String() string {
 func (*ChangeFilePermissionsResponse) ProtoMessage() {}
 
 func (x *ChangeFilePermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[22]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1258,7 +1422,7 @@ func (x *ChangeFilePermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeFilePermissionsResponse.ProtoReflect.Descriptor instead.
 func (*ChangeFilePermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{22}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ChangeFilePermissionsResponse) GetSuccess() bool {
@@ -1278,7 +1442,7 @@ type GetFilePermissionsRequest struct {
 
 func (x *GetFilePermissionsRequest) Reset() {
 	*x = GetFilePermissionsRequest{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[23]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1290,7 +1454,7 @@ func (x *GetFilePermissionsRequest) String() string {
 func (*GetFilePermissionsRequest) ProtoMessage() {}
 
 func (x *GetFilePermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[23]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1303,7 +1467,7 @@ func (x *GetFilePermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFilePermissionsRequest.ProtoReflect.Descriptor instead.
 func (*GetFilePermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{23}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetFilePermissionsRequest) GetServerId() string {
@@ -1329,7 +1493,7 @@ type GetFilePermissionsResponse struct {
 
 func (x *GetFilePermissionsResponse) Reset() {
 	*x = GetFilePermissionsResponse{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[24]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1341,7 +1505,7 @@ func (x *GetFilePermissionsResponse) String() string {
 func (*GetFilePermissionsResponse) ProtoMessage() {}
 
 func (x *GetFilePermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[24]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1354,7 +1518,7 @@ func (x *GetFilePermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFilePermissionsResponse.ProtoReflect.Descriptor instead.
 func (*GetFilePermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{24}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetFilePermissionsResponse) GetPermissions() uint32 {
@@ -1375,7 +1539,7 @@ type SearchFilesRequest struct {
 
 func (x *SearchFilesRequest) Reset() {
 	*x = SearchFilesRequest{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[25]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1387,7 +1551,7 @@ func (x *SearchFilesRequest) String() string {
 func (*SearchFilesRequest) ProtoMessage() {}
 
 func (x *SearchFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[25]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1400,7 +1564,7 @@ func (x *SearchFilesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchFilesRequest.ProtoReflect.Descriptor instead.
 func (*SearchFilesRequest) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{25}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *SearchFilesRequest) GetServerId() string {
@@ -1433,7 +1597,7 @@ type SearchFilesResponse struct {
 
 func (x *SearchFilesResponse) Reset() {
 	*x = SearchFilesResponse{}
-	mi := &file_daemon_ServerFiles_proto_msgTypes[26]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1445,7 +1609,7 @@ func (x *SearchFilesResponse) String() string {
 func (*SearchFilesResponse) ProtoMessage() {}
 
 func (x *SearchFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_daemon_ServerFiles_proto_msgTypes[26]
+	mi := &file_daemon_ServerFiles_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,7 +1622,7 @@ func (x *SearchFilesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchFilesResponse.ProtoReflect.Descriptor instead.
 func (*SearchFilesResponse) Descriptor() ([]byte, []int) {
-	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{26}
+	return file_daemon_ServerFiles_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SearchFilesResponse) GetResults() []*FileEntry {
@@ -1492,7 +1656,17 @@ const file_daemon_ServerFiles_proto_rawDesc = "" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\".\n" +
 	"\x18GetDirectorySizeResponse\x12\x12\n" +
-	"\x04size\x18\x01 \x01(\x03R\x04size\"B\n" +
+	"\x04size\x18\x01 \x01(\x03R\x04size\"2\n" +
+	"\x13GetDiskUsageRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"[\n" +
+	"\x0eDiskUsageEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fis_directory\x18\x02 \x01(\bR\visDirectory\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\"\x97\x01\n" +
+	"\x14GetDiskUsageResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x120\n" +
+	"\aentries\x18\x02 \x03(\v2\x16.daemon.DiskUsageEntryR\aentries\x127\n" +
+	"\tlast_scan\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\blastScan\"B\n" +
 	"\x0fReadFileRequest\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\"\\\n" +
@@ -1558,11 +1732,12 @@ const file_daemon_ServerFiles_proto_rawDesc = "" +
 	"\x1eCOMPRESSION_FORMAT_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16COMPRESSION_FORMAT_ZIP\x10\x01\x12\x1a\n" +
 	"\x16COMPRESSION_FORMAT_TAR\x10\x02\x12\x1b\n" +
-	"\x17COMPRESSION_FORMAT_GZIP\x10\x032\xf8\a\n" +
+	"\x17COMPRESSION_FORMAT_GZIP\x10\x032\xc3\b\n" +
 	"\x12ServerFilesService\x12L\n" +
 	"\rListDirectory\x12\x1c.daemon.ListDirectoryRequest\x1a\x1d.daemon.ListDirectoryResponse\x12R\n" +
 	"\x0fCreateDirectory\x12\x1e.daemon.CreateDirectoryRequest\x1a\x1f.daemon.CreateDirectoryResponse\x12U\n" +
-	"\x10GetDirectorySize\x12\x1f.daemon.GetDirectorySizeRequest\x1a .daemon.GetDirectorySizeResponse\x12=\n" +
+	"\x10GetDirectorySize\x12\x1f.daemon.GetDirectorySizeRequest\x1a .daemon.GetDirectorySizeResponse\x12I\n" +
+	"\fGetDiskUsage\x12\x1b.daemon.GetDiskUsageRequest\x1a\x1c.daemon.GetDiskUsageResponse\x12=\n" +
 	"\bReadFile\x12\x17.daemon.ReadFileRequest\x1a\x18.daemon.ReadFileResponse\x12@\n" +
 	"\tWriteFile\x12\x18.daemon.WriteFileRequest\x1a\x19.daemon.WriteFileResponse\x12C\n" +
 	"\n" +
@@ -1588,7 +1763,7 @@ func file_daemon_ServerFiles_proto_rawDescGZIP() []byte {
 }
 
 var file_daemon_ServerFiles_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_daemon_ServerFiles_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_daemon_ServerFiles_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_daemon_ServerFiles_proto_goTypes = []any{
 	(CompressionFormat)(0),                // 0: daemon.CompressionFormat
 	(*FileEntry)(nil),                     // 1: daemon.FileEntry
@@ -1598,65 +1773,72 @@ var file_daemon_ServerFiles_proto_goTypes = []any{
 	(*CreateDirectoryResponse)(nil),       // 5: daemon.CreateDirectoryResponse
 	(*GetDirectorySizeRequest)(nil),       // 6: daemon.GetDirectorySizeRequest
 	(*GetDirectorySizeResponse)(nil),      // 7: daemon.GetDirectorySizeResponse
-	(*ReadFileRequest)(nil),               // 8: daemon.ReadFileRequest
-	(*ReadFileResponse)(nil),              // 9: daemon.ReadFileResponse
-	(*WriteFileRequest)(nil),              // 10: daemon.WriteFileRequest
-	(*WriteFileResponse)(nil),             // 11: daemon.WriteFileResponse
-	(*DeleteFileRequest)(nil),             // 12: daemon.DeleteFileRequest
-	(*DeleteFileResponse)(nil),            // 13: daemon.DeleteFileResponse
-	(*MoveFileRequest)(nil),               // 14: daemon.MoveFileRequest
-	(*MoveFileResponse)(nil),              // 15: daemon.MoveFileResponse
-	(*CopyFileRequest)(nil),               // 16: daemon.CopyFileRequest
-	(*CopyFileResponse)(nil),              // 17: daemon.CopyFileResponse
-	(*CompressFileRequest)(nil),           // 18: daemon.CompressFileRequest
-	(*CompressFileResponse)(nil),          // 19: daemon.CompressFileResponse
-	(*DecompressFileRequest)(nil),         // 20: daemon.DecompressFileRequest
-	(*DecompressFileResponse)(nil),        // 21: daemon.DecompressFileResponse
-	(*ChangeFilePermissionsRequest)(nil),  // 22: daemon.ChangeFilePermissionsRequest
-	(*ChangeFilePermissionsResponse)(nil), // 23: daemon.ChangeFilePermissionsResponse
-	(*GetFilePermissionsRequest)(nil),     // 24: daemon.GetFilePermissionsRequest
-	(*GetFilePermissionsResponse)(nil),    // 25: daemon.GetFilePermissionsResponse
-	(*SearchFilesRequest)(nil),            // 26: daemon.SearchFilesRequest
-	(*SearchFilesResponse)(nil),           // 27: daemon.SearchFilesResponse
-	(*timestamppb.Timestamp)(nil),         // 28: google.protobuf.Timestamp
+	(*GetDiskUsageRequest)(nil),           // 8: daemon.GetDiskUsageRequest
+	(*DiskUsageEntry)(nil),                // 9: daemon.DiskUsageEntry
+	(*GetDiskUsageResponse)(nil),          // 10: daemon.GetDiskUsageResponse
+	(*ReadFileRequest)(nil),               // 11: daemon.ReadFileRequest
+	(*ReadFileResponse)(nil),              // 12: daemon.ReadFileResponse
+	(*WriteFileRequest)(nil),              // 13: daemon.WriteFileRequest
+	(*WriteFileResponse)(nil),             // 14: daemon.WriteFileResponse
+	(*DeleteFileRequest)(nil),             // 15: daemon.DeleteFileRequest
+	(*DeleteFileResponse)(nil),            // 16: daemon.DeleteFileResponse
+	(*MoveFileRequest)(nil),               // 17: daemon.MoveFileRequest
+	(*MoveFileResponse)(nil),              // 18: daemon.MoveFileResponse
+	(*CopyFileRequest)(nil),               // 19: daemon.CopyFileRequest
+	(*CopyFileResponse)(nil),              // 20: daemon.CopyFileResponse
+	(*CompressFileRequest)(nil),           // 21: daemon.CompressFileRequest
+	(*CompressFileResponse)(nil),          // 22: daemon.CompressFileResponse
+	(*DecompressFileRequest)(nil),         // 23: daemon.DecompressFileRequest
+	(*DecompressFileResponse)(nil),        // 24: daemon.DecompressFileResponse
+	(*ChangeFilePermissionsRequest)(nil),  // 25: daemon.ChangeFilePermissionsRequest
+	(*ChangeFilePermissionsResponse)(nil), // 26: daemon.ChangeFilePermissionsResponse
+	(*GetFilePermissionsRequest)(nil),     // 27: daemon.GetFilePermissionsRequest
+	(*GetFilePermissionsResponse)(nil),    // 28: daemon.GetFilePermissionsResponse
+	(*SearchFilesRequest)(nil),            // 29: daemon.SearchFilesRequest
+	(*SearchFilesResponse)(nil),           // 30: daemon.SearchFilesResponse
+	(*timestamppb.Timestamp)(nil),         // 31: google.protobuf.Timestamp
 }
 var file_daemon_ServerFiles_proto_depIdxs = []int32{
-	28, // 0: daemon.FileEntry.last_modified:type_name -> google.protobuf.Timestamp
+	31, // 0: daemon.FileEntry.last_modified:type_name -> google.protobuf.Timestamp
 	1,  // 1: daemon.ListDirectoryResponse.files:type_name -> daemon.FileEntry
-	1,  // 2: daemon.ReadFileResponse.file_info:type_name -> daemon.FileEntry
-	0,  // 3: daemon.CompressFileRequest.format:type_name -> daemon.CompressionFormat
-	1,  // 4: daemon.SearchFilesResponse.results:type_name -> daemon.FileEntry
-	2,  // 5: daemon.ServerFilesService.ListDirectory:input_type -> daemon.ListDirectoryRequest
-	4,  // 6: daemon.ServerFilesService.CreateDirectory:input_type -> daemon.CreateDirectoryRequest
-	6,  // 7: daemon.ServerFilesService.GetDirectorySize:input_type -> daemon.GetDirectorySizeRequest
-	8,  // 8: daemon.ServerFilesService.ReadFile:input_type -> daemon.ReadFileRequest
-	10, // 9: daemon.ServerFilesService.WriteFile:input_type -> daemon.WriteFileRequest
-	12, // 10: daemon.ServerFilesService.DeleteFile:input_type -> daemon.DeleteFileRequest
-	14, // 11: daemon.ServerFilesService.MoveFile:input_type -> daemon.MoveFileRequest
-	16, // 12: daemon.ServerFilesService.CopyFile:input_type -> daemon.CopyFileRequest
-	18, // 13: daemon.ServerFilesService.CompressFile:input_type -> daemon.CompressFileRequest
-	20, // 14: daemon.ServerFilesService.DecompressFile:input_type -> daemon.DecompressFileRequest
-	22, // 15: daemon.ServerFilesService.ChangeFilePermissions:input_type -> daemon.ChangeFilePermissionsRequest
-	24, // 16: daemon.ServerFilesService.GetFilePermissions:input_type -> daemon.GetFilePermissionsRequest
-	26, // 17: daemon.ServerFilesService.SearchFiles:input_type -> daemon.SearchFilesRequest
-	3,  // 18: daemon.ServerFilesService.ListDirectory:output_type -> daemon.ListDirectoryResponse
-	5,  // 19: daemon.ServerFilesService.CreateDirectory:output_type -> daemon.CreateDirectoryResponse
-	7,  // 20: daemon.ServerFilesService.GetDirectorySize:output_type -> daemon.GetDirectorySizeResponse
-	9,  // 21: daemon.ServerFilesService.ReadFile:output_type -> daemon.ReadFileResponse
-	11, // 22: daemon.ServerFilesService.WriteFile:output_type -> daemon.WriteFileResponse
-	13, // 23: daemon.ServerFilesService.DeleteFile:output_type -> daemon.DeleteFileResponse
-	15, // 24: daemon.ServerFilesService.MoveFile:output_type -> daemon.MoveFileResponse
-	17, // 25: daemon.ServerFilesService.CopyFile:output_type -> daemon.CopyFileResponse
-	19, // 26: daemon.ServerFilesService.CompressFile:output_type -> daemon.CompressFileResponse
-	21, // 27: daemon.ServerFilesService.DecompressFile:output_type -> daemon.DecompressFileResponse
-	23, // 28: daemon.ServerFilesService.ChangeFilePermissions:output_type -> daemon.ChangeFilePermissionsResponse
-	25, // 29: daemon.ServerFilesService.GetFilePermissions:output_type -> daemon.GetFilePermissionsResponse
-	27, // 30: daemon.ServerFilesService.SearchFiles:output_type -> daemon.SearchFilesResponse
-	18, // [18:31] is the sub-list for method output_type
-	5,  // [5:18] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	9,  // 2: daemon.GetDiskUsageResponse.entries:type_name -> daemon.DiskUsageEntry
+	31, // 3: daemon.GetDiskUsageResponse.last_scan:type_name -> google.protobuf.Timestamp
+	1,  // 4: daemon.ReadFileResponse.file_info:type_name -> daemon.FileEntry
+	0,  // 5: daemon.CompressFileRequest.format:type_name -> daemon.CompressionFormat
+	1,  // 6: daemon.SearchFilesResponse.results:type_name -> daemon.FileEntry
+	2,  // 7: daemon.ServerFilesService.ListDirectory:input_type -> daemon.ListDirectoryRequest
+	4,  // 8: daemon.ServerFilesService.CreateDirectory:input_type -> daemon.CreateDirectoryRequest
+	6,  // 9: daemon.ServerFilesService.GetDirectorySize:input_type -> daemon.GetDirectorySizeRequest
+	8,  // 10: daemon.ServerFilesService.GetDiskUsage:input_type -> daemon.GetDiskUsageRequest
+	11, // 11: daemon.ServerFilesService.ReadFile:input_type -> daemon.ReadFileRequest
+	13, // 12: daemon.ServerFilesService.WriteFile:input_type -> daemon.WriteFileRequest
+	15, // 13: daemon.ServerFilesService.DeleteFile:input_type -> daemon.DeleteFileRequest
+	17, // 14: daemon.ServerFilesService.MoveFile:input_type -> daemon.MoveFileRequest
+	19, // 15: daemon.ServerFilesService.CopyFile:input_type -> daemon.CopyFileRequest
+	21, // 16: daemon.ServerFilesService.CompressFile:input_type -> daemon.CompressFileRequest
+	23, // 17: daemon.ServerFilesService.DecompressFile:input_type -> daemon.DecompressFileRequest
+	25, // 18: daemon.ServerFilesService.ChangeFilePermissions:input_type -> daemon.ChangeFilePermissionsRequest
+	27, // 19: daemon.ServerFilesService.GetFilePermissions:input_type -> daemon.GetFilePermissionsRequest
+	29, // 20: daemon.ServerFilesService.SearchFiles:input_type -> daemon.SearchFilesRequest
+	3,  // 21: daemon.ServerFilesService.ListDirectory:output_type -> daemon.ListDirectoryResponse
+	5,  // 22: daemon.ServerFilesService.CreateDirectory:output_type -> daemon.CreateDirectoryResponse
+	7,  // 23: daemon.ServerFilesService.GetDirectorySize:output_type -> daemon.GetDirectorySizeResponse
+	10, // 24: daemon.ServerFilesService.GetDiskUsage:output_type -> daemon.GetDiskUsageResponse
+	12, // 25: daemon.ServerFilesService.ReadFile:output_type -> daemon.ReadFileResponse
+	14, // 26: daemon.ServerFilesService.WriteFile:output_type -> daemon.WriteFileResponse
+	16, // 27: daemon.ServerFilesService.DeleteFile:output_type -> daemon.DeleteFileResponse
+	18, // 28: daemon.ServerFilesService.MoveFile:output_type -> daemon.MoveFileResponse
+	20, // 29: daemon.ServerFilesService.CopyFile:output_type -> daemon.CopyFileResponse
+	22, // 30: daemon.ServerFilesService.CompressFile:output_type -> daemon.CompressFileResponse
+	24, // 31: daemon.ServerFilesService.DecompressFile:output_type -> daemon.DecompressFileResponse
+	26, // 32: daemon.ServerFilesService.ChangeFilePermissions:output_type -> daemon.ChangeFilePermissionsResponse
+	28, // 33: daemon.ServerFilesService.GetFilePermissions:output_type -> daemon.GetFilePermissionsResponse
+	30, // 34: daemon.ServerFilesService.SearchFiles:output_type -> daemon.SearchFilesResponse
+	21, // [21:35] is the sub-list for method output_type
+	7,  // [7:21] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_daemon_ServerFiles_proto_init() }
@@ -1670,7 +1852,7 @@ func file_daemon_ServerFiles_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_daemon_ServerFiles_proto_rawDesc), len(file_daemon_ServerFiles_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   27,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -43,6 +43,12 @@ const (
 	// BackendServiceDeleteServerProcedure is the fully-qualified name of the BackendService's
 	// DeleteServer RPC.
 	BackendServiceDeleteServerProcedure = "/daemon.BackendService/DeleteServer"
+	// BackendServiceListTerminalSessionsProcedure is the fully-qualified name of the BackendService's
+	// ListTerminalSessions RPC.
+	BackendServiceListTerminalSessionsProcedure = "/daemon.BackendService/ListTerminalSessions"
+	// BackendServiceKillTerminalSessionProcedure is the fully-qualified name of the BackendService's
+	// KillTerminalSession RPC.
+	BackendServiceKillTerminalSessionProcedure = "/daemon.BackendService/KillTerminalSession"
 )
 
 // BackendServiceClient is a client for the daemon.BackendService service.
@@ -50,6 +56,8 @@ type BackendServiceClient interface {
 	CreateServer(context.Context, *connect.Request[daemon.Server]) (*connect.Response[proto_gen_go.SuccessMessage], error)
 	UpdateServer(context.Context, *connect.Request[daemon.Server]) (*connect.Response[proto_gen_go.SuccessMessage], error)
 	DeleteServer(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error)
+	ListTerminalSessions(context.Context, *connect.Request[proto_gen_go.Empty]) (*connect.Response[daemon.ListTerminalSessionsResponse], error)
+	KillTerminalSession(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error)
 }
 
 // NewBackendServiceClient constructs a client for the daemon.BackendService service. By default, it
@@ -81,14 +89,28 @@ func NewBackendServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(backendServiceMethods.ByName("DeleteServer")),
 			connect.WithClientOptions(opts...),
 		),
+		listTerminalSessions: connect.NewClient[proto_gen_go.Empty, daemon.ListTerminalSessionsResponse](
+			httpClient,
+			baseURL+BackendServiceListTerminalSessionsProcedure,
+			connect.WithSchema(backendServiceMethods.ByName("ListTerminalSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		killTerminalSession: connect.NewClient[proto_gen_go.SimpleIDMessage, proto_gen_go.SuccessMessage](
+			httpClient,
+			baseURL+BackendServiceKillTerminalSessionProcedure,
+			connect.WithSchema(backendServiceMethods.ByName("KillTerminalSession")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // backendServiceClient implements BackendServiceClient.
 type backendServiceClient struct {
-	createServer *connect.Client[daemon.Server, proto_gen_go.SuccessMessage]
-	updateServer *connect.Client[daemon.Server, proto_gen_go.SuccessMessage]
-	deleteServer *connect.Client[proto_gen_go.SimpleIDMessage, proto_gen_go.SuccessMessage]
+	createServer         *connect.Client[daemon.Server, proto_gen_go.SuccessMessage]
+	updateServer         *connect.Client[daemon.Server, proto_gen_go.SuccessMessage]
+	deleteServer         *connect.Client[proto_gen_go.SimpleIDMessage, proto_gen_go.SuccessMessage]
+	listTerminalSessions *connect.Client[proto_gen_go.Empty, daemon.ListTerminalSessionsResponse]
+	killTerminalSession  *connect.Client[proto_gen_go.SimpleIDMessage, proto_gen_go.SuccessMessage]
 }
 
 // CreateServer calls daemon.BackendService.CreateServer.
@@ -106,11 +128,23 @@ func (c *backendServiceClient) DeleteServer(ctx context.Context, req *connect.Re
 	return c.deleteServer.CallUnary(ctx, req)
 }
 
+// ListTerminalSessions calls daemon.BackendService.ListTerminalSessions.
+func (c *backendServiceClient) ListTerminalSessions(ctx context.Context, req *connect.Request[proto_gen_go.Empty]) (*connect.Response[daemon.ListTerminalSessionsResponse], error) {
+	return c.listTerminalSessions.CallUnary(ctx, req)
+}
+
+// KillTerminalSession calls daemon.BackendService.KillTerminalSession.
+func (c *backendServiceClient) KillTerminalSession(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
+	return c.killTerminalSession.CallUnary(ctx, req)
+}
+
 // BackendServiceHandler is an implementation of the daemon.BackendService service.
 type BackendServiceHandler interface {
 	CreateServer(context.Context, *connect.Request[daemon.Server]) (*connect.Response[proto_gen_go.SuccessMessage], error)
 	UpdateServer(context.Context, *connect.Request[daemon.Server]) (*connect.Response[proto_gen_go.SuccessMessage], error)
 	DeleteServer(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error)
+	ListTerminalSessions(context.Context, *connect.Request[proto_gen_go.Empty]) (*connect.Response[daemon.ListTerminalSessionsResponse], error)
+	KillTerminalSession(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error)
 }
 
 // NewBackendServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -138,6 +172,18 @@ func NewBackendServiceHandler(svc BackendServiceHandler, opts ...connect.Handler
 		connect.WithSchema(backendServiceMethods.ByName("DeleteServer")),
 		connect.WithHandlerOptions(opts...),
 	)
+	backendServiceListTerminalSessionsHandler := connect.NewUnaryHandler(
+		BackendServiceListTerminalSessionsProcedure,
+		svc.ListTerminalSessions,
+		connect.WithSchema(backendServiceMethods.ByName("ListTerminalSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backendServiceKillTerminalSessionHandler := connect.NewUnaryHandler(
+		BackendServiceKillTerminalSessionProcedure,
+		svc.KillTerminalSession,
+		connect.WithSchema(backendServiceMethods.ByName("KillTerminalSession")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/daemon.BackendService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BackendServiceCreateServerProcedure:
@@ -146,6 +192,10 @@ func NewBackendServiceHandler(svc BackendServiceHandler, opts ...connect.Handler
 			backendServiceUpdateServerHandler.ServeHTTP(w, r)
 		case BackendServiceDeleteServerProcedure:
 			backendServiceDeleteServerHandler.ServeHTTP(w, r)
+		case BackendServiceListTerminalSessionsProcedure:
+			backendServiceListTerminalSessionsHandler.ServeHTTP(w, r)
+		case BackendServiceKillTerminalSessionProcedure:
+			backendServiceKillTerminalSessionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -166,3 +216,11 @@ func (UnimplementedBackendServiceHandler) UpdateServer(context.Context, *connect
 func (UnimplementedBackendServiceHandler) DeleteServer(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.BackendService.DeleteServer is not implemented"))
 }
+
+func (UnimplementedBackendServiceHandler) ListTerminalSessions(context.Context, *connect.Request[proto_gen_go.Empty]) (*connect.Response[daemon.ListTerminalSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.BackendService.ListTerminalSessions is not implemented"))
+}
+
+func (UnimplementedBackendServiceHandler) KillTerminalSession(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.BackendService.KillTerminalSession is not implemented"))
+}
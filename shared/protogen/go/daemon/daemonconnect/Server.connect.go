@@ -39,33 +39,85 @@ const (
 	// ServerServiceConsoleCommandProcedure is the fully-qualified name of the ServerService's
 	// ConsoleCommand RPC.
 	ServerServiceConsoleCommandProcedure = "/daemon.ServerService/ConsoleCommand"
+	// ServerServiceListConsoleLogsProcedure is the fully-qualified name of the ServerService's
+	// ListConsoleLogs RPC.
+	ServerServiceListConsoleLogsProcedure = "/daemon.ServerService/ListConsoleLogs"
+	// ServerServiceSearchConsoleLogsProcedure is the fully-qualified name of the ServerService's
+	// SearchConsoleLogs RPC.
+	ServerServiceSearchConsoleLogsProcedure = "/daemon.ServerService/SearchConsoleLogs"
+	// ServerServiceGetConsoleLogProcedure is the fully-qualified name of the ServerService's
+	// GetConsoleLog RPC.
+	ServerServiceGetConsoleLogProcedure = "/daemon.ServerService/GetConsoleLog"
 	// ServerServiceTerminalProcedure is the fully-qualified name of the ServerService's Terminal RPC.
 	ServerServiceTerminalProcedure = "/daemon.ServerService/Terminal"
 	// ServerServiceTerminalCommandProcedure is the fully-qualified name of the ServerService's
 	// TerminalCommand RPC.
 	ServerServiceTerminalCommandProcedure = "/daemon.ServerService/TerminalCommand"
+	// ServerServicePtyTerminalProcedure is the fully-qualified name of the ServerService's PtyTerminal
+	// RPC.
+	ServerServicePtyTerminalProcedure = "/daemon.ServerService/PtyTerminal"
+	// ServerServiceListTerminalSessionsProcedure is the fully-qualified name of the ServerService's
+	// ListTerminalSessions RPC.
+	ServerServiceListTerminalSessionsProcedure = "/daemon.ServerService/ListTerminalSessions"
 	// ServerServiceStatusProcedure is the fully-qualified name of the ServerService's Status RPC.
 	ServerServiceStatusProcedure = "/daemon.ServerService/Status"
+	// ServerServiceWatchStatusProcedure is the fully-qualified name of the ServerService's WatchStatus
+	// RPC.
+	ServerServiceWatchStatusProcedure = "/daemon.ServerService/WatchStatus"
 	// ServerServiceResourceUsageProcedure is the fully-qualified name of the ServerService's
 	// ResourceUsage RPC.
 	ServerServiceResourceUsageProcedure = "/daemon.ServerService/ResourceUsage"
+	// ServerServiceGetResourceHistoryProcedure is the fully-qualified name of the ServerService's
+	// GetResourceHistory RPC.
+	ServerServiceGetResourceHistoryProcedure = "/daemon.ServerService/GetResourceHistory"
 	// ServerServicePowerActionProcedure is the fully-qualified name of the ServerService's PowerAction
 	// RPC.
 	ServerServicePowerActionProcedure = "/daemon.ServerService/PowerAction"
 	// ServerServiceInstallProcedure is the fully-qualified name of the ServerService's Install RPC.
 	ServerServiceInstallProcedure = "/daemon.ServerService/Install"
+	// ServerServiceInstallProgressProcedure is the fully-qualified name of the ServerService's
+	// InstallProgress RPC.
+	ServerServiceInstallProgressProcedure = "/daemon.ServerService/InstallProgress"
+	// ServerServiceListInstallRunsProcedure is the fully-qualified name of the ServerService's
+	// ListInstallRuns RPC.
+	ServerServiceListInstallRunsProcedure = "/daemon.ServerService/ListInstallRuns"
+	// ServerServiceGetInstallLogProcedure is the fully-qualified name of the ServerService's
+	// GetInstallLog RPC.
+	ServerServiceGetInstallLogProcedure = "/daemon.ServerService/GetInstallLog"
+	// ServerServiceGetEulaStatusProcedure is the fully-qualified name of the ServerService's
+	// GetEulaStatus RPC.
+	ServerServiceGetEulaStatusProcedure = "/daemon.ServerService/GetEulaStatus"
+	// ServerServiceAcceptEulaProcedure is the fully-qualified name of the ServerService's AcceptEula
+	// RPC.
+	ServerServiceAcceptEulaProcedure = "/daemon.ServerService/AcceptEula"
+	// ServerServiceGetStorageUsageProcedure is the fully-qualified name of the ServerService's
+	// GetStorageUsage RPC.
+	ServerServiceGetStorageUsageProcedure = "/daemon.ServerService/GetStorageUsage"
 )
 
 // ServerServiceClient is a client for the daemon.ServerService service.
 type ServerServiceClient interface {
 	Console(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.ServerStreamForClient[proto_gen_go.SimpleMessage], error)
 	ConsoleCommand(context.Context, *connect.Request[proto_gen_go.IDMessage]) (*connect.Response[proto_gen_go.Empty], error)
+	ListConsoleLogs(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ListConsoleLogsResponse], error)
+	SearchConsoleLogs(context.Context, *connect.Request[daemon.SearchConsoleLogsRequest]) (*connect.ServerStreamForClient[daemon.ConsoleLogLine], error)
+	GetConsoleLog(context.Context, *connect.Request[daemon.GetConsoleLogRequest]) (*connect.ServerStreamForClient[proto_gen_go.SimpleMessage], error)
 	Terminal(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.ServerStreamForClient[proto_gen_go.SimpleMessage], error)
 	TerminalCommand(context.Context, *connect.Request[proto_gen_go.IDMessage]) (*connect.Response[proto_gen_go.Empty], error)
+	PtyTerminal(context.Context) *connect.BidiStreamForClient[daemon.PtyInput, daemon.PtyOutput]
+	ListTerminalSessions(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ListTerminalSessionsResponse], error)
 	Status(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ServerStatus], error)
+	WatchStatus(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.ServerStreamForClient[daemon.ServerStatusEvent], error)
 	ResourceUsage(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.ServerStreamForClient[daemon.ResourceUsageMessage], error)
+	GetResourceHistory(context.Context, *connect.Request[daemon.GetResourceHistoryRequest]) (*connect.Response[daemon.ResourceHistory], error)
 	PowerAction(context.Context, *connect.Request[daemon.PowerActionMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error)
-	Install(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error)
+	Install(context.Context, *connect.Request[daemon.InstallRequest]) (*connect.Response[proto_gen_go.SuccessMessage], error)
+	InstallProgress(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.ServerStreamForClient[daemon.InstallProgressEvent], error)
+	ListInstallRuns(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ListInstallRunsResponse], error)
+	GetInstallLog(context.Context, *connect.Request[daemon.GetInstallLogRequest]) (*connect.ServerStreamForClient[proto_gen_go.SimpleMessage], error)
+	GetEulaStatus(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.EulaStatus], error)
+	AcceptEula(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error)
+	GetStorageUsage(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.StorageUsage], error)
 }
 
 // NewServerServiceClient constructs a client for the daemon.ServerService service. By default, it
@@ -91,6 +143,24 @@ func NewServerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(serverServiceMethods.ByName("ConsoleCommand")),
 			connect.WithClientOptions(opts...),
 		),
+		listConsoleLogs: connect.NewClient[proto_gen_go.SimpleIDMessage, daemon.ListConsoleLogsResponse](
+			httpClient,
+			baseURL+ServerServiceListConsoleLogsProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("ListConsoleLogs")),
+			connect.WithClientOptions(opts...),
+		),
+		searchConsoleLogs: connect.NewClient[daemon.SearchConsoleLogsRequest, daemon.ConsoleLogLine](
+			httpClient,
+			baseURL+ServerServiceSearchConsoleLogsProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("SearchConsoleLogs")),
+			connect.WithClientOptions(opts...),
+		),
+		getConsoleLog: connect.NewClient[daemon.GetConsoleLogRequest, proto_gen_go.SimpleMessage](
+			httpClient,
+			baseURL+ServerServiceGetConsoleLogProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("GetConsoleLog")),
+			connect.WithClientOptions(opts...),
+		),
 		terminal: connect.NewClient[proto_gen_go.SimpleIDMessage, proto_gen_go.SimpleMessage](
 			httpClient,
 			baseURL+ServerServiceTerminalProcedure,
@@ -103,43 +173,116 @@ func NewServerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(serverServiceMethods.ByName("TerminalCommand")),
 			connect.WithClientOptions(opts...),
 		),
+		ptyTerminal: connect.NewClient[daemon.PtyInput, daemon.PtyOutput](
+			httpClient,
+			baseURL+ServerServicePtyTerminalProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("PtyTerminal")),
+			connect.WithClientOptions(opts...),
+		),
+		listTerminalSessions: connect.NewClient[proto_gen_go.SimpleIDMessage, daemon.ListTerminalSessionsResponse](
+			httpClient,
+			baseURL+ServerServiceListTerminalSessionsProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("ListTerminalSessions")),
+			connect.WithClientOptions(opts...),
+		),
 		status: connect.NewClient[proto_gen_go.SimpleIDMessage, daemon.ServerStatus](
 			httpClient,
 			baseURL+ServerServiceStatusProcedure,
 			connect.WithSchema(serverServiceMethods.ByName("Status")),
 			connect.WithClientOptions(opts...),
 		),
+		watchStatus: connect.NewClient[proto_gen_go.SimpleIDMessage, daemon.ServerStatusEvent](
+			httpClient,
+			baseURL+ServerServiceWatchStatusProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("WatchStatus")),
+			connect.WithClientOptions(opts...),
+		),
 		resourceUsage: connect.NewClient[proto_gen_go.SimpleIDMessage, daemon.ResourceUsageMessage](
 			httpClient,
 			baseURL+ServerServiceResourceUsageProcedure,
 			connect.WithSchema(serverServiceMethods.ByName("ResourceUsage")),
 			connect.WithClientOptions(opts...),
 		),
+		getResourceHistory: connect.NewClient[daemon.GetResourceHistoryRequest, daemon.ResourceHistory](
+			httpClient,
+			baseURL+ServerServiceGetResourceHistoryProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("GetResourceHistory")),
+			connect.WithClientOptions(opts...),
+		),
 		powerAction: connect.NewClient[daemon.PowerActionMessage, proto_gen_go.SuccessMessage](
 			httpClient,
 			baseURL+ServerServicePowerActionProcedure,
 			connect.WithSchema(serverServiceMethods.ByName("PowerAction")),
 			connect.WithClientOptions(opts...),
 		),
-		install: connect.NewClient[proto_gen_go.SimpleIDMessage, proto_gen_go.SuccessMessage](
+		install: connect.NewClient[daemon.InstallRequest, proto_gen_go.SuccessMessage](
 			httpClient,
 			baseURL+ServerServiceInstallProcedure,
 			connect.WithSchema(serverServiceMethods.ByName("Install")),
 			connect.WithClientOptions(opts...),
 		),
+		installProgress: connect.NewClient[proto_gen_go.SimpleIDMessage, daemon.InstallProgressEvent](
+			httpClient,
+			baseURL+ServerServiceInstallProgressProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("InstallProgress")),
+			connect.WithClientOptions(opts...),
+		),
+		listInstallRuns: connect.NewClient[proto_gen_go.SimpleIDMessage, daemon.ListInstallRunsResponse](
+			httpClient,
+			baseURL+ServerServiceListInstallRunsProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("ListInstallRuns")),
+			connect.WithClientOptions(opts...),
+		),
+		getInstallLog: connect.NewClient[daemon.GetInstallLogRequest, proto_gen_go.SimpleMessage](
+			httpClient,
+			baseURL+ServerServiceGetInstallLogProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("GetInstallLog")),
+			connect.WithClientOptions(opts...),
+		),
+		getEulaStatus: connect.NewClient[proto_gen_go.SimpleIDMessage, daemon.EulaStatus](
+			httpClient,
+			baseURL+ServerServiceGetEulaStatusProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("GetEulaStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptEula: connect.NewClient[proto_gen_go.SimpleIDMessage, proto_gen_go.SuccessMessage](
+			httpClient,
+			baseURL+ServerServiceAcceptEulaProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("AcceptEula")),
+			connect.WithClientOptions(opts...),
+		),
+		getStorageUsage: connect.NewClient[proto_gen_go.SimpleIDMessage, daemon.StorageUsage](
+			httpClient,
+			baseURL+ServerServiceGetStorageUsageProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("GetStorageUsage")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // serverServiceClient implements ServerServiceClient.
 type serverServiceClient struct {
-	console         *connect.Client[proto_gen_go.SimpleIDMessage, proto_gen_go.SimpleMessage]
-	consoleCommand  *connect.Client[proto_gen_go.IDMessage, proto_gen_go.Empty]
-	terminal        *connect.Client[proto_gen_go.SimpleIDMessage, proto_gen_go.SimpleMessage]
-	terminalCommand *connect.Client[proto_gen_go.IDMessage, proto_gen_go.Empty]
-	status          *connect.Client[proto_gen_go.SimpleIDMessage, daemon.ServerStatus]
-	resourceUsage   *connect.Client[proto_gen_go.SimpleIDMessage, daemon.ResourceUsageMessage]
-	powerAction     *connect.Client[daemon.PowerActionMessage, proto_gen_go.SuccessMessage]
-	install         *connect.Client[proto_gen_go.SimpleIDMessage, proto_gen_go.SuccessMessage]
+	console              *connect.Client[proto_gen_go.SimpleIDMessage, proto_gen_go.SimpleMessage]
+	consoleCommand       *connect.Client[proto_gen_go.IDMessage, proto_gen_go.Empty]
+	listConsoleLogs      *connect.Client[proto_gen_go.SimpleIDMessage, daemon.ListConsoleLogsResponse]
+	searchConsoleLogs    *connect.Client[daemon.SearchConsoleLogsRequest, daemon.ConsoleLogLine]
+	getConsoleLog        *connect.Client[daemon.GetConsoleLogRequest, proto_gen_go.SimpleMessage]
+	terminal             *connect.Client[proto_gen_go.SimpleIDMessage, proto_gen_go.SimpleMessage]
+	terminalCommand      *connect.Client[proto_gen_go.IDMessage, proto_gen_go.Empty]
+	ptyTerminal          *connect.Client[daemon.PtyInput, daemon.PtyOutput]
+	listTerminalSessions *connect.Client[proto_gen_go.SimpleIDMessage, daemon.ListTerminalSessionsResponse]
+	status               *connect.Client[proto_gen_go.SimpleIDMessage, daemon.ServerStatus]
+	watchStatus          *connect.Client[proto_gen_go.SimpleIDMessage, daemon.ServerStatusEvent]
+	resourceUsage        *connect.Client[proto_gen_go.SimpleIDMessage, daemon.ResourceUsageMessage]
+	getResourceHistory   *connect.Client[daemon.GetResourceHistoryRequest, daemon.ResourceHistory]
+	powerAction          *connect.Client[daemon.PowerActionMessage, proto_gen_go.SuccessMessage]
+	install              *connect.Client[daemon.InstallRequest, proto_gen_go.SuccessMessage]
+	installProgress      *connect.Client[proto_gen_go.SimpleIDMessage, daemon.InstallProgressEvent]
+	listInstallRuns      *connect.Client[proto_gen_go.SimpleIDMessage, daemon.ListInstallRunsResponse]
+	getInstallLog        *connect.Client[daemon.GetInstallLogRequest, proto_gen_go.SimpleMessage]
+	getEulaStatus        *connect.Client[proto_gen_go.SimpleIDMessage, daemon.EulaStatus]
+	acceptEula           *connect.Client[proto_gen_go.SimpleIDMessage, proto_gen_go.SuccessMessage]
+	getStorageUsage      *connect.Client[proto_gen_go.SimpleIDMessage, daemon.StorageUsage]
 }
 
 // Console calls daemon.ServerService.Console.
@@ -152,6 +295,21 @@ func (c *serverServiceClient) ConsoleCommand(ctx context.Context, req *connect.R
 	return c.consoleCommand.CallUnary(ctx, req)
 }
 
+// ListConsoleLogs calls daemon.ServerService.ListConsoleLogs.
+func (c *serverServiceClient) ListConsoleLogs(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ListConsoleLogsResponse], error) {
+	return c.listConsoleLogs.CallUnary(ctx, req)
+}
+
+// SearchConsoleLogs calls daemon.ServerService.SearchConsoleLogs.
+func (c *serverServiceClient) SearchConsoleLogs(ctx context.Context, req *connect.Request[daemon.SearchConsoleLogsRequest]) (*connect.ServerStreamForClient[daemon.ConsoleLogLine], error) {
+	return c.searchConsoleLogs.CallServerStream(ctx, req)
+}
+
+// GetConsoleLog calls daemon.ServerService.GetConsoleLog.
+func (c *serverServiceClient) GetConsoleLog(ctx context.Context, req *connect.Request[daemon.GetConsoleLogRequest]) (*connect.ServerStreamForClient[proto_gen_go.SimpleMessage], error) {
+	return c.getConsoleLog.CallServerStream(ctx, req)
+}
+
 // Terminal calls daemon.ServerService.Terminal.
 func (c *serverServiceClient) Terminal(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.ServerStreamForClient[proto_gen_go.SimpleMessage], error) {
 	return c.terminal.CallServerStream(ctx, req)
@@ -162,36 +320,99 @@ func (c *serverServiceClient) TerminalCommand(ctx context.Context, req *connect.
 	return c.terminalCommand.CallUnary(ctx, req)
 }
 
+// PtyTerminal calls daemon.ServerService.PtyTerminal.
+func (c *serverServiceClient) PtyTerminal(ctx context.Context) *connect.BidiStreamForClient[daemon.PtyInput, daemon.PtyOutput] {
+	return c.ptyTerminal.CallBidiStream(ctx)
+}
+
+// ListTerminalSessions calls daemon.ServerService.ListTerminalSessions.
+func (c *serverServiceClient) ListTerminalSessions(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ListTerminalSessionsResponse], error) {
+	return c.listTerminalSessions.CallUnary(ctx, req)
+}
+
 // Status calls daemon.ServerService.Status.
 func (c *serverServiceClient) Status(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ServerStatus], error) {
 	return c.status.CallUnary(ctx, req)
 }
 
+// WatchStatus calls daemon.ServerService.WatchStatus.
+func (c *serverServiceClient) WatchStatus(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.ServerStreamForClient[daemon.ServerStatusEvent], error) {
+	return c.watchStatus.CallServerStream(ctx, req)
+}
+
 // ResourceUsage calls daemon.ServerService.ResourceUsage.
 func (c *serverServiceClient) ResourceUsage(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.ServerStreamForClient[daemon.ResourceUsageMessage], error) {
 	return c.resourceUsage.CallServerStream(ctx, req)
 }
 
+// GetResourceHistory calls daemon.ServerService.GetResourceHistory.
+func (c *serverServiceClient) GetResourceHistory(ctx context.Context, req *connect.Request[daemon.GetResourceHistoryRequest]) (*connect.Response[daemon.ResourceHistory], error) {
+	return c.getResourceHistory.CallUnary(ctx, req)
+}
+
 // PowerAction calls daemon.ServerService.PowerAction.
 func (c *serverServiceClient) PowerAction(ctx context.Context, req *connect.Request[daemon.PowerActionMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
 	return c.powerAction.CallUnary(ctx, req)
 }
 
 // Install calls daemon.ServerService.Install.
-func (c *serverServiceClient) Install(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
+func (c *serverServiceClient) Install(ctx context.Context, req *connect.Request[daemon.InstallRequest]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
 	return c.install.CallUnary(ctx, req)
 }
 
+// InstallProgress calls daemon.ServerService.InstallProgress.
+func (c *serverServiceClient) InstallProgress(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.ServerStreamForClient[daemon.InstallProgressEvent], error) {
+	return c.installProgress.CallServerStream(ctx, req)
+}
+
+// ListInstallRuns calls daemon.ServerService.ListInstallRuns.
+func (c *serverServiceClient) ListInstallRuns(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ListInstallRunsResponse], error) {
+	return c.listInstallRuns.CallUnary(ctx, req)
+}
+
+// GetInstallLog calls daemon.ServerService.GetInstallLog.
+func (c *serverServiceClient) GetInstallLog(ctx context.Context, req *connect.Request[daemon.GetInstallLogRequest]) (*connect.ServerStreamForClient[proto_gen_go.SimpleMessage], error) {
+	return c.getInstallLog.CallServerStream(ctx, req)
+}
+
+// GetEulaStatus calls daemon.ServerService.GetEulaStatus.
+func (c *serverServiceClient) GetEulaStatus(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.EulaStatus], error) {
+	return c.getEulaStatus.CallUnary(ctx, req)
+}
+
+// AcceptEula calls daemon.ServerService.AcceptEula.
+func (c *serverServiceClient) AcceptEula(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
+	return c.acceptEula.CallUnary(ctx, req)
+}
+
+// GetStorageUsage calls daemon.ServerService.GetStorageUsage.
+func (c *serverServiceClient) GetStorageUsage(ctx context.Context, req *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.StorageUsage], error) {
+	return c.getStorageUsage.CallUnary(ctx, req)
+}
+
 // ServerServiceHandler is an implementation of the daemon.ServerService service.
 type ServerServiceHandler interface {
 	Console(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage], *connect.ServerStream[proto_gen_go.SimpleMessage]) error
 	ConsoleCommand(context.Context, *connect.Request[proto_gen_go.IDMessage]) (*connect.Response[proto_gen_go.Empty], error)
+	ListConsoleLogs(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ListConsoleLogsResponse], error)
+	SearchConsoleLogs(context.Context, *connect.Request[daemon.SearchConsoleLogsRequest], *connect.ServerStream[daemon.ConsoleLogLine]) error
+	GetConsoleLog(context.Context, *connect.Request[daemon.GetConsoleLogRequest], *connect.ServerStream[proto_gen_go.SimpleMessage]) error
 	Terminal(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage], *connect.ServerStream[proto_gen_go.SimpleMessage]) error
 	TerminalCommand(context.Context, *connect.Request[proto_gen_go.IDMessage]) (*connect.Response[proto_gen_go.Empty], error)
+	PtyTerminal(context.Context, *connect.BidiStream[daemon.PtyInput, daemon.PtyOutput]) error
+	ListTerminalSessions(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ListTerminalSessionsResponse], error)
 	Status(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ServerStatus], error)
+	WatchStatus(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage], *connect.ServerStream[daemon.ServerStatusEvent]) error
 	ResourceUsage(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage], *connect.ServerStream[daemon.ResourceUsageMessage]) error
+	GetResourceHistory(context.Context, *connect.Request[daemon.GetResourceHistoryRequest]) (*connect.Response[daemon.ResourceHistory], error)
 	PowerAction(context.Context, *connect.Request[daemon.PowerActionMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error)
-	Install(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error)
+	Install(context.Context, *connect.Request[daemon.InstallRequest]) (*connect.Response[proto_gen_go.SuccessMessage], error)
+	InstallProgress(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage], *connect.ServerStream[daemon.InstallProgressEvent]) error
+	ListInstallRuns(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ListInstallRunsResponse], error)
+	GetInstallLog(context.Context, *connect.Request[daemon.GetInstallLogRequest], *connect.ServerStream[proto_gen_go.SimpleMessage]) error
+	GetEulaStatus(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.EulaStatus], error)
+	AcceptEula(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error)
+	GetStorageUsage(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.StorageUsage], error)
 }
 
 // NewServerServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -213,6 +434,24 @@ func NewServerServiceHandler(svc ServerServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(serverServiceMethods.ByName("ConsoleCommand")),
 		connect.WithHandlerOptions(opts...),
 	)
+	serverServiceListConsoleLogsHandler := connect.NewUnaryHandler(
+		ServerServiceListConsoleLogsProcedure,
+		svc.ListConsoleLogs,
+		connect.WithSchema(serverServiceMethods.ByName("ListConsoleLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceSearchConsoleLogsHandler := connect.NewServerStreamHandler(
+		ServerServiceSearchConsoleLogsProcedure,
+		svc.SearchConsoleLogs,
+		connect.WithSchema(serverServiceMethods.ByName("SearchConsoleLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceGetConsoleLogHandler := connect.NewServerStreamHandler(
+		ServerServiceGetConsoleLogProcedure,
+		svc.GetConsoleLog,
+		connect.WithSchema(serverServiceMethods.ByName("GetConsoleLog")),
+		connect.WithHandlerOptions(opts...),
+	)
 	serverServiceTerminalHandler := connect.NewServerStreamHandler(
 		ServerServiceTerminalProcedure,
 		svc.Terminal,
@@ -225,18 +464,42 @@ func NewServerServiceHandler(svc ServerServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(serverServiceMethods.ByName("TerminalCommand")),
 		connect.WithHandlerOptions(opts...),
 	)
+	serverServicePtyTerminalHandler := connect.NewBidiStreamHandler(
+		ServerServicePtyTerminalProcedure,
+		svc.PtyTerminal,
+		connect.WithSchema(serverServiceMethods.ByName("PtyTerminal")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceListTerminalSessionsHandler := connect.NewUnaryHandler(
+		ServerServiceListTerminalSessionsProcedure,
+		svc.ListTerminalSessions,
+		connect.WithSchema(serverServiceMethods.ByName("ListTerminalSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	serverServiceStatusHandler := connect.NewUnaryHandler(
 		ServerServiceStatusProcedure,
 		svc.Status,
 		connect.WithSchema(serverServiceMethods.ByName("Status")),
 		connect.WithHandlerOptions(opts...),
 	)
+	serverServiceWatchStatusHandler := connect.NewServerStreamHandler(
+		ServerServiceWatchStatusProcedure,
+		svc.WatchStatus,
+		connect.WithSchema(serverServiceMethods.ByName("WatchStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
 	serverServiceResourceUsageHandler := connect.NewServerStreamHandler(
 		ServerServiceResourceUsageProcedure,
 		svc.ResourceUsage,
 		connect.WithSchema(serverServiceMethods.ByName("ResourceUsage")),
 		connect.WithHandlerOptions(opts...),
 	)
+	serverServiceGetResourceHistoryHandler := connect.NewUnaryHandler(
+		ServerServiceGetResourceHistoryProcedure,
+		svc.GetResourceHistory,
+		connect.WithSchema(serverServiceMethods.ByName("GetResourceHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
 	serverServicePowerActionHandler := connect.NewUnaryHandler(
 		ServerServicePowerActionProcedure,
 		svc.PowerAction,
@@ -249,24 +512,86 @@ func NewServerServiceHandler(svc ServerServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(serverServiceMethods.ByName("Install")),
 		connect.WithHandlerOptions(opts...),
 	)
+	serverServiceInstallProgressHandler := connect.NewServerStreamHandler(
+		ServerServiceInstallProgressProcedure,
+		svc.InstallProgress,
+		connect.WithSchema(serverServiceMethods.ByName("InstallProgress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceListInstallRunsHandler := connect.NewUnaryHandler(
+		ServerServiceListInstallRunsProcedure,
+		svc.ListInstallRuns,
+		connect.WithSchema(serverServiceMethods.ByName("ListInstallRuns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceGetInstallLogHandler := connect.NewServerStreamHandler(
+		ServerServiceGetInstallLogProcedure,
+		svc.GetInstallLog,
+		connect.WithSchema(serverServiceMethods.ByName("GetInstallLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceGetEulaStatusHandler := connect.NewUnaryHandler(
+		ServerServiceGetEulaStatusProcedure,
+		svc.GetEulaStatus,
+		connect.WithSchema(serverServiceMethods.ByName("GetEulaStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceAcceptEulaHandler := connect.NewUnaryHandler(
+		ServerServiceAcceptEulaProcedure,
+		svc.AcceptEula,
+		connect.WithSchema(serverServiceMethods.ByName("AcceptEula")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceGetStorageUsageHandler := connect.NewUnaryHandler(
+		ServerServiceGetStorageUsageProcedure,
+		svc.GetStorageUsage,
+		connect.WithSchema(serverServiceMethods.ByName("GetStorageUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/daemon.ServerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ServerServiceConsoleProcedure:
 			serverServiceConsoleHandler.ServeHTTP(w, r)
 		case ServerServiceConsoleCommandProcedure:
 			serverServiceConsoleCommandHandler.ServeHTTP(w, r)
+		case ServerServiceListConsoleLogsProcedure:
+			serverServiceListConsoleLogsHandler.ServeHTTP(w, r)
+		case ServerServiceSearchConsoleLogsProcedure:
+			serverServiceSearchConsoleLogsHandler.ServeHTTP(w, r)
+		case ServerServiceGetConsoleLogProcedure:
+			serverServiceGetConsoleLogHandler.ServeHTTP(w, r)
 		case ServerServiceTerminalProcedure:
 			serverServiceTerminalHandler.ServeHTTP(w, r)
 		case ServerServiceTerminalCommandProcedure:
 			serverServiceTerminalCommandHandler.ServeHTTP(w, r)
+		case ServerServicePtyTerminalProcedure:
+			serverServicePtyTerminalHandler.ServeHTTP(w, r)
+		case ServerServiceListTerminalSessionsProcedure:
+			serverServiceListTerminalSessionsHandler.ServeHTTP(w, r)
 		case ServerServiceStatusProcedure:
 			serverServiceStatusHandler.ServeHTTP(w, r)
+		case ServerServiceWatchStatusProcedure:
+			serverServiceWatchStatusHandler.ServeHTTP(w, r)
 		case ServerServiceResourceUsageProcedure:
 			serverServiceResourceUsageHandler.ServeHTTP(w, r)
+		case ServerServiceGetResourceHistoryProcedure:
+			serverServiceGetResourceHistoryHandler.ServeHTTP(w, r)
 		case ServerServicePowerActionProcedure:
 			serverServicePowerActionHandler.ServeHTTP(w, r)
 		case ServerServiceInstallProcedure:
 			serverServiceInstallHandler.ServeHTTP(w, r)
+		case ServerServiceInstallProgressProcedure:
+			serverServiceInstallProgressHandler.ServeHTTP(w, r)
+		case ServerServiceListInstallRunsProcedure:
+			serverServiceListInstallRunsHandler.ServeHTTP(w, r)
+		case ServerServiceGetInstallLogProcedure:
+			serverServiceGetInstallLogHandler.ServeHTTP(w, r)
+		case ServerServiceGetEulaStatusProcedure:
+			serverServiceGetEulaStatusHandler.ServeHTTP(w, r)
+		case ServerServiceAcceptEulaProcedure:
+			serverServiceAcceptEulaHandler.ServeHTTP(w, r)
+		case ServerServiceGetStorageUsageProcedure:
+			serverServiceGetStorageUsageHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -284,6 +609,18 @@ func (UnimplementedServerServiceHandler) ConsoleCommand(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.ConsoleCommand is not implemented"))
 }
 
+func (UnimplementedServerServiceHandler) ListConsoleLogs(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ListConsoleLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.ListConsoleLogs is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) SearchConsoleLogs(context.Context, *connect.Request[daemon.SearchConsoleLogsRequest], *connect.ServerStream[daemon.ConsoleLogLine]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.SearchConsoleLogs is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) GetConsoleLog(context.Context, *connect.Request[daemon.GetConsoleLogRequest], *connect.ServerStream[proto_gen_go.SimpleMessage]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.GetConsoleLog is not implemented"))
+}
+
 func (UnimplementedServerServiceHandler) Terminal(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage], *connect.ServerStream[proto_gen_go.SimpleMessage]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.Terminal is not implemented"))
 }
@@ -292,18 +629,58 @@ func (UnimplementedServerServiceHandler) TerminalCommand(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.TerminalCommand is not implemented"))
 }
 
+func (UnimplementedServerServiceHandler) PtyTerminal(context.Context, *connect.BidiStream[daemon.PtyInput, daemon.PtyOutput]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.PtyTerminal is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) ListTerminalSessions(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ListTerminalSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.ListTerminalSessions is not implemented"))
+}
+
 func (UnimplementedServerServiceHandler) Status(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ServerStatus], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.Status is not implemented"))
 }
 
+func (UnimplementedServerServiceHandler) WatchStatus(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage], *connect.ServerStream[daemon.ServerStatusEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.WatchStatus is not implemented"))
+}
+
 func (UnimplementedServerServiceHandler) ResourceUsage(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage], *connect.ServerStream[daemon.ResourceUsageMessage]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.ResourceUsage is not implemented"))
 }
 
+func (UnimplementedServerServiceHandler) GetResourceHistory(context.Context, *connect.Request[daemon.GetResourceHistoryRequest]) (*connect.Response[daemon.ResourceHistory], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.GetResourceHistory is not implemented"))
+}
+
 func (UnimplementedServerServiceHandler) PowerAction(context.Context, *connect.Request[daemon.PowerActionMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.PowerAction is not implemented"))
 }
 
-func (UnimplementedServerServiceHandler) Install(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
+func (UnimplementedServerServiceHandler) Install(context.Context, *connect.Request[daemon.InstallRequest]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.Install is not implemented"))
 }
+
+func (UnimplementedServerServiceHandler) InstallProgress(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage], *connect.ServerStream[daemon.InstallProgressEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.InstallProgress is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) ListInstallRuns(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.ListInstallRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.ListInstallRuns is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) GetInstallLog(context.Context, *connect.Request[daemon.GetInstallLogRequest], *connect.ServerStream[proto_gen_go.SimpleMessage]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.GetInstallLog is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) GetEulaStatus(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.EulaStatus], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.GetEulaStatus is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) AcceptEula(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[proto_gen_go.SuccessMessage], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.AcceptEula is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) GetStorageUsage(context.Context, *connect.Request[proto_gen_go.SimpleIDMessage]) (*connect.Response[daemon.StorageUsage], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerService.GetStorageUsage is not implemented"))
+}
@@ -42,6 +42,9 @@ const (
 	// ServerFilesServiceGetDirectorySizeProcedure is the fully-qualified name of the
 	// ServerFilesService's GetDirectorySize RPC.
 	ServerFilesServiceGetDirectorySizeProcedure = "/daemon.ServerFilesService/GetDirectorySize"
+	// ServerFilesServiceGetDiskUsageProcedure is the fully-qualified name of the ServerFilesService's
+	// GetDiskUsage RPC.
+	ServerFilesServiceGetDiskUsageProcedure = "/daemon.ServerFilesService/GetDiskUsage"
 	// ServerFilesServiceReadFileProcedure is the fully-qualified name of the ServerFilesService's
 	// ReadFile RPC.
 	ServerFilesServiceReadFileProcedure = "/daemon.ServerFilesService/ReadFile"
@@ -80,6 +83,7 @@ type ServerFilesServiceClient interface {
 	ListDirectory(context.Context, *connect.Request[daemon.ListDirectoryRequest]) (*connect.Response[daemon.ListDirectoryResponse], error)
 	CreateDirectory(context.Context, *connect.Request[daemon.CreateDirectoryRequest]) (*connect.Response[daemon.CreateDirectoryResponse], error)
 	GetDirectorySize(context.Context, *connect.Request[daemon.GetDirectorySizeRequest]) (*connect.Response[daemon.GetDirectorySizeResponse], error)
+	GetDiskUsage(context.Context, *connect.Request[daemon.GetDiskUsageRequest]) (*connect.Response[daemon.GetDiskUsageResponse], error)
 	// File operations
 	ReadFile(context.Context, *connect.Request[daemon.ReadFileRequest]) (*connect.Response[daemon.ReadFileResponse], error)
 	WriteFile(context.Context, *connect.Request[daemon.WriteFileRequest]) (*connect.Response[daemon.WriteFileResponse], error)
@@ -126,6 +130,12 @@ func NewServerFilesServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(serverFilesServiceMethods.ByName("GetDirectorySize")),
 			connect.WithClientOptions(opts...),
 		),
+		getDiskUsage: connect.NewClient[daemon.GetDiskUsageRequest, daemon.GetDiskUsageResponse](
+			httpClient,
+			baseURL+ServerFilesServiceGetDiskUsageProcedure,
+			connect.WithSchema(serverFilesServiceMethods.ByName("GetDiskUsage")),
+			connect.WithClientOptions(opts...),
+		),
 		readFile: connect.NewClient[daemon.ReadFileRequest, daemon.ReadFileResponse](
 			httpClient,
 			baseURL+ServerFilesServiceReadFileProcedure,
@@ -194,6 +204,7 @@ type serverFilesServiceClient struct {
 	listDirectory         *connect.Client[daemon.ListDirectoryRequest, daemon.ListDirectoryResponse]
 	createDirectory       *connect.Client[daemon.CreateDirectoryRequest, daemon.CreateDirectoryResponse]
 	getDirectorySize      *connect.Client[daemon.GetDirectorySizeRequest, daemon.GetDirectorySizeResponse]
+	getDiskUsage          *connect.Client[daemon.GetDiskUsageRequest, daemon.GetDiskUsageResponse]
 	readFile              *connect.Client[daemon.ReadFileRequest, daemon.ReadFileResponse]
 	writeFile             *connect.Client[daemon.WriteFileRequest, daemon.WriteFileResponse]
 	deleteFile            *connect.Client[daemon.DeleteFileRequest, daemon.DeleteFileResponse]
@@ -221,6 +232,11 @@ func (c *serverFilesServiceClient) GetDirectorySize(ctx context.Context, req *co
 	return c.getDirectorySize.CallUnary(ctx, req)
 }
 
+// GetDiskUsage calls daemon.ServerFilesService.GetDiskUsage.
+func (c *serverFilesServiceClient) GetDiskUsage(ctx context.Context, req *connect.Request[daemon.GetDiskUsageRequest]) (*connect.Response[daemon.GetDiskUsageResponse], error) {
+	return c.getDiskUsage.CallUnary(ctx, req)
+}
+
 // ReadFile calls daemon.ServerFilesService.ReadFile.
 func (c *serverFilesServiceClient) ReadFile(ctx context.Context, req *connect.Request[daemon.ReadFileRequest]) (*connect.Response[daemon.ReadFileResponse], error) {
 	return c.readFile.CallUnary(ctx, req)
@@ -277,6 +293,7 @@ type ServerFilesServiceHandler interface {
 	ListDirectory(context.Context, *connect.Request[daemon.ListDirectoryRequest]) (*connect.Response[daemon.ListDirectoryResponse], error)
 	CreateDirectory(context.Context, *connect.Request[daemon.CreateDirectoryRequest]) (*connect.Response[daemon.CreateDirectoryResponse], error)
 	GetDirectorySize(context.Context, *connect.Request[daemon.GetDirectorySizeRequest]) (*connect.Response[daemon.GetDirectorySizeResponse], error)
+	GetDiskUsage(context.Context, *connect.Request[daemon.GetDiskUsageRequest]) (*connect.Response[daemon.GetDiskUsageResponse], error)
 	// File operations
 	ReadFile(context.Context, *connect.Request[daemon.ReadFileRequest]) (*connect.Response[daemon.ReadFileResponse], error)
 	WriteFile(context.Context, *connect.Request[daemon.WriteFileRequest]) (*connect.Response[daemon.WriteFileResponse], error)
@@ -319,6 +336,12 @@ func NewServerFilesServiceHandler(svc ServerFilesServiceHandler, opts ...connect
 		connect.WithSchema(serverFilesServiceMethods.ByName("GetDirectorySize")),
 		connect.WithHandlerOptions(opts...),
 	)
+	serverFilesServiceGetDiskUsageHandler := connect.NewUnaryHandler(
+		ServerFilesServiceGetDiskUsageProcedure,
+		svc.GetDiskUsage,
+		connect.WithSchema(serverFilesServiceMethods.ByName("GetDiskUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	serverFilesServiceReadFileHandler := connect.NewUnaryHandler(
 		ServerFilesServiceReadFileProcedure,
 		svc.ReadFile,
@@ -387,6 +410,8 @@ func NewServerFilesServiceHandler(svc ServerFilesServiceHandler, opts ...connect
 			serverFilesServiceCreateDirectoryHandler.ServeHTTP(w, r)
 		case ServerFilesServiceGetDirectorySizeProcedure:
 			serverFilesServiceGetDirectorySizeHandler.ServeHTTP(w, r)
+		case ServerFilesServiceGetDiskUsageProcedure:
+			serverFilesServiceGetDiskUsageHandler.ServeHTTP(w, r)
 		case ServerFilesServiceReadFileProcedure:
 			serverFilesServiceReadFileHandler.ServeHTTP(w, r)
 		case ServerFilesServiceWriteFileProcedure:
@@ -428,6 +453,10 @@ func (UnimplementedServerFilesServiceHandler) GetDirectorySize(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerFilesService.GetDirectorySize is not implemented"))
 }
 
+func (UnimplementedServerFilesServiceHandler) GetDiskUsage(context.Context, *connect.Request[daemon.GetDiskUsageRequest]) (*connect.Response[daemon.GetDiskUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerFilesService.GetDiskUsage is not implemented"))
+}
+
 func (UnimplementedServerFilesServiceHandler) ReadFile(context.Context, *connect.Request[daemon.ReadFileRequest]) (*connect.Response[daemon.ReadFileResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("daemon.ServerFilesService.ReadFile is not implemented"))
 }
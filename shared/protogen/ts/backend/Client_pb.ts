@@ -4,7 +4,7 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { EmptySchema, IPAllocation, ResourceLimit, SimpleIDMessageSchema } from "../common_pb";
+import type { BlueprintVariable, EmptySchema, IPAllocation, ResourceLimit, ServerVariable, SimpleIDMessageSchema, SuccessMessageSchema } from "../common_pb";
 import { file_common } from "../common_pb";
 import { file_daemon_Server } from "../daemon/Server_pb";
 import type { Message } from "@bufbuild/protobuf";
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file backend/Client.proto.
  */
 export const file_backend_Client: GenFile = /*@__PURE__*/
-  fileDesc("ChRiYWNrZW5kL0NsaWVudC5wcm90bxIHYmFja2VuZCIvChJBdmFpbGFibGVCbHVlcHJpbnQSCwoDYmlkGAEgASgJEgwKBG5hbWUYAiABKAkiRgoTQXZhaWxhYmxlQmx1ZXByaW50cxIvCgpibHVlcHJpbnRzGAEgAygLMhsuYmFja2VuZC5BdmFpbGFibGVCbHVlcHJpbnQiLgoRQXZhaWxhYmxlTG9jYXRpb24SCwoDbGlkGAEgASgJEgwKBG5hbWUYAiABKAkiQwoSQXZhaWxhYmxlTG9jYXRpb25zEi0KCWxvY2F0aW9ucxgBIAMoCzIaLmJhY2tlbmQuQXZhaWxhYmxlTG9jYXRpb24iNwoNQXZhaWxhYmxlTm9kZRILCgNuaWQYASABKAkSCwoDbGlkGAIgASgJEgwKBG5hbWUYAyABKAkiNwoOQXZhaWxhYmxlTm9kZXMSJQoFbm9kZXMYASADKAsyFi5iYWNrZW5kLkF2YWlsYWJsZU5vZGUidgoQTmV3U2VydmVyUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEgsKA2JpZBgDIAEoCRIQCgNsaWQYBCABKAlIAIgBARIQCgNuaWQYBSABKAlIAYgBAUIGCgRfbGlkQgYKBF9uaWQiIAoRTmV3U2VydmVyUmVzcG9uc2USCwoDc2lkGAEgASgJIkkKCkNsaWVudEluZm8SCwoDdWlkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEg0KBWVtYWlsGAMgASgJEg0KBWFkbWluGAQgASgIIjIKClNlcnZlckxpc3QSJAoHc2VydmVycxgBIAMoCzITLmJhY2tlbmQuU2VydmVySW5mbyKDAgoKU2VydmVySW5mbxILCgNzaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIQCghzb2Z0d2FyZRgEIAEoCRIVCg1zb2Z0d2FyZV9pY29uGAUgASgJEjIKD21haW5fYWxsb2NhdGlvbhgGIAEoCzIULmNvbW1vbi5JUEFsbG9jYXRpb25IAIgBARITCgtkYWVtb25faG9zdBgHIAEoCRItCg5yZXNvdXJjZV9saW1pdBgIIAEoCzIVLmNvbW1vbi5SZXNvdXJjZUxpbWl0EhAKCGxvY2F0aW9uGAkgASgJQhIKEF9tYWluX2FsbG9jYXRpb24yuwMKDUNsaWVudFNlcnZpY2USLQoHR2V0SW5mbxINLmNvbW1vbi5FbXB0eRoTLmJhY2tlbmQuQ2xpZW50SW5mbxIzCg1HZXRTZXJ2ZXJMaXN0Eg0uY29tbW9uLkVtcHR5GhMuYmFja2VuZC5TZXJ2ZXJMaXN0EjkKCUdldFNlcnZlchIXLmNvbW1vbi5TaW1wbGVJRE1lc3NhZ2UaEy5iYWNrZW5kLlNlcnZlckluZm8SRQoWR2V0QXZhaWxhYmxlQmx1ZXByaW50cxINLmNvbW1vbi5FbXB0eRocLmJhY2tlbmQuQXZhaWxhYmxlQmx1ZXByaW50cxJDChVHZXRBdmFpbGFibGVMb2NhdGlvbnMSDS5jb21tb24uRW1wdHkaGy5iYWNrZW5kLkF2YWlsYWJsZUxvY2F0aW9ucxI7ChFHZXRBdmFpbGFibGVOb2RlcxINLmNvbW1vbi5FbXB0eRoXLmJhY2tlbmQuQXZhaWxhYmxlTm9kZXMSQgoJTmV3U2VydmVyEhkuYmFja2VuZC5OZXdTZXJ2ZXJSZXF1ZXN0GhouYmFja2VuZC5OZXdTZXJ2ZXJSZXNwb25zZUIfWh1wYW5lbGl1bS9wcm90b19nZW5fZ28vYmFja2VuZGIGcHJvdG8z", [file_common, file_daemon_Server]);
+  fileDesc("ChRiYWNrZW5kL0NsaWVudC5wcm90bxIHYmFja2VuZCIvChJBdmFpbGFibGVCbHVlcHJpbnQSCwoDYmlkGAEgASgJEgwKBG5hbWUYAiABKAkiRgoTQXZhaWxhYmxlQmx1ZXByaW50cxIvCgpibHVlcHJpbnRzGAEgAygLMhsuYmFja2VuZC5BdmFpbGFibGVCbHVlcHJpbnQiLgoRQXZhaWxhYmxlTG9jYXRpb24SCwoDbGlkGAEgASgJEgwKBG5hbWUYAiABKAkiQwoSQXZhaWxhYmxlTG9jYXRpb25zEi0KCWxvY2F0aW9ucxgBIAMoCzIaLmJhY2tlbmQuQXZhaWxhYmxlTG9jYXRpb24iNwoNQXZhaWxhYmxlTm9kZRILCgNuaWQYASABKAkSCwoDbGlkGAIgASgJEgwKBG5hbWUYAyABKAkiNwoOQXZhaWxhYmxlTm9kZXMSJQoFbm9kZXMYASADKAsyFi5iYWNrZW5kLkF2YWlsYWJsZU5vZGUioQEKEE5ld1NlcnZlclJlcXVlc3QSDAoEbmFtZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRILCgNiaWQYAyABKAkSEAoDbGlkGAQgASgJSACIAQESEAoDbmlkGAUgASgJSAGIAQESKQoJdmFyaWFibGVzGAYgAygLMhYuY29tbW9uLlNlcnZlclZhcmlhYmxlQgYKBF9saWRCBgoEX25pZCIgChFOZXdTZXJ2ZXJSZXNwb25zZRILCgNzaWQYASABKAkiSQoKQ2xpZW50SW5mbxILCgN1aWQYASABKAkSEAoIdXNlcm5hbWUYAiABKAkSDQoFZW1haWwYAyABKAkSDQoFYWRtaW4YBCABKAgiMgoKU2VydmVyTGlzdBIkCgdzZXJ2ZXJzGAEgAygLMhMuYmFja2VuZC5TZXJ2ZXJJbmZvIoMCCgpTZXJ2ZXJJbmZvEgsKA3NpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhAKCHNvZnR3YXJlGAQgASgJEhUKDXNvZnR3YXJlX2ljb24YBSABKAkSMgoPbWFpbl9hbGxvY2F0aW9uGAYgASgLMhQuY29tbW9uLklQQWxsb2NhdGlvbkgAiAEBEhMKC2RhZW1vbl9ob3N0GAcgASgJEi0KDnJlc291cmNlX2xpbWl0GAggASgLMhUuY29tbW9uLlJlc291cmNlTGltaXQSEAoIbG9jYXRpb24YCSABKAlCEgoQX21haW5fYWxsb2NhdGlvbiJQChJTZXJ2ZXJWYXJpYWJsZUluZm8SKwoIdmFyaWFibGUYASABKAsyGS5jb21tb24uQmx1ZXByaW50VmFyaWFibGUSDQoFdmFsdWUYAiABKAkiQQoPU2VydmVyVmFyaWFibGVzEi4KCXZhcmlhYmxlcxgBIAMoCzIbLmJhY2tlbmQuU2VydmVyVmFyaWFibGVJbmZvIlYKHFVwZGF0ZVNlcnZlclZhcmlhYmxlc1JlcXVlc3QSCwoDc2lkGAEgASgJEikKCXZhcmlhYmxlcxgCIAMoCzIWLmNvbW1vbi5TZXJ2ZXJWYXJpYWJsZTLcBAoNQ2xpZW50U2VydmljZRItCgdHZXRJbmZvEg0uY29tbW9uLkVtcHR5GhMuYmFja2VuZC5DbGllbnRJbmZvEjMKDUdldFNlcnZlckxpc3QSDS5jb21tb24uRW1wdHkaEy5iYWNrZW5kLlNlcnZlckxpc3QSOQoJR2V0U2VydmVyEhcuY29tbW9uLlNpbXBsZUlETWVzc2FnZRoTLmJhY2tlbmQuU2VydmVySW5mbxJFChZHZXRBdmFpbGFibGVCbHVlcHJpbnRzEg0uY29tbW9uLkVtcHR5GhwuYmFja2VuZC5BdmFpbGFibGVCbHVlcHJpbnRzEkMKFUdldEF2YWlsYWJsZUxvY2F0aW9ucxINLmNvbW1vbi5FbXB0eRobLmJhY2tlbmQuQXZhaWxhYmxlTG9jYXRpb25zEjsKEUdldEF2YWlsYWJsZU5vZGVzEg0uY29tbW9uLkVtcHR5GhcuYmFja2VuZC5BdmFpbGFibGVOb2RlcxJCCglOZXdTZXJ2ZXISGS5iYWNrZW5kLk5ld1NlcnZlclJlcXVlc3QaGi5iYWNrZW5kLk5ld1NlcnZlclJlc3BvbnNlEkcKEkdldFNlcnZlclZhcmlhYmxlcxIXLmNvbW1vbi5TaW1wbGVJRE1lc3NhZ2UaGC5iYWNrZW5kLlNlcnZlclZhcmlhYmxlcxJWChVVcGRhdGVTZXJ2ZXJWYXJpYWJsZXMSJS5iYWNrZW5kLlVwZGF0ZVNlcnZlclZhcmlhYmxlc1JlcXVlc3QaFi5jb21tb24uU3VjY2Vzc01lc3NhZ2VCH1odcGFuZWxpdW0vcHJvdG9fZ2VuX2dvL2JhY2tlbmRiBnByb3RvMw", [file_common, file_daemon_Server]);
 
 /**
  * @generated from message backend.AvailableBlueprint
@@ -165,6 +165,13 @@ export type NewServerRequest = Message<"backend.NewServerRequest"> & {
    * @generated from field: optional string nid = 5;
    */
   nid?: string;
+
+  /**
+   * only user editable variables, the others use their default
+   *
+   * @generated from field: repeated common.ServerVariable variables = 6;
+   */
+  variables: ServerVariable[];
 };
 
 /**
@@ -297,6 +304,71 @@ export type ServerInfo = Message<"backend.ServerInfo"> & {
 export const ServerInfoSchema: GenMessage<ServerInfo> = /*@__PURE__*/
   messageDesc(file_backend_Client, 10);
 
+/**
+ * @generated from message backend.ServerVariableInfo
+ */
+export type ServerVariableInfo = Message<"backend.ServerVariableInfo"> & {
+  /**
+   * @generated from field: common.BlueprintVariable variable = 1;
+   */
+  variable?: BlueprintVariable;
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message backend.ServerVariableInfo.
+ * Use `create(ServerVariableInfoSchema)` to create a new message.
+ */
+export const ServerVariableInfoSchema: GenMessage<ServerVariableInfo> = /*@__PURE__*/
+  messageDesc(file_backend_Client, 11);
+
+/**
+ * @generated from message backend.ServerVariables
+ */
+export type ServerVariables = Message<"backend.ServerVariables"> & {
+  /**
+   * only the user editable variables of the server's blueprint
+   *
+   * @generated from field: repeated backend.ServerVariableInfo variables = 1;
+   */
+  variables: ServerVariableInfo[];
+};
+
+/**
+ * Describes the message backend.ServerVariables.
+ * Use `create(ServerVariablesSchema)` to create a new message.
+ */
+export const ServerVariablesSchema: GenMessage<ServerVariables> = /*@__PURE__*/
+  messageDesc(file_backend_Client, 12);
+
+/**
+ * @generated from message backend.UpdateServerVariablesRequest
+ */
+export type UpdateServerVariablesRequest = Message<"backend.UpdateServerVariablesRequest"> & {
+  /**
+   * @generated from field: string sid = 1;
+   */
+  sid: string;
+
+  /**
+   * variables not given are left as they are
+   *
+   * @generated from field: repeated common.ServerVariable variables = 2;
+   */
+  variables: ServerVariable[];
+};
+
+/**
+ * Describes the message backend.UpdateServerVariablesRequest.
+ * Use `create(UpdateServerVariablesRequestSchema)` to create a new message.
+ */
+export const UpdateServerVariablesRequestSchema: GenMessage<UpdateServerVariablesRequest> = /*@__PURE__*/
+  messageDesc(file_backend_Client, 13);
+
 /**
  * @generated from service backend.ClientService
  */
@@ -357,6 +429,22 @@ export const ClientService: GenService<{
     input: typeof NewServerRequestSchema;
     output: typeof NewServerResponseSchema;
   },
+  /**
+   * @generated from rpc backend.ClientService.GetServerVariables
+   */
+  getServerVariables: {
+    methodKind: "unary";
+    input: typeof SimpleIDMessageSchema;
+    output: typeof ServerVariablesSchema;
+  },
+  /**
+   * @generated from rpc backend.ClientService.UpdateServerVariables
+   */
+  updateServerVariables: {
+    methodKind: "unary";
+    input: typeof UpdateServerVariablesRequestSchema;
+    output: typeof SuccessMessageSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_backend_Client, 0);
 
@@ -4,7 +4,7 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { EmptySchema, IPAllocation, ResourceLimit, SimpleIDMessageSchema, SuccessMessageSchema } from "../common_pb";
+import type { BlueprintPort, BlueprintVariable, EmptySchema, IPAllocation, ResourceLimit, RestartPolicy, ServerVariable, SimpleIDMessageSchema, SuccessMessageSchema } from "../common_pb";
 import { file_common } from "../common_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file backend/Daemon.proto.
  */
 export const file_backend_Daemon: GenFile = /*@__PURE__*/
-  fileDesc("ChRiYWNrZW5kL0RhZW1vbi5wcm90bxIHYmFja2VuZCIrChVSZWdpc3RlckRhZW1vblJlcXVlc3QSEgoKbm9kZV90b2tlbhgBIAEoCSKbAgoJQmx1ZXByaW50EgsKA2JpZBgBIAEoCRIPCgd2ZXJzaW9uGAIgASgNEg0KBWZsYWdzGAMgAygJEhUKDWRvY2tlcl9pbWFnZXMYBCADKAkSKwoNYmxvY2tlZF9maWxlcxgFIAMoCzIULmJhY2tlbmQuQmxvY2tlZEZpbGUSFQoNc2VydmVyX2JpbmFyeRgGIAEoCRIVCg1zdGFydF9jb21tYW5kGAcgASgJEhQKDHN0b3BfY29tbWFuZBgIIAEoCRIbChNzZXR1cF9zY3JpcHRfYmFzZTY0GAkgASgJEhoKEnNldHVwX2RvY2tlcl9pbWFnZRgKIAEoCRIgChhzZXR1cF9zY3JpcHRfaW50ZXJwcmV0ZXIYCyABKAkiPgoLQmxvY2tlZEZpbGUSDAoEZmlsZRgBIAEoCRIPCgd2aXNpYmxlGAIgASgIEhAKCHJlYWRhYmxlGAMgASgIIrYBCgZTZXJ2ZXISCwoDc2lkGAEgASgJEhAKCG93bmVyX2lkGAIgASgJEhAKCHVzZXJfaWRzGAMgAygJEikKC2FsbG9jYXRpb25zGAQgAygLMhQuY29tbW9uLklQQWxsb2NhdGlvbhItCg5yZXNvdXJjZV9saW1pdBgFIAEoCzIVLmNvbW1vbi5SZXNvdXJjZUxpbWl0EhQKDGRvY2tlcl9pbWFnZRgGIAEoCRILCgNiaWQYByABKAkytQIKDURhZW1vblNlcnZpY2USSAoOUmVnaXN0ZXJEYWVtb24SHi5iYWNrZW5kLlJlZ2lzdGVyRGFlbW9uUmVxdWVzdBoWLmNvbW1vbi5TdWNjZXNzTWVzc2FnZRI1Cg5TeW5jQmx1ZXByaW50cxINLmNvbW1vbi5FbXB0eRoSLmJhY2tlbmQuQmx1ZXByaW50MAESOwoMR2V0Qmx1ZXByaW50EhcuY29tbW9uLlNpbXBsZUlETWVzc2FnZRoSLmJhY2tlbmQuQmx1ZXByaW50Ei8KC1N5bmNTZXJ2ZXJzEg0uY29tbW9uLkVtcHR5Gg8uYmFja2VuZC5TZXJ2ZXIwARI1CglHZXRTZXJ2ZXISFy5jb21tb24uU2ltcGxlSURNZXNzYWdlGg8uYmFja2VuZC5TZXJ2ZXJCH1odcGFuZWxpdW0vcHJvdG9fZ2VuX2dvL2JhY2tlbmRiBnByb3RvMw", [file_common]);
+  fileDesc("ChRiYWNrZW5kL0RhZW1vbi5wcm90bxIHYmFja2VuZCIrChVSZWdpc3RlckRhZW1vblJlcXVlc3QSEgoKbm9kZV90b2tlbhgBIAEoCSLdAwoJQmx1ZXByaW50EgsKA2JpZBgBIAEoCRIPCgd2ZXJzaW9uGAIgASgNEg0KBWZsYWdzGAMgAygJEhUKDWRvY2tlcl9pbWFnZXMYBCADKAkSKwoNYmxvY2tlZF9maWxlcxgFIAMoCzIULmJhY2tlbmQuQmxvY2tlZEZpbGUSFQoNc2VydmVyX2JpbmFyeRgGIAEoCRIVCg1zdGFydF9jb21tYW5kGAcgASgJEhQKDHN0b3BfY29tbWFuZBgIIAEoCRIbChNzZXR1cF9zY3JpcHRfYmFzZTY0GAkgASgJEhoKEnNldHVwX2RvY2tlcl9pbWFnZRgKIAEoCRIgChhzZXR1cF9zY3JpcHRfaW50ZXJwcmV0ZXIYCyABKAkSHAoUc3RhcnR1cF9kb25lX3BhdHRlcm4YDCABKAkSJAoFcG9ydHMYDSADKAsyFS5jb21tb24uQmx1ZXByaW50UG9ydBIsCgl2YXJpYWJsZXMYDiADKAsyGS5jb21tb24uQmx1ZXByaW50VmFyaWFibGUSGAoQZW50cnlwb2ludF9zaGVsbBgPIAEoCRIWCg50ZXJtaW5hbF9zaGVsbBgQIAEoCRIcChRzZWN1cml0eV9yZWxheGF0aW9ucxgRIAMoCSI+CgtCbG9ja2VkRmlsZRIMCgRmaWxlGAEgASgJEg8KB3Zpc2libGUYAiABKAgSEAoIcmVhZGFibGUYAyABKAgikAIKBlNlcnZlchILCgNzaWQYASABKAkSEAoIb3duZXJfaWQYAiABKAkSEAoIdXNlcl9pZHMYAyADKAkSKQoLYWxsb2NhdGlvbnMYBCADKAsyFC5jb21tb24uSVBBbGxvY2F0aW9uEi0KDnJlc291cmNlX2xpbWl0GAUgASgLMhUuY29tbW9uLlJlc291cmNlTGltaXQSFAoMZG9ja2VyX2ltYWdlGAYgASgJEgsKA2JpZBgHIAEoCRItCg5yZXN0YXJ0X3BvbGljeRgIIAEoDjIVLmNvbW1vbi5SZXN0YXJ0UG9saWN5EikKCXZhcmlhYmxlcxgJIAMoCzIWLmNvbW1vbi5TZXJ2ZXJWYXJpYWJsZTK1AgoNRGFlbW9uU2VydmljZRJICg5SZWdpc3RlckRhZW1vbhIeLmJhY2tlbmQuUmVnaXN0ZXJEYWVtb25SZXF1ZXN0GhYuY29tbW9uLlN1Y2Nlc3NNZXNzYWdlEjUKDlN5bmNCbHVlcHJpbnRzEg0uY29tbW9uLkVtcHR5GhIuYmFja2VuZC5CbHVlcHJpbnQwARI7CgxHZXRCbHVlcHJpbnQSFy5jb21tb24uU2ltcGxlSURNZXNzYWdlGhIuYmFja2VuZC5CbHVlcHJpbnQSLwoLU3luY1NlcnZlcnMSDS5jb21tb24uRW1wdHkaDy5iYWNrZW5kLlNlcnZlcjABEjUKCUdldFNlcnZlchIXLmNvbW1vbi5TaW1wbGVJRE1lc3NhZ2UaDy5iYWNrZW5kLlNlcnZlckIfWh1wYW5lbGl1bS9wcm90b19nZW5fZ28vYmFja2VuZGIGcHJvdG8z", [file_common]);
 
 /**
  * @generated from message backend.RegisterDaemonRequest
@@ -91,6 +91,46 @@ export type Blueprint = Message<"backend.Blueprint"> & {
    * @generated from field: string setup_script_interpreter = 11;
    */
   setupScriptInterpreter: string;
+
+  /**
+   * regex matched against console output, server is considered online after the first match
+   *
+   * @generated from field: string startup_done_pattern = 12;
+   */
+  startupDonePattern: string;
+
+  /**
+   * the server's allocations are bound to these in order
+   *
+   * @generated from field: repeated common.BlueprintPort ports = 13;
+   */
+  ports: BlueprintPort[];
+
+  /**
+   * @generated from field: repeated common.BlueprintVariable variables = 14;
+   */
+  variables: BlueprintVariable[];
+
+  /**
+   * e.g. /bin/sh, runs the start command with "<shell> -c", empty runs it directly
+   *
+   * @generated from field: string entrypoint_shell = 15;
+   */
+  entrypointShell: string;
+
+  /**
+   * shell of terminal sessions, e.g. /bin/bash, empty uses the first of bash, sh and ash found in the container
+   *
+   * @generated from field: string terminal_shell = 16;
+   */
+  terminalShell: string;
+
+  /**
+   * loosening of the node security profile requested by the blueprint and approved by an admin
+   *
+   * @generated from field: repeated string security_relaxations = 17;
+   */
+  securityRelaxations: string[];
 };
 
 /**
@@ -165,6 +205,18 @@ export type Server = Message<"backend.Server"> & {
    * @generated from field: string bid = 7;
    */
   bid: string;
+
+  /**
+   * @generated from field: common.RestartPolicy restart_policy = 8;
+   */
+  restartPolicy: RestartPolicy;
+
+  /**
+   * values of the blueprint's variables, missing ones use the default
+   *
+   * @generated from field: repeated common.ServerVariable variables = 9;
+   */
+  variables: ServerVariable[];
 };
 
 /**
@@ -4,7 +4,7 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { Pagination } from "../../common_pb";
+import type { BlueprintPort, BlueprintVariable, Pagination } from "../../common_pb";
 import { file_common } from "../../common_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file backend/admin/BlueprintManager.proto.
  */
 export const file_backend_admin_BlueprintManager: GenFile = /*@__PURE__*/
-  fileDesc("CiRiYWNrZW5kL2FkbWluL0JsdWVwcmludE1hbmFnZXIucHJvdG8SDWJhY2tlbmRfYWRtaW4iKgoLRG9ja2VySW1hZ2USDAoEbmFtZRgBIAEoCRINCgVpbWFnZRgCIAEoCSI+CgtCbG9ja2VkRmlsZRIMCgRmaWxlGAEgASgJEg8KB3Zpc2libGUYAiABKAgSEAoIcmVhZGFibGUYAyABKAgivAMKCUJsdWVwcmludBIWCg5mb3JtYXRfdmVyc2lvbhgBIAEoDRILCgNiaWQYAiABKAkSDwoHdmVyc2lvbhgDIAEoDRISCgp1cGRhdGVfdXJsGAQgASgJEgwKBG5hbWUYBSABKAkSEwoLZGVzY3JpcHRpb24YBiABKAkSEAoIY2F0ZWdvcnkYByABKAkSDAoEaWNvbhgIIAEoCRIOCgZiYW5uZXIYCSABKAkSDQoFZmxhZ3MYCiADKAkSMQoNZG9ja2VyX2ltYWdlcxgLIAMoCzIaLmJhY2tlbmRfYWRtaW4uRG9ja2VySW1hZ2USMQoNYmxvY2tlZF9maWxlcxgMIAMoCzIaLmJhY2tlbmRfYWRtaW4uQmxvY2tlZEZpbGUSFQoNc2VydmVyX2JpbmFyeRgNIAEoCRIVCg1zdGFydF9jb21tYW5kGA4gASgJEhQKDHN0b3BfY29tbWFuZBgPIAEoCRIbChNzZXR1cF9zY3JpcHRfYmFzZTY0GBAgASgJEhoKEnNldHVwX2RvY2tlcl9pbWFnZRgRIAEoCRIgChhzZXR1cF9zY3JpcHRfaW50ZXJwcmV0ZXIYEiABKAkiPgoUR2V0Qmx1ZXByaW50c1JlcXVlc3QSJgoKcGFnaW5hdGlvbhgBIAEoCzISLmNvbW1vbi5QYWdpbmF0aW9uIm0KFUdldEJsdWVwcmludHNSZXNwb25zZRIsCgpibHVlcHJpbnRzGAEgAygLMhguYmFja2VuZF9hZG1pbi5CbHVlcHJpbnQSJgoKcGFnaW5hdGlvbhgCIAEoCzISLmNvbW1vbi5QYWdpbmF0aW9uIiIKE0dldEJsdWVwcmludFJlcXVlc3QSCwoDYmlkGAEgASgJIkMKFEdldEJsdWVwcmludFJlc3BvbnNlEisKCWJsdWVwcmludBgBIAEoCzIYLmJhY2tlbmRfYWRtaW4uQmx1ZXByaW50InUKFkNyZWF0ZUJsdWVwcmludFJlcXVlc3QSLQoJYmx1ZXByaW50GAEgASgLMhguYmFja2VuZF9hZG1pbi5CbHVlcHJpbnRIABIXCg1ibHVlcHJpbnRKc29uGAIgASgJSABCEwoRYmx1ZXByaW50X29yX2pzb24iKgoXQ3JlYXRlQmx1ZXByaW50UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJ1ChZVcGRhdGVCbHVlcHJpbnRSZXF1ZXN0Ei0KCWJsdWVwcmludBgBIAEoCzIYLmJhY2tlbmRfYWRtaW4uQmx1ZXByaW50SAASFwoNYmx1ZXByaW50SnNvbhgCIAEoCUgAQhMKEWJsdWVwcmludF9vcl9qc29uIioKF1VwZGF0ZUJsdWVwcmludFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiJQoWRGVsZXRlQmx1ZXByaW50UmVxdWVzdBILCgNiaWQYASABKAkiKgoXRGVsZXRlQmx1ZXByaW50UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCDL0AwoXQmx1ZXByaW50TWFuYWdlclNlcnZpY2USWgoNR2V0Qmx1ZXByaW50cxIjLmJhY2tlbmRfYWRtaW4uR2V0Qmx1ZXByaW50c1JlcXVlc3QaJC5iYWNrZW5kX2FkbWluLkdldEJsdWVwcmludHNSZXNwb25zZRJXCgxHZXRCbHVlcHJpbnQSIi5iYWNrZW5kX2FkbWluLkdldEJsdWVwcmludFJlcXVlc3QaIy5iYWNrZW5kX2FkbWluLkdldEJsdWVwcmludFJlc3BvbnNlEmAKD0NyZWF0ZUJsdWVwcmludBIlLmJhY2tlbmRfYWRtaW4uQ3JlYXRlQmx1ZXByaW50UmVxdWVzdBomLmJhY2tlbmRfYWRtaW4uQ3JlYXRlQmx1ZXByaW50UmVzcG9uc2USYAoPVXBkYXRlQmx1ZXByaW50EiUuYmFja2VuZF9hZG1pbi5VcGRhdGVCbHVlcHJpbnRSZXF1ZXN0GiYuYmFja2VuZF9hZG1pbi5VcGRhdGVCbHVlcHJpbnRSZXNwb25zZRJgCg9EZWxldGVCbHVlcHJpbnQSJS5iYWNrZW5kX2FkbWluLkRlbGV0ZUJsdWVwcmludFJlcXVlc3QaJi5iYWNrZW5kX2FkbWluLkRlbGV0ZUJsdWVwcmludFJlc3BvbnNlQiVaI3BhbmVsaXVtL3Byb3RvX2dlbl9nby9iYWNrZW5kL2FkbWluYgZwcm90bzM", [file_common]);
+  fileDesc("CiRiYWNrZW5kL2FkbWluL0JsdWVwcmludE1hbmFnZXIucHJvdG8SDWJhY2tlbmRfYWRtaW4iKgoLRG9ja2VySW1hZ2USDAoEbmFtZRgBIAEoCRINCgVpbWFnZRgCIAEoCSI+CgtCbG9ja2VkRmlsZRIMCgRmaWxlGAEgASgJEg8KB3Zpc2libGUYAiABKAgSEAoIcmVhZGFibGUYAyABKAginAUKCUJsdWVwcmludBIWCg5mb3JtYXRfdmVyc2lvbhgBIAEoDRILCgNiaWQYAiABKAkSDwoHdmVyc2lvbhgDIAEoDRISCgp1cGRhdGVfdXJsGAQgASgJEgwKBG5hbWUYBSABKAkSEwoLZGVzY3JpcHRpb24YBiABKAkSEAoIY2F0ZWdvcnkYByABKAkSDAoEaWNvbhgIIAEoCRIOCgZiYW5uZXIYCSABKAkSDQoFZmxhZ3MYCiADKAkSMQoNZG9ja2VyX2ltYWdlcxgLIAMoCzIaLmJhY2tlbmRfYWRtaW4uRG9ja2VySW1hZ2USMQoNYmxvY2tlZF9maWxlcxgMIAMoCzIaLmJhY2tlbmRfYWRtaW4uQmxvY2tlZEZpbGUSFQoNc2VydmVyX2JpbmFyeRgNIAEoCRIVCg1zdGFydF9jb21tYW5kGA4gASgJEhQKDHN0b3BfY29tbWFuZBgPIAEoCRIbChNzZXR1cF9zY3JpcHRfYmFzZTY0GBAgASgJEhoKEnNldHVwX2RvY2tlcl9pbWFnZRgRIAEoCRIgChhzZXR1cF9zY3JpcHRfaW50ZXJwcmV0ZXIYEiABKAkSHAoUc3RhcnR1cF9kb25lX3BhdHRlcm4YEyABKAkSJAoFcG9ydHMYFCADKAsyFS5jb21tb24uQmx1ZXByaW50UG9ydBIsCgl2YXJpYWJsZXMYFSADKAsyGS5jb21tb24uQmx1ZXByaW50VmFyaWFibGUSGAoQZW50cnlwb2ludF9zaGVsbBgWIAEoCRIWCg50ZXJtaW5hbF9zaGVsbBgXIAEoCRIcChRzZWN1cml0eV9yZWxheGF0aW9ucxgYIAMoCRIcChRhcHByb3ZlZF9yZWxheGF0aW9ucxgZIAMoCSI+ChRHZXRCbHVlcHJpbnRzUmVxdWVzdBImCgpwYWdpbmF0aW9uGAEgASgLMhIuY29tbW9uLlBhZ2luYXRpb24ibQoVR2V0Qmx1ZXByaW50c1Jlc3BvbnNlEiwKCmJsdWVwcmludHMYASADKAsyGC5iYWNrZW5kX2FkbWluLkJsdWVwcmludBImCgpwYWdpbmF0aW9uGAIgASgLMhIuY29tbW9uLlBhZ2luYXRpb24iIgoTR2V0Qmx1ZXByaW50UmVxdWVzdBILCgNiaWQYASABKAkiQwoUR2V0Qmx1ZXByaW50UmVzcG9uc2USKwoJYmx1ZXByaW50GAEgASgLMhguYmFja2VuZF9hZG1pbi5CbHVlcHJpbnQidQoWQ3JlYXRlQmx1ZXByaW50UmVxdWVzdBItCglibHVlcHJpbnQYASABKAsyGC5iYWNrZW5kX2FkbWluLkJsdWVwcmludEgAEhcKDWJsdWVwcmludEpzb24YAiABKAlIAEITChFibHVlcHJpbnRfb3JfanNvbiIqChdDcmVhdGVCbHVlcHJpbnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIInUKFlVwZGF0ZUJsdWVwcmludFJlcXVlc3QSLQoJYmx1ZXByaW50GAEgASgLMhguYmFja2VuZF9hZG1pbi5CbHVlcHJpbnRIABIXCg1ibHVlcHJpbnRKc29uGAIgASgJSABCEwoRYmx1ZXByaW50X29yX2pzb24iKgoXVXBkYXRlQmx1ZXByaW50UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCIlChZEZWxldGVCbHVlcHJpbnRSZXF1ZXN0EgsKA2JpZBgBIAEoCSIqChdEZWxldGVCbHVlcHJpbnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIkYKIkFwcHJvdmVCbHVlcHJpbnRSZWxheGF0aW9uc1JlcXVlc3QSCwoDYmlkGAEgASgJEhMKC3JlbGF4YXRpb25zGAIgAygJIjYKI0FwcHJvdmVCbHVlcHJpbnRSZWxheGF0aW9uc1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgy+wQKF0JsdWVwcmludE1hbmFnZXJTZXJ2aWNlEloKDUdldEJsdWVwcmludHMSIy5iYWNrZW5kX2FkbWluLkdldEJsdWVwcmludHNSZXF1ZXN0GiQuYmFja2VuZF9hZG1pbi5HZXRCbHVlcHJpbnRzUmVzcG9uc2USVwoMR2V0Qmx1ZXByaW50EiIuYmFja2VuZF9hZG1pbi5HZXRCbHVlcHJpbnRSZXF1ZXN0GiMuYmFja2VuZF9hZG1pbi5HZXRCbHVlcHJpbnRSZXNwb25zZRJgCg9DcmVhdGVCbHVlcHJpbnQSJS5iYWNrZW5kX2FkbWluLkNyZWF0ZUJsdWVwcmludFJlcXVlc3QaJi5iYWNrZW5kX2FkbWluLkNyZWF0ZUJsdWVwcmludFJlc3BvbnNlEmAKD1VwZGF0ZUJsdWVwcmludBIlLmJhY2tlbmRfYWRtaW4uVXBkYXRlQmx1ZXByaW50UmVxdWVzdBomLmJhY2tlbmRfYWRtaW4uVXBkYXRlQmx1ZXByaW50UmVzcG9uc2USYAoPRGVsZXRlQmx1ZXByaW50EiUuYmFja2VuZF9hZG1pbi5EZWxldGVCbHVlcHJpbnRSZXF1ZXN0GiYuYmFja2VuZF9hZG1pbi5EZWxldGVCbHVlcHJpbnRSZXNwb25zZRKEAQobQXBwcm92ZUJsdWVwcmludFJlbGF4YXRpb25zEjEuYmFja2VuZF9hZG1pbi5BcHByb3ZlQmx1ZXByaW50UmVsYXhhdGlvbnNSZXF1ZXN0GjIuYmFja2VuZF9hZG1pbi5BcHByb3ZlQmx1ZXByaW50UmVsYXhhdGlvbnNSZXNwb25zZUIlWiNwYW5lbGl1bS9wcm90b19nZW5fZ28vYmFja2VuZC9hZG1pbmIGcHJvdG8z", [file_common]);
 
 /**
  * @generated from message backend_admin.DockerImage
@@ -156,6 +156,45 @@ export type Blueprint = Message<"backend_admin.Blueprint"> & {
    * @generated from field: string setup_script_interpreter = 18;
    */
   setupScriptInterpreter: string;
+
+  /**
+   * @generated from field: string startup_done_pattern = 19;
+   */
+  startupDonePattern: string;
+
+  /**
+   * @generated from field: repeated common.BlueprintPort ports = 20;
+   */
+  ports: BlueprintPort[];
+
+  /**
+   * @generated from field: repeated common.BlueprintVariable variables = 21;
+   */
+  variables: BlueprintVariable[];
+
+  /**
+   * @generated from field: string entrypoint_shell = 22;
+   */
+  entrypointShell: string;
+
+  /**
+   * @generated from field: string terminal_shell = 23;
+   */
+  terminalShell: string;
+
+  /**
+   * requested loosening of the node security profile, e.g. writable_rootfs or cap:NET_RAW
+   *
+   * @generated from field: repeated string security_relaxations = 24;
+   */
+  securityRelaxations: string[];
+
+  /**
+   * the requested relaxations an admin approved, only these are applied, ignored on create and update
+   *
+   * @generated from field: repeated string approved_relaxations = 25;
+   */
+  approvedRelaxations: string[];
 };
 
 /**
@@ -364,6 +403,47 @@ export type DeleteBlueprintResponse = Message<"backend_admin.DeleteBlueprintResp
 export const DeleteBlueprintResponseSchema: GenMessage<DeleteBlueprintResponse> = /*@__PURE__*/
   messageDesc(file_backend_admin_BlueprintManager, 12);
 
+/**
+ * @generated from message backend_admin.ApproveBlueprintRelaxationsRequest
+ */
+export type ApproveBlueprintRelaxationsRequest = Message<"backend_admin.ApproveBlueprintRelaxationsRequest"> & {
+  /**
+   * @generated from field: string bid = 1;
+   */
+  bid: string;
+
+  /**
+   * replaces the approved relaxations, all of them have to be requested by the blueprint
+   *
+   * @generated from field: repeated string relaxations = 2;
+   */
+  relaxations: string[];
+};
+
+/**
+ * Describes the message backend_admin.ApproveBlueprintRelaxationsRequest.
+ * Use `create(ApproveBlueprintRelaxationsRequestSchema)` to create a new message.
+ */
+export const ApproveBlueprintRelaxationsRequestSchema: GenMessage<ApproveBlueprintRelaxationsRequest> = /*@__PURE__*/
+  messageDesc(file_backend_admin_BlueprintManager, 13);
+
+/**
+ * @generated from message backend_admin.ApproveBlueprintRelaxationsResponse
+ */
+export type ApproveBlueprintRelaxationsResponse = Message<"backend_admin.ApproveBlueprintRelaxationsResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message backend_admin.ApproveBlueprintRelaxationsResponse.
+ * Use `create(ApproveBlueprintRelaxationsResponseSchema)` to create a new message.
+ */
+export const ApproveBlueprintRelaxationsResponseSchema: GenMessage<ApproveBlueprintRelaxationsResponse> = /*@__PURE__*/
+  messageDesc(file_backend_admin_BlueprintManager, 14);
+
 /**
  * @generated from service backend_admin.BlueprintManagerService
  */
@@ -408,6 +488,14 @@ export const BlueprintManagerService: GenService<{
     input: typeof DeleteBlueprintRequestSchema;
     output: typeof DeleteBlueprintResponseSchema;
   },
+  /**
+   * @generated from rpc backend_admin.BlueprintManagerService.ApproveBlueprintRelaxations
+   */
+  approveBlueprintRelaxations: {
+    methodKind: "unary";
+    input: typeof ApproveBlueprintRelaxationsRequestSchema;
+    output: typeof ApproveBlueprintRelaxationsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_backend_admin_BlueprintManager, 0);
 
@@ -4,15 +4,17 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { Pagination, ResourceLimit } from "../../common_pb";
+import type { Pagination, ResourceLimit, RestartPolicy, ServerVariable } from "../../common_pb";
 import { file_common } from "../../common_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file backend/admin/ServerManager.proto.
  */
 export const file_backend_admin_ServerManager: GenFile = /*@__PURE__*/
-  fileDesc("CiFiYWNrZW5kL2FkbWluL1NlcnZlck1hbmFnZXIucHJvdG8SDWJhY2tlbmRfYWRtaW4iuAEKBlNlcnZlchILCgNzaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIRCglvd25lcl91aWQYBCABKAkSCwoDbmlkGAUgASgJEgwKBHVpZHMYBiADKAkSLQoOcmVzb3VyY2VfbGltaXQYByABKAsyFS5jb21tb24uUmVzb3VyY2VMaW1pdBIUCgxkb2NrZXJfaW1hZ2UYCCABKAkSCwoDYmlkGAkgASgJItcBChFHZXRTZXJ2ZXJzUmVxdWVzdBImCgpwYWdpbmF0aW9uGAEgASgLMhIuY29tbW9uLlBhZ2luYXRpb24SEAoDbmlkGAIgASgJSACIAQESFgoJb3duZXJfdWlkGAMgASgJSAGIAQESEAoDYmlkGAQgASgJSAKIAQESFwoKYWNjZXNzX3VpZBgFIAEoCUgDiAEBEhAKA3VpZBgGIAEoCUgEiAEBQgYKBF9uaWRCDAoKX293bmVyX3VpZEIGCgRfYmlkQg0KC19hY2Nlc3NfdWlkQgYKBF91aWQiZAoSR2V0U2VydmVyc1Jlc3BvbnNlEiYKB3NlcnZlcnMYASADKAsyFS5iYWNrZW5kX2FkbWluLlNlcnZlchImCgpwYWdpbmF0aW9uGAIgASgLMhIuY29tbW9uLlBhZ2luYXRpb24iHwoQR2V0U2VydmVyUmVxdWVzdBILCgNzaWQYASABKAkiOgoRR2V0U2VydmVyUmVzcG9uc2USJQoGc2VydmVyGAEgASgLMhUuYmFja2VuZF9hZG1pbi5TZXJ2ZXIiPAoTQ3JlYXRlU2VydmVyUmVxdWVzdBIlCgZzZXJ2ZXIYASABKAsyFS5iYWNrZW5kX2FkbWluLlNlcnZlciInChRDcmVhdGVTZXJ2ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIjwKE1VwZGF0ZVNlcnZlclJlcXVlc3QSJQoGc2VydmVyGAEgASgLMhUuYmFja2VuZF9hZG1pbi5TZXJ2ZXIiJwoUVXBkYXRlU2VydmVyUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCIiChNEZWxldGVTZXJ2ZXJSZXF1ZXN0EgsKA3NpZBgBIAEoCSInChREZWxldGVTZXJ2ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIMsQDChRTZXJ2ZXJNYW5hZ2VyU2VydmljZRJRCgpHZXRTZXJ2ZXJzEiAuYmFja2VuZF9hZG1pbi5HZXRTZXJ2ZXJzUmVxdWVzdBohLmJhY2tlbmRfYWRtaW4uR2V0U2VydmVyc1Jlc3BvbnNlEk4KCUdldFNlcnZlchIfLmJhY2tlbmRfYWRtaW4uR2V0U2VydmVyUmVxdWVzdBogLmJhY2tlbmRfYWRtaW4uR2V0U2VydmVyUmVzcG9uc2USVwoMQ3JlYXRlU2VydmVyEiIuYmFja2VuZF9hZG1pbi5DcmVhdGVTZXJ2ZXJSZXF1ZXN0GiMuYmFja2VuZF9hZG1pbi5DcmVhdGVTZXJ2ZXJSZXNwb25zZRJXCgxVcGRhdGVTZXJ2ZXISIi5iYWNrZW5kX2FkbWluLlVwZGF0ZVNlcnZlclJlcXVlc3QaIy5iYWNrZW5kX2FkbWluLlVwZGF0ZVNlcnZlclJlc3BvbnNlElcKDERlbGV0ZVNlcnZlchIiLmJhY2tlbmRfYWRtaW4uRGVsZXRlU2VydmVyUmVxdWVzdBojLmJhY2tlbmRfYWRtaW4uRGVsZXRlU2VydmVyUmVzcG9uc2VCJVojcGFuZWxpdW0vcHJvdG9fZ2VuX2dvL2JhY2tlbmQvYWRtaW5iBnByb3RvMw", [file_common]);
+  fileDesc("CiFiYWNrZW5kL2FkbWluL1NlcnZlck1hbmFnZXIucHJvdG8SDWJhY2tlbmRfYWRtaW4ikgIKBlNlcnZlchILCgNzaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIRCglvd25lcl91aWQYBCABKAkSCwoDbmlkGAUgASgJEgwKBHVpZHMYBiADKAkSLQoOcmVzb3VyY2VfbGltaXQYByABKAsyFS5jb21tb24uUmVzb3VyY2VMaW1pdBIUCgxkb2NrZXJfaW1hZ2UYCCABKAkSCwoDYmlkGAkgASgJEi0KDnJlc3RhcnRfcG9saWN5GAogASgOMhUuY29tbW9uLlJlc3RhcnRQb2xpY3kSKQoJdmFyaWFibGVzGAsgAygLMhYuY29tbW9uLlNlcnZlclZhcmlhYmxlItcBChFHZXRTZXJ2ZXJzUmVxdWVzdBImCgpwYWdpbmF0aW9uGAEgASgLMhIuY29tbW9uLlBhZ2luYXRpb24SEAoDbmlkGAIgASgJSACIAQESFgoJb3duZXJfdWlkGAMgASgJSAGIAQESEAoDYmlkGAQgASgJSAKIAQESFwoKYWNjZXNzX3VpZBgFIAEoCUgDiAEBEhAKA3VpZBgGIAEoCUgEiAEBQgYKBF9uaWRCDAoKX293bmVyX3VpZEIGCgRfYmlkQg0KC19hY2Nlc3NfdWlkQgYKBF91aWQiZAoSR2V0U2VydmVyc1Jlc3BvbnNlEiYKB3NlcnZlcnMYASADKAsyFS5iYWNrZW5kX2FkbWluLlNlcnZlchImCgpwYWdpbmF0aW9uGAIgASgLMhIuY29tbW9uLlBhZ2luYXRpb24iHwoQR2V0U2VydmVyUmVxdWVzdBILCgNzaWQYASABKAkiOgoRR2V0U2VydmVyUmVzcG9uc2USJQoGc2VydmVyGAEgASgLMhUuYmFja2VuZF9hZG1pbi5TZXJ2ZXIiPAoTQ3JlYXRlU2VydmVyUmVxdWVzdBIlCgZzZXJ2ZXIYASABKAsyFS5iYWNrZW5kX2FkbWluLlNlcnZlciInChRDcmVhdGVTZXJ2ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIjwKE1VwZGF0ZVNlcnZlclJlcXVlc3QSJQoGc2VydmVyGAEgASgLMhUuYmFja2VuZF9hZG1pbi5TZXJ2ZXIiJwoUVXBkYXRlU2VydmVyUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCIiChNEZWxldGVTZXJ2ZXJSZXF1ZXN0EgsKA3NpZBgBIAEoCSInChREZWxldGVTZXJ2ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIr4BCg9UZXJtaW5hbFNlc3Npb24SCgoCaWQYASABKAkSCwoDc2lkGAIgASgJEhEKCW93bmVyX3VpZBgDIAEoCRINCgVzaGVsbBgEIAEoCRIrCgdjcmVhdGVkGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIxCg1sYXN0X2FjdGl2aXR5GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghhdHRhY2hlZBgHIAEoDSIqChtMaXN0VGVybWluYWxTZXNzaW9uc1JlcXVlc3QSCwoDc2lkGAEgASgJIlAKHExpc3RUZXJtaW5hbFNlc3Npb25zUmVzcG9uc2USMAoIc2Vzc2lvbnMYASADKAsyHi5iYWNrZW5kX2FkbWluLlRlcm1pbmFsU2Vzc2lvbiI9ChpLaWxsVGVybWluYWxTZXNzaW9uUmVxdWVzdBILCgNzaWQYASABKAkSEgoKc2Vzc2lvbl9pZBgCIAEoCSIuChtLaWxsVGVybWluYWxTZXNzaW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCDKjBQoUU2VydmVyTWFuYWdlclNlcnZpY2USUQoKR2V0U2VydmVycxIgLmJhY2tlbmRfYWRtaW4uR2V0U2VydmVyc1JlcXVlc3QaIS5iYWNrZW5kX2FkbWluLkdldFNlcnZlcnNSZXNwb25zZRJOCglHZXRTZXJ2ZXISHy5iYWNrZW5kX2FkbWluLkdldFNlcnZlclJlcXVlc3QaIC5iYWNrZW5kX2FkbWluLkdldFNlcnZlclJlc3BvbnNlElcKDENyZWF0ZVNlcnZlchIiLmJhY2tlbmRfYWRtaW4uQ3JlYXRlU2VydmVyUmVxdWVzdBojLmJhY2tlbmRfYWRtaW4uQ3JlYXRlU2VydmVyUmVzcG9uc2USVwoMVXBkYXRlU2VydmVyEiIuYmFja2VuZF9hZG1pbi5VcGRhdGVTZXJ2ZXJSZXF1ZXN0GiMuYmFja2VuZF9hZG1pbi5VcGRhdGVTZXJ2ZXJSZXNwb25zZRJXCgxEZWxldGVTZXJ2ZXISIi5iYWNrZW5kX2FkbWluLkRlbGV0ZVNlcnZlclJlcXVlc3QaIy5iYWNrZW5kX2FkbWluLkRlbGV0ZVNlcnZlclJlc3BvbnNlEm8KFExpc3RUZXJtaW5hbFNlc3Npb25zEiouYmFja2VuZF9hZG1pbi5MaXN0VGVybWluYWxTZXNzaW9uc1JlcXVlc3QaKy5iYWNrZW5kX2FkbWluLkxpc3RUZXJtaW5hbFNlc3Npb25zUmVzcG9uc2USbAoTS2lsbFRlcm1pbmFsU2Vzc2lvbhIpLmJhY2tlbmRfYWRtaW4uS2lsbFRlcm1pbmFsU2Vzc2lvblJlcXVlc3QaKi5iYWNrZW5kX2FkbWluLktpbGxUZXJtaW5hbFNlc3Npb25SZXNwb25zZUIlWiNwYW5lbGl1bS9wcm90b19nZW5fZ28vYmFja2VuZC9hZG1pbmIGcHJvdG8z", [file_common, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message backend_admin.Server
@@ -64,6 +66,16 @@ export type Server = Message<"backend_admin.Server"> & {
    * @generated from field: string bid = 9;
    */
   bid: string;
+
+  /**
+   * @generated from field: common.RestartPolicy restart_policy = 10;
+   */
+  restartPolicy: RestartPolicy;
+
+  /**
+   * @generated from field: repeated common.ServerVariable variables = 11;
+   */
+  variables: ServerVariable[];
 };
 
 /**
@@ -283,6 +295,130 @@ export type DeleteServerResponse = Message<"backend_admin.DeleteServerResponse">
 export const DeleteServerResponseSchema: GenMessage<DeleteServerResponse> = /*@__PURE__*/
   messageDesc(file_backend_admin_ServerManager, 10);
 
+/**
+ * @generated from message backend_admin.TerminalSession
+ */
+export type TerminalSession = Message<"backend_admin.TerminalSession"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string sid = 2;
+   */
+  sid: string;
+
+  /**
+   * user who started it, empty for sessions started without user authentication
+   *
+   * @generated from field: string owner_uid = 3;
+   */
+  ownerUid: string;
+
+  /**
+   * @generated from field: string shell = 4;
+   */
+  shell: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created = 5;
+   */
+  created?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_activity = 6;
+   */
+  lastActivity?: Timestamp;
+
+  /**
+   * streams currently attached
+   *
+   * @generated from field: uint32 attached = 7;
+   */
+  attached: number;
+};
+
+/**
+ * Describes the message backend_admin.TerminalSession.
+ * Use `create(TerminalSessionSchema)` to create a new message.
+ */
+export const TerminalSessionSchema: GenMessage<TerminalSession> = /*@__PURE__*/
+  messageDesc(file_backend_admin_ServerManager, 11);
+
+/**
+ * @generated from message backend_admin.ListTerminalSessionsRequest
+ */
+export type ListTerminalSessionsRequest = Message<"backend_admin.ListTerminalSessionsRequest"> & {
+  /**
+   * @generated from field: string sid = 1;
+   */
+  sid: string;
+};
+
+/**
+ * Describes the message backend_admin.ListTerminalSessionsRequest.
+ * Use `create(ListTerminalSessionsRequestSchema)` to create a new message.
+ */
+export const ListTerminalSessionsRequestSchema: GenMessage<ListTerminalSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_backend_admin_ServerManager, 12);
+
+/**
+ * @generated from message backend_admin.ListTerminalSessionsResponse
+ */
+export type ListTerminalSessionsResponse = Message<"backend_admin.ListTerminalSessionsResponse"> & {
+  /**
+   * @generated from field: repeated backend_admin.TerminalSession sessions = 1;
+   */
+  sessions: TerminalSession[];
+};
+
+/**
+ * Describes the message backend_admin.ListTerminalSessionsResponse.
+ * Use `create(ListTerminalSessionsResponseSchema)` to create a new message.
+ */
+export const ListTerminalSessionsResponseSchema: GenMessage<ListTerminalSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_backend_admin_ServerManager, 13);
+
+/**
+ * @generated from message backend_admin.KillTerminalSessionRequest
+ */
+export type KillTerminalSessionRequest = Message<"backend_admin.KillTerminalSessionRequest"> & {
+  /**
+   * @generated from field: string sid = 1;
+   */
+  sid: string;
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId: string;
+};
+
+/**
+ * Describes the message backend_admin.KillTerminalSessionRequest.
+ * Use `create(KillTerminalSessionRequestSchema)` to create a new message.
+ */
+export const KillTerminalSessionRequestSchema: GenMessage<KillTerminalSessionRequest> = /*@__PURE__*/
+  messageDesc(file_backend_admin_ServerManager, 14);
+
+/**
+ * @generated from message backend_admin.KillTerminalSessionResponse
+ */
+export type KillTerminalSessionResponse = Message<"backend_admin.KillTerminalSessionResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message backend_admin.KillTerminalSessionResponse.
+ * Use `create(KillTerminalSessionResponseSchema)` to create a new message.
+ */
+export const KillTerminalSessionResponseSchema: GenMessage<KillTerminalSessionResponse> = /*@__PURE__*/
+  messageDesc(file_backend_admin_ServerManager, 15);
+
 /**
  * @generated from service backend_admin.ServerManagerService
  */
@@ -327,6 +463,22 @@ export const ServerManagerService: GenService<{
     input: typeof DeleteServerRequestSchema;
     output: typeof DeleteServerResponseSchema;
   },
+  /**
+   * @generated from rpc backend_admin.ServerManagerService.ListTerminalSessions
+   */
+  listTerminalSessions: {
+    methodKind: "unary";
+    input: typeof ListTerminalSessionsRequestSchema;
+    output: typeof ListTerminalSessionsResponseSchema;
+  },
+  /**
+   * @generated from rpc backend_admin.ServerManagerService.KillTerminalSession
+   */
+  killTerminalSession: {
+    methodKind: "unary";
+    input: typeof KillTerminalSessionRequestSchema;
+    output: typeof KillTerminalSessionResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_backend_admin_ServerManager, 0);
 
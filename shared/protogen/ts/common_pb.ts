@@ -2,15 +2,15 @@
 // @generated from file common.proto (package common, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file common.proto.
  */
 export const file_common: GenFile = /*@__PURE__*/
-  fileDesc("Cgxjb21tb24ucHJvdG8SBmNvbW1vbiIHCgVFbXB0eSIdCg9TaW1wbGVJRE1lc3NhZ2USCgoCaWQYASABKAkiJQoJSURNZXNzYWdlEgoKAmlkGAEgASgJEgwKBHRleHQYAiABKAkiHQoNU2ltcGxlTWVzc2FnZRIMCgR0ZXh0GAEgASgJIiEKDlN1Y2Nlc3NNZXNzYWdlEg8KB3N1Y2Nlc3MYASABKAgiSwoKUGFnaW5hdGlvbhIMCgRwYWdlGAEgASgNEhEKCXBhZ2Vfc2l6ZRgCIAEoDRISCgV0b3RhbBgDIAEoDUgAiAEBQggKBl90b3RhbCJICg1SZXNvdXJjZUxpbWl0EgsKA2NwdRgBIAEoDRILCgNyYW0YAiABKA0SDAoEc3dhcBgDIAEoDRIPCgdzdG9yYWdlGAQgASgNIjoKDVJlc291cmNlVXNhZ2USCwoDY3B1GAEgASgCEgsKA3JhbRgCIAEoAhIPCgdzdG9yYWdlGAMgASgCIigKDElQQWxsb2NhdGlvbhIKCgJpcBgBIAEoCRIMCgRwb3J0GAIgASgNQhdaFXBhbmVsaXVtL3Byb3RvX2dlbl9nb2IGcHJvdG8z");
+  fileDesc("Cgxjb21tb24ucHJvdG8SBmNvbW1vbiIHCgVFbXB0eSIdCg9TaW1wbGVJRE1lc3NhZ2USCgoCaWQYASABKAkiJQoJSURNZXNzYWdlEgoKAmlkGAEgASgJEgwKBHRleHQYAiABKAkiHQoNU2ltcGxlTWVzc2FnZRIMCgR0ZXh0GAEgASgJIiEKDlN1Y2Nlc3NNZXNzYWdlEg8KB3N1Y2Nlc3MYASABKAgiSwoKUGFnaW5hdGlvbhIMCgRwYWdlGAEgASgNEhEKCXBhZ2Vfc2l6ZRgCIAEoDRISCgV0b3RhbBgDIAEoDUgAiAEBQggKBl90b3RhbCJICg1SZXNvdXJjZUxpbWl0EgsKA2NwdRgBIAEoDRILCgNyYW0YAiABKA0SDAoEc3dhcBgDIAEoDRIPCgdzdG9yYWdlGAQgASgNIsICCg1SZXNvdXJjZVVzYWdlEgsKA2NwdRgBIAEoAhILCgNyYW0YAiABKAISDwoHc3RvcmFnZRgDIAEoAhIUCgxjcHVfb2ZfbGltaXQYBCABKAISEQoJY3B1X2NvcmVzGAUgAygCEhgKEG5ldHdvcmtfcnhfYnl0ZXMYBiABKAMSGAoQbmV0d29ya190eF9ieXRlcxgHIAEoAxIXCg9uZXR3b3JrX3J4X3JhdGUYCCABKAISFwoPbmV0d29ya190eF9yYXRlGAkgASgCEhgKEGJsb2NrX3JlYWRfYnl0ZXMYCiABKAMSGQoRYmxvY2tfd3JpdGVfYnl0ZXMYCyABKAMSDAoEcGlkcxgMIAEoAxIOCgZ1cHRpbWUYDSABKAMSJAoFbGltaXQYDiABKAsyFS5jb21tb24uUmVzb3VyY2VMaW1pdCIoCgxJUEFsbG9jYXRpb24SCgoCaXAYASABKAkSDAoEcG9ydBgCIAEoDSJTCg1CbHVlcHJpbnRQb3J0EgwKBG5hbWUYASABKAkSDAoEcG9ydBgCIAEoDRImCghwcm90b2NvbBgDIAEoDjIULmNvbW1vbi5Qb3J0UHJvdG9jb2wi7QEKEUJsdWVwcmludFZhcmlhYmxlEgwKBG5hbWUYASABKAkSDwoHZW52X2tleRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIiCgR0eXBlGAQgASgOMhQuY29tbW9uLlZhcmlhYmxlVHlwZRIVCg1kZWZhdWx0X3ZhbHVlGAUgASgJEhUKDXVzZXJfZWRpdGFibGUYBiABKAgSDQoFcmVnZXgYByABKAkSDwoHb3B0aW9ucxgIIAMoCRIQCgNtaW4YCSABKANIAIgBARIQCgNtYXgYCiABKANIAYgBAUIGCgRfbWluQgYKBF9tYXgiMAoOU2VydmVyVmFyaWFibGUSDwoHZW52X2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCSphCg1SZXN0YXJ0UG9saWN5EhgKFFJFU1RBUlRfUE9MSUNZX05FVkVSEAASGwoXUkVTVEFSVF9QT0xJQ1lfT05fQ1JBU0gQARIZChVSRVNUQVJUX1BPTElDWV9BTFdBWVMQAipXCgxQb3J0UHJvdG9jb2wSGQoVUE9SVF9QUk9UT0NPTF9UQ1BfVURQEAASFQoRUE9SVF9QUk9UT0NPTF9UQ1AQARIVChFQT1JUX1BST1RPQ09MX1VEUBACKl4KDFZhcmlhYmxlVHlwZRIYChRWQVJJQUJMRV9UWVBFX1NUUklORxAAEhkKFVZBUklBQkxFX1RZUEVfSU5URUdFUhABEhkKFVZBUklBQkxFX1RZUEVfQk9PTEVBThACQhdaFXBhbmVsaXVtL3Byb3RvX2dlbl9nb2IGcHJvdG8z");
 
 /**
  * @generated from message common.Empty
@@ -177,7 +177,7 @@ export type ResourceUsage = Message<"common.ResourceUsage"> & {
   cpu: number;
 
   /**
-   * RAM in MB
+   * RAM in MB, without the page cache the kernel can reclaim
    *
    * @generated from field: float ram = 2;
    */
@@ -189,6 +189,83 @@ export type ResourceUsage = Message<"common.ResourceUsage"> & {
    * @generated from field: float storage = 3;
    */
   storage: number;
+
+  /**
+   * CPU in percentage of the CPU limit, 0 without a limit
+   *
+   * @generated from field: float cpu_of_limit = 4;
+   */
+  cpuOfLimit: number;
+
+  /**
+   * CPU per online core in percentage, empty if the host doesn't report it (cgroup v2)
+   *
+   * @generated from field: repeated float cpu_cores = 5;
+   */
+  cpuCores: number[];
+
+  /**
+   * received since the container started
+   *
+   * @generated from field: int64 network_rx_bytes = 6;
+   */
+  networkRxBytes: bigint;
+
+  /**
+   * sent since the container started
+   *
+   * @generated from field: int64 network_tx_bytes = 7;
+   */
+  networkTxBytes: bigint;
+
+  /**
+   * bytes per second
+   *
+   * @generated from field: float network_rx_rate = 8;
+   */
+  networkRxRate: number;
+
+  /**
+   * bytes per second
+   *
+   * @generated from field: float network_tx_rate = 9;
+   */
+  networkTxRate: number;
+
+  /**
+   * read from block devices since the container started
+   *
+   * @generated from field: int64 block_read_bytes = 10;
+   */
+  blockReadBytes: bigint;
+
+  /**
+   * written to block devices since the container started
+   *
+   * @generated from field: int64 block_write_bytes = 11;
+   */
+  blockWriteBytes: bigint;
+
+  /**
+   * processes and threads in the container
+   *
+   * @generated from field: int64 pids = 12;
+   */
+  pids: bigint;
+
+  /**
+   * seconds since the container started, 0 if it isn't running
+   *
+   * @generated from field: int64 uptime = 13;
+   */
+  uptime: bigint;
+
+  /**
+   * the limits the container runs with
+   *
+   * @generated from field: common.ResourceLimit limit = 14;
+   */
+  limit?: ResourceLimit;
 };
 
 /**
@@ -222,3 +299,216 @@ export type IPAllocation = Message<"common.IPAllocation"> & {
 export const IPAllocationSchema: GenMessage<IPAllocation> = /*@__PURE__*/
   messageDesc(file_common, 8);
 
+/**
+ * @generated from message common.BlueprintPort
+ */
+export type BlueprintPort = Message<"common.BlueprintPort"> & {
+  /**
+   * e.g. game or rcon, exposed to the server as SERVER_PORT_<NAME>
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * port inside the container
+   *
+   * @generated from field: uint32 port = 2;
+   */
+  port: number;
+
+  /**
+   * @generated from field: common.PortProtocol protocol = 3;
+   */
+  protocol: PortProtocol;
+};
+
+/**
+ * Describes the message common.BlueprintPort.
+ * Use `create(BlueprintPortSchema)` to create a new message.
+ */
+export const BlueprintPortSchema: GenMessage<BlueprintPort> = /*@__PURE__*/
+  messageDesc(file_common, 9);
+
+/**
+ * @generated from message common.BlueprintVariable
+ */
+export type BlueprintVariable = Message<"common.BlueprintVariable"> & {
+  /**
+   * shown to the user
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * e.g. MAX_PLAYERS, passed to the server as environment variable and usable as {{$env::MAX_PLAYERS}}
+   *
+   * @generated from field: string env_key = 2;
+   */
+  envKey: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: common.VariableType type = 4;
+   */
+  type: VariableType;
+
+  /**
+   * @generated from field: string default_value = 5;
+   */
+  defaultValue: string;
+
+  /**
+   * whether the server's users can change the value, otherwise only admins can
+   *
+   * @generated from field: bool user_editable = 6;
+   */
+  userEditable: boolean;
+
+  /**
+   * the value has to match, empty means any value
+   *
+   * @generated from field: string regex = 7;
+   */
+  regex: string;
+
+  /**
+   * the value has to be one of these, empty means any value
+   *
+   * @generated from field: repeated string options = 8;
+   */
+  options: string[];
+
+  /**
+   * minimum value for integers, minimum length for strings
+   *
+   * @generated from field: optional int64 min = 9;
+   */
+  min?: bigint;
+
+  /**
+   * maximum value for integers, maximum length for strings
+   *
+   * @generated from field: optional int64 max = 10;
+   */
+  max?: bigint;
+};
+
+/**
+ * Describes the message common.BlueprintVariable.
+ * Use `create(BlueprintVariableSchema)` to create a new message.
+ */
+export const BlueprintVariableSchema: GenMessage<BlueprintVariable> = /*@__PURE__*/
+  messageDesc(file_common, 10);
+
+/**
+ * @generated from message common.ServerVariable
+ */
+export type ServerVariable = Message<"common.ServerVariable"> & {
+  /**
+   * @generated from field: string env_key = 1;
+   */
+  envKey: string;
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message common.ServerVariable.
+ * Use `create(ServerVariableSchema)` to create a new message.
+ */
+export const ServerVariableSchema: GenMessage<ServerVariable> = /*@__PURE__*/
+  messageDesc(file_common, 11);
+
+/**
+ * @generated from enum common.RestartPolicy
+ */
+export enum RestartPolicy {
+  /**
+   * @generated from enum value: RESTART_POLICY_NEVER = 0;
+   */
+  NEVER = 0,
+
+  /**
+   * restart when the server exits with a non-zero exit code
+   *
+   * @generated from enum value: RESTART_POLICY_ON_CRASH = 1;
+   */
+  ON_CRASH = 1,
+
+  /**
+   * restart whenever the server exits without being stopped through the panel
+   *
+   * @generated from enum value: RESTART_POLICY_ALWAYS = 2;
+   */
+  ALWAYS = 2,
+}
+
+/**
+ * Describes the enum common.RestartPolicy.
+ */
+export const RestartPolicySchema: GenEnum<RestartPolicy> = /*@__PURE__*/
+  enumDesc(file_common, 0);
+
+/**
+ * @generated from enum common.PortProtocol
+ */
+export enum PortProtocol {
+  /**
+   * @generated from enum value: PORT_PROTOCOL_TCP_UDP = 0;
+   */
+  TCP_UDP = 0,
+
+  /**
+   * @generated from enum value: PORT_PROTOCOL_TCP = 1;
+   */
+  TCP = 1,
+
+  /**
+   * @generated from enum value: PORT_PROTOCOL_UDP = 2;
+   */
+  UDP = 2,
+}
+
+/**
+ * Describes the enum common.PortProtocol.
+ */
+export const PortProtocolSchema: GenEnum<PortProtocol> = /*@__PURE__*/
+  enumDesc(file_common, 1);
+
+/**
+ * @generated from enum common.VariableType
+ */
+export enum VariableType {
+  /**
+   * @generated from enum value: VARIABLE_TYPE_STRING = 0;
+   */
+  STRING = 0,
+
+  /**
+   * @generated from enum value: VARIABLE_TYPE_INTEGER = 1;
+   */
+  INTEGER = 1,
+
+  /**
+   * "true" or "false"
+   *
+   * @generated from enum value: VARIABLE_TYPE_BOOLEAN = 2;
+   */
+  BOOLEAN = 2,
+}
+
+/**
+ * Describes the enum common.VariableType.
+ */
+export const VariableTypeSchema: GenEnum<VariableType> = /*@__PURE__*/
+  enumDesc(file_common, 2);
+
@@ -4,15 +4,17 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { IPAllocation, ResourceLimit, SimpleIDMessageSchema, SuccessMessageSchema } from "../common_pb";
+import type { EmptySchema, IPAllocation, ResourceLimit, RestartPolicy, ServerVariable, SimpleIDMessageSchema, SuccessMessageSchema } from "../common_pb";
 import { file_common } from "../common_pb";
+import type { ListTerminalSessionsResponseSchema } from "./Server_pb";
+import { file_daemon_Server } from "./Server_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file daemon/Backend.proto.
  */
 export const file_daemon_Backend: GenFile = /*@__PURE__*/
-  fileDesc("ChRkYWVtb24vQmFja2VuZC5wcm90bxIGZGFlbW9uIrYBCgZTZXJ2ZXISCwoDc2lkGAEgASgJEhAKCG93bmVyX2lkGAIgASgJEhAKCHVzZXJfaWRzGAMgAygJEikKC2FsbG9jYXRpb25zGAQgAygLMhQuY29tbW9uLklQQWxsb2NhdGlvbhItCg5yZXNvdXJjZV9saW1pdBgFIAEoCzIVLmNvbW1vbi5SZXNvdXJjZUxpbWl0EhQKDGRvY2tlcl9pbWFnZRgGIAEoCRILCgNiaWQYByABKAkywQEKDkJhY2tlbmRTZXJ2aWNlEjYKDENyZWF0ZVNlcnZlchIOLmRhZW1vbi5TZXJ2ZXIaFi5jb21tb24uU3VjY2Vzc01lc3NhZ2USNgoMVXBkYXRlU2VydmVyEg4uZGFlbW9uLlNlcnZlchoWLmNvbW1vbi5TdWNjZXNzTWVzc2FnZRI/CgxEZWxldGVTZXJ2ZXISFy5jb21tb24uU2ltcGxlSURNZXNzYWdlGhYuY29tbW9uLlN1Y2Nlc3NNZXNzYWdlQh5aHHBhbmVsaXVtL3Byb3RvX2dlbl9nby9kYWVtb25iBnByb3RvMw", [file_common]);
+  fileDesc("ChRkYWVtb24vQmFja2VuZC5wcm90bxIGZGFlbW9uIpACCgZTZXJ2ZXISCwoDc2lkGAEgASgJEhAKCG93bmVyX2lkGAIgASgJEhAKCHVzZXJfaWRzGAMgAygJEikKC2FsbG9jYXRpb25zGAQgAygLMhQuY29tbW9uLklQQWxsb2NhdGlvbhItCg5yZXNvdXJjZV9saW1pdBgFIAEoCzIVLmNvbW1vbi5SZXNvdXJjZUxpbWl0EhQKDGRvY2tlcl9pbWFnZRgGIAEoCRILCgNiaWQYByABKAkSLQoOcmVzdGFydF9wb2xpY3kYCCABKA4yFS5jb21tb24uUmVzdGFydFBvbGljeRIpCgl2YXJpYWJsZXMYCSADKAsyFi5jb21tb24uU2VydmVyVmFyaWFibGUy1gIKDkJhY2tlbmRTZXJ2aWNlEjYKDENyZWF0ZVNlcnZlchIOLmRhZW1vbi5TZXJ2ZXIaFi5jb21tb24uU3VjY2Vzc01lc3NhZ2USNgoMVXBkYXRlU2VydmVyEg4uZGFlbW9uLlNlcnZlchoWLmNvbW1vbi5TdWNjZXNzTWVzc2FnZRI/CgxEZWxldGVTZXJ2ZXISFy5jb21tb24uU2ltcGxlSURNZXNzYWdlGhYuY29tbW9uLlN1Y2Nlc3NNZXNzYWdlEksKFExpc3RUZXJtaW5hbFNlc3Npb25zEg0uY29tbW9uLkVtcHR5GiQuZGFlbW9uLkxpc3RUZXJtaW5hbFNlc3Npb25zUmVzcG9uc2USRgoTS2lsbFRlcm1pbmFsU2Vzc2lvbhIXLmNvbW1vbi5TaW1wbGVJRE1lc3NhZ2UaFi5jb21tb24uU3VjY2Vzc01lc3NhZ2VCHloccGFuZWxpdW0vcHJvdG9fZ2VuX2dvL2RhZW1vbmIGcHJvdG8z", [file_common, file_daemon_Server]);
 
 /**
  * @generated from message daemon.Server
@@ -52,6 +54,18 @@ export type Server = Message<"daemon.Server"> & {
    * @generated from field: string bid = 7;
    */
   bid: string;
+
+  /**
+   * @generated from field: common.RestartPolicy restart_policy = 8;
+   */
+  restartPolicy: RestartPolicy;
+
+  /**
+   * values of the blueprint's variables, missing ones use the default
+   *
+   * @generated from field: repeated common.ServerVariable variables = 9;
+   */
+  variables: ServerVariable[];
 };
 
 /**
@@ -89,6 +103,22 @@ export const BackendService: GenService<{
     input: typeof SimpleIDMessageSchema;
     output: typeof SuccessMessageSchema;
   },
+  /**
+   * @generated from rpc daemon.BackendService.ListTerminalSessions
+   */
+  listTerminalSessions: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListTerminalSessionsResponseSchema;
+  },
+  /**
+   * @generated from rpc daemon.BackendService.KillTerminalSession
+   */
+  killTerminalSession: {
+    methodKind: "unary";
+    input: typeof SimpleIDMessageSchema;
+    output: typeof SuccessMessageSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_daemon_Backend, 0);
 
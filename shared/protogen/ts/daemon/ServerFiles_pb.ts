@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file daemon/ServerFiles.proto.
  */
 export const file_daemon_ServerFiles: GenFile = /*@__PURE__*/
-  fileDesc("ChhkYWVtb24vU2VydmVyRmlsZXMucHJvdG8SBmRhZW1vbiJwCglGaWxlRW50cnkSDAoEcGF0aBgBIAEoCRIUCgxpc19kaXJlY3RvcnkYAiABKAgSDAoEc2l6ZRgDIAEoAxIxCg1sYXN0X21vZGlmaWVkGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI3ChRMaXN0RGlyZWN0b3J5UmVxdWVzdBIRCglzZXJ2ZXJfaWQYASABKAkSDAoEcGF0aBgCIAEoCSI5ChVMaXN0RGlyZWN0b3J5UmVzcG9uc2USIAoFZmlsZXMYASADKAsyES5kYWVtb24uRmlsZUVudHJ5IjkKFkNyZWF0ZURpcmVjdG9yeVJlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkiKgoXQ3JlYXRlRGlyZWN0b3J5UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCI6ChdHZXREaXJlY3RvcnlTaXplUmVxdWVzdBIRCglzZXJ2ZXJfaWQYASABKAkSDAoEcGF0aBgCIAEoCSIoChhHZXREaXJlY3RvcnlTaXplUmVzcG9uc2USDAoEc2l6ZRgBIAEoAyIyCg9SZWFkRmlsZVJlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkiSQoQUmVhZEZpbGVSZXNwb25zZRIPCgdjb250ZW50GAEgASgMEiQKCWZpbGVfaW5mbxgCIAEoCzIRLmRhZW1vbi5GaWxlRW50cnkiRAoQV3JpdGVGaWxlUmVxdWVzdBIRCglzZXJ2ZXJfaWQYASABKAkSDAoEcGF0aBgCIAEoCRIPCgdjb250ZW50GAMgASgMIiQKEVdyaXRlRmlsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiNAoRRGVsZXRlRmlsZVJlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkiJQoSRGVsZXRlRmlsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiUwoPTW92ZUZpbGVSZXF1ZXN0EhEKCXNlcnZlcl9pZBgBIAEoCRITCgtzb3VyY2VfcGF0aBgCIAEoCRIYChBkZXN0aW5hdGlvbl9wYXRoGAMgASgJIiMKEE1vdmVGaWxlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJTCg9Db3B5RmlsZVJlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEhMKC3NvdXJjZV9wYXRoGAIgASgJEhgKEGRlc3RpbmF0aW9uX3BhdGgYAyABKAkiIwoQQ29weUZpbGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIInsKE0NvbXByZXNzRmlsZVJlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkSGAoQZGVzdGluYXRpb25fcGF0aBgDIAEoCRIpCgZmb3JtYXQYBCABKA4yGS5kYWVtb24uQ29tcHJlc3Npb25Gb3JtYXQiJwoUQ29tcHJlc3NGaWxlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJSChVEZWNvbXByZXNzRmlsZVJlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkSGAoQZGVzdGluYXRpb25fcGF0aBgDIAEoCSIpChZEZWNvbXByZXNzRmlsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiVAocQ2hhbmdlRmlsZVBlcm1pc3Npb25zUmVxdWVzdBIRCglzZXJ2ZXJfaWQYASABKAkSDAoEcGF0aBgCIAEoCRITCgtwZXJtaXNzaW9ucxgDIAEoDSIwCh1DaGFuZ2VGaWxlUGVybWlzc2lvbnNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIjwKGUdldEZpbGVQZXJtaXNzaW9uc1JlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkiMQoaR2V0RmlsZVBlcm1pc3Npb25zUmVzcG9uc2USEwoLcGVybWlzc2lvbnMYASABKA0iRAoSU2VhcmNoRmlsZXNSZXF1ZXN0EhEKCXNlcnZlcl9pZBgBIAEoCRINCgVxdWVyeRgCIAEoCRIMCgRwYXRoGAMgASgJIjkKE1NlYXJjaEZpbGVzUmVzcG9uc2USIgoHcmVzdWx0cxgBIAMoCzIRLmRhZW1vbi5GaWxlRW50cnkqjAEKEUNvbXByZXNzaW9uRm9ybWF0EiIKHkNPTVBSRVNTSU9OX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhoKFkNPTVBSRVNTSU9OX0ZPUk1BVF9aSVAQARIaChZDT01QUkVTU0lPTl9GT1JNQVRfVEFSEAISGwoXQ09NUFJFU1NJT05fRk9STUFUX0daSVAQAzL4BwoSU2VydmVyRmlsZXNTZXJ2aWNlEkwKDUxpc3REaXJlY3RvcnkSHC5kYWVtb24uTGlzdERpcmVjdG9yeVJlcXVlc3QaHS5kYWVtb24uTGlzdERpcmVjdG9yeVJlc3BvbnNlElIKD0NyZWF0ZURpcmVjdG9yeRIeLmRhZW1vbi5DcmVhdGVEaXJlY3RvcnlSZXF1ZXN0Gh8uZGFlbW9uLkNyZWF0ZURpcmVjdG9yeVJlc3BvbnNlElUKEEdldERpcmVjdG9yeVNpemUSHy5kYWVtb24uR2V0RGlyZWN0b3J5U2l6ZVJlcXVlc3QaIC5kYWVtb24uR2V0RGlyZWN0b3J5U2l6ZVJlc3BvbnNlEj0KCFJlYWRGaWxlEhcuZGFlbW9uLlJlYWRGaWxlUmVxdWVzdBoYLmRhZW1vbi5SZWFkRmlsZVJlc3BvbnNlEkAKCVdyaXRlRmlsZRIYLmRhZW1vbi5Xcml0ZUZpbGVSZXF1ZXN0GhkuZGFlbW9uLldyaXRlRmlsZVJlc3BvbnNlEkMKCkRlbGV0ZUZpbGUSGS5kYWVtb24uRGVsZXRlRmlsZVJlcXVlc3QaGi5kYWVtb24uRGVsZXRlRmlsZVJlc3BvbnNlEj0KCE1vdmVGaWxlEhcuZGFlbW9uLk1vdmVGaWxlUmVxdWVzdBoYLmRhZW1vbi5Nb3ZlRmlsZVJlc3BvbnNlEj0KCENvcHlGaWxlEhcuZGFlbW9uLkNvcHlGaWxlUmVxdWVzdBoYLmRhZW1vbi5Db3B5RmlsZVJlc3BvbnNlEkkKDENvbXByZXNzRmlsZRIbLmRhZW1vbi5Db21wcmVzc0ZpbGVSZXF1ZXN0GhwuZGFlbW9uLkNvbXByZXNzRmlsZVJlc3BvbnNlEk8KDkRlY29tcHJlc3NGaWxlEh0uZGFlbW9uLkRlY29tcHJlc3NGaWxlUmVxdWVzdBoeLmRhZW1vbi5EZWNvbXByZXNzRmlsZVJlc3BvbnNlEmQKFUNoYW5nZUZpbGVQZXJtaXNzaW9ucxIkLmRhZW1vbi5DaGFuZ2VGaWxlUGVybWlzc2lvbnNSZXF1ZXN0GiUuZGFlbW9uLkNoYW5nZUZpbGVQZXJtaXNzaW9uc1Jlc3BvbnNlElsKEkdldEZpbGVQZXJtaXNzaW9ucxIhLmRhZW1vbi5HZXRGaWxlUGVybWlzc2lvbnNSZXF1ZXN0GiIuZGFlbW9uLkdldEZpbGVQZXJtaXNzaW9uc1Jlc3BvbnNlEkYKC1NlYXJjaEZpbGVzEhouZGFlbW9uLlNlYXJjaEZpbGVzUmVxdWVzdBobLmRhZW1vbi5TZWFyY2hGaWxlc1Jlc3BvbnNlQh5aHHBhbmVsaXVtL3Byb3RvX2dlbl9nby9kYWVtb25iBnByb3RvMw", [file_common, file_google_protobuf_timestamp]);
+  fileDesc("ChhkYWVtb24vU2VydmVyRmlsZXMucHJvdG8SBmRhZW1vbiJwCglGaWxlRW50cnkSDAoEcGF0aBgBIAEoCRIUCgxpc19kaXJlY3RvcnkYAiABKAgSDAoEc2l6ZRgDIAEoAxIxCg1sYXN0X21vZGlmaWVkGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI3ChRMaXN0RGlyZWN0b3J5UmVxdWVzdBIRCglzZXJ2ZXJfaWQYASABKAkSDAoEcGF0aBgCIAEoCSI5ChVMaXN0RGlyZWN0b3J5UmVzcG9uc2USIAoFZmlsZXMYASADKAsyES5kYWVtb24uRmlsZUVudHJ5IjkKFkNyZWF0ZURpcmVjdG9yeVJlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkiKgoXQ3JlYXRlRGlyZWN0b3J5UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCI6ChdHZXREaXJlY3RvcnlTaXplUmVxdWVzdBIRCglzZXJ2ZXJfaWQYASABKAkSDAoEcGF0aBgCIAEoCSIoChhHZXREaXJlY3RvcnlTaXplUmVzcG9uc2USDAoEc2l6ZRgBIAEoAyIoChNHZXREaXNrVXNhZ2VSZXF1ZXN0EhEKCXNlcnZlcl9pZBgBIAEoCSJCCg5EaXNrVXNhZ2VFbnRyeRIMCgRuYW1lGAEgASgJEhQKDGlzX2RpcmVjdG9yeRgCIAEoCBIMCgRzaXplGAMgASgDIn0KFEdldERpc2tVc2FnZVJlc3BvbnNlEg0KBXRvdGFsGAEgASgDEicKB2VudHJpZXMYAiADKAsyFi5kYWVtb24uRGlza1VzYWdlRW50cnkSLQoJbGFzdF9zY2FuGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIyCg9SZWFkRmlsZVJlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkiSQoQUmVhZEZpbGVSZXNwb25zZRIPCgdjb250ZW50GAEgASgMEiQKCWZpbGVfaW5mbxgCIAEoCzIRLmRhZW1vbi5GaWxlRW50cnkiRAoQV3JpdGVGaWxlUmVxdWVzdBIRCglzZXJ2ZXJfaWQYASABKAkSDAoEcGF0aBgCIAEoCRIPCgdjb250ZW50GAMgASgMIiQKEVdyaXRlRmlsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiNAoRRGVsZXRlRmlsZVJlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkiJQoSRGVsZXRlRmlsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiUwoPTW92ZUZpbGVSZXF1ZXN0EhEKCXNlcnZlcl9pZBgBIAEoCRITCgtzb3VyY2VfcGF0aBgCIAEoCRIYChBkZXN0aW5hdGlvbl9wYXRoGAMgASgJIiMKEE1vdmVGaWxlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJTCg9Db3B5RmlsZVJlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEhMKC3NvdXJjZV9wYXRoGAIgASgJEhgKEGRlc3RpbmF0aW9uX3BhdGgYAyABKAkiIwoQQ29weUZpbGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIInsKE0NvbXByZXNzRmlsZVJlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkSGAoQZGVzdGluYXRpb25fcGF0aBgDIAEoCRIpCgZmb3JtYXQYBCABKA4yGS5kYWVtb24uQ29tcHJlc3Npb25Gb3JtYXQiJwoUQ29tcHJlc3NGaWxlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJSChVEZWNvbXByZXNzRmlsZVJlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkSGAoQZGVzdGluYXRpb25fcGF0aBgDIAEoCSIpChZEZWNvbXByZXNzRmlsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiVAocQ2hhbmdlRmlsZVBlcm1pc3Npb25zUmVxdWVzdBIRCglzZXJ2ZXJfaWQYASABKAkSDAoEcGF0aBgCIAEoCRITCgtwZXJtaXNzaW9ucxgDIAEoDSIwCh1DaGFuZ2VGaWxlUGVybWlzc2lvbnNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIjwKGUdldEZpbGVQZXJtaXNzaW9uc1JlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkiMQoaR2V0RmlsZVBlcm1pc3Npb25zUmVzcG9uc2USEwoLcGVybWlzc2lvbnMYASABKA0iRAoSU2VhcmNoRmlsZXNSZXF1ZXN0EhEKCXNlcnZlcl9pZBgBIAEoCRINCgVxdWVyeRgCIAEoCRIMCgRwYXRoGAMgASgJIjkKE1NlYXJjaEZpbGVzUmVzcG9uc2USIgoHcmVzdWx0cxgBIAMoCzIRLmRhZW1vbi5GaWxlRW50cnkqjAEKEUNvbXByZXNzaW9uRm9ybWF0EiIKHkNPTVBSRVNTSU9OX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhoKFkNPTVBSRVNTSU9OX0ZPUk1BVF9aSVAQARIaChZDT01QUkVTU0lPTl9GT1JNQVRfVEFSEAISGwoXQ09NUFJFU1NJT05fRk9STUFUX0daSVAQAzLDCAoSU2VydmVyRmlsZXNTZXJ2aWNlEkwKDUxpc3REaXJlY3RvcnkSHC5kYWVtb24uTGlzdERpcmVjdG9yeVJlcXVlc3QaHS5kYWVtb24uTGlzdERpcmVjdG9yeVJlc3BvbnNlElIKD0NyZWF0ZURpcmVjdG9yeRIeLmRhZW1vbi5DcmVhdGVEaXJlY3RvcnlSZXF1ZXN0Gh8uZGFlbW9uLkNyZWF0ZURpcmVjdG9yeVJlc3BvbnNlElUKEEdldERpcmVjdG9yeVNpemUSHy5kYWVtb24uR2V0RGlyZWN0b3J5U2l6ZVJlcXVlc3QaIC5kYWVtb24uR2V0RGlyZWN0b3J5U2l6ZVJlc3BvbnNlEkkKDEdldERpc2tVc2FnZRIbLmRhZW1vbi5HZXREaXNrVXNhZ2VSZXF1ZXN0GhwuZGFlbW9uLkdldERpc2tVc2FnZVJlc3BvbnNlEj0KCFJlYWRGaWxlEhcuZGFlbW9uLlJlYWRGaWxlUmVxdWVzdBoYLmRhZW1vbi5SZWFkRmlsZVJlc3BvbnNlEkAKCVdyaXRlRmlsZRIYLmRhZW1vbi5Xcml0ZUZpbGVSZXF1ZXN0GhkuZGFlbW9uLldyaXRlRmlsZVJlc3BvbnNlEkMKCkRlbGV0ZUZpbGUSGS5kYWVtb24uRGVsZXRlRmlsZVJlcXVlc3QaGi5kYWVtb24uRGVsZXRlRmlsZVJlc3BvbnNlEj0KCE1vdmVGaWxlEhcuZGFlbW9uLk1vdmVGaWxlUmVxdWVzdBoYLmRhZW1vbi5Nb3ZlRmlsZVJlc3BvbnNlEj0KCENvcHlGaWxlEhcuZGFlbW9uLkNvcHlGaWxlUmVxdWVzdBoYLmRhZW1vbi5Db3B5RmlsZVJlc3BvbnNlEkkKDENvbXByZXNzRmlsZRIbLmRhZW1vbi5Db21wcmVzc0ZpbGVSZXF1ZXN0GhwuZGFlbW9uLkNvbXByZXNzRmlsZVJlc3BvbnNlEk8KDkRlY29tcHJlc3NGaWxlEh0uZGFlbW9uLkRlY29tcHJlc3NGaWxlUmVxdWVzdBoeLmRhZW1vbi5EZWNvbXByZXNzRmlsZVJlc3BvbnNlEmQKFUNoYW5nZUZpbGVQZXJtaXNzaW9ucxIkLmRhZW1vbi5DaGFuZ2VGaWxlUGVybWlzc2lvbnNSZXF1ZXN0GiUuZGFlbW9uLkNoYW5nZUZpbGVQZXJtaXNzaW9uc1Jlc3BvbnNlElsKEkdldEZpbGVQZXJtaXNzaW9ucxIhLmRhZW1vbi5HZXRGaWxlUGVybWlzc2lvbnNSZXF1ZXN0GiIuZGFlbW9uLkdldEZpbGVQZXJtaXNzaW9uc1Jlc3BvbnNlEkYKC1NlYXJjaEZpbGVzEhouZGFlbW9uLlNlYXJjaEZpbGVzUmVxdWVzdBobLmRhZW1vbi5TZWFyY2hGaWxlc1Jlc3BvbnNlQh5aHHBhbmVsaXVtL3Byb3RvX2dlbl9nby9kYWVtb25iBnByb3RvMw", [file_common, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message daemon.FileEntry
@@ -166,6 +166,83 @@ export type GetDirectorySizeResponse = Message<"daemon.GetDirectorySizeResponse"
 export const GetDirectorySizeResponseSchema: GenMessage<GetDirectorySizeResponse> = /*@__PURE__*/
   messageDesc(file_daemon_ServerFiles, 6);
 
+/**
+ * @generated from message daemon.GetDiskUsageRequest
+ */
+export type GetDiskUsageRequest = Message<"daemon.GetDiskUsageRequest"> & {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId: string;
+};
+
+/**
+ * Describes the message daemon.GetDiskUsageRequest.
+ * Use `create(GetDiskUsageRequestSchema)` to create a new message.
+ */
+export const GetDiskUsageRequestSchema: GenMessage<GetDiskUsageRequest> = /*@__PURE__*/
+  messageDesc(file_daemon_ServerFiles, 7);
+
+/**
+ * @generated from message daemon.DiskUsageEntry
+ */
+export type DiskUsageEntry = Message<"daemon.DiskUsageEntry"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool is_directory = 2;
+   */
+  isDirectory: boolean;
+
+  /**
+   * bytes allocated on disk, for directories everything below them
+   *
+   * @generated from field: int64 size = 3;
+   */
+  size: bigint;
+};
+
+/**
+ * Describes the message daemon.DiskUsageEntry.
+ * Use `create(DiskUsageEntrySchema)` to create a new message.
+ */
+export const DiskUsageEntrySchema: GenMessage<DiskUsageEntry> = /*@__PURE__*/
+  messageDesc(file_daemon_ServerFiles, 8);
+
+/**
+ * @generated from message daemon.GetDiskUsageResponse
+ */
+export type GetDiskUsageResponse = Message<"daemon.GetDiskUsageResponse"> & {
+  /**
+   * @generated from field: int64 total = 1;
+   */
+  total: bigint;
+
+  /**
+   * largest first
+   *
+   * @generated from field: repeated daemon.DiskUsageEntry entries = 2;
+   */
+  entries: DiskUsageEntry[];
+
+  /**
+   * time of the last full rescan, changes in between are tracked as they happen
+   *
+   * @generated from field: google.protobuf.Timestamp last_scan = 3;
+   */
+  lastScan?: Timestamp;
+};
+
+/**
+ * Describes the message daemon.GetDiskUsageResponse.
+ * Use `create(GetDiskUsageResponseSchema)` to create a new message.
+ */
+export const GetDiskUsageResponseSchema: GenMessage<GetDiskUsageResponse> = /*@__PURE__*/
+  messageDesc(file_daemon_ServerFiles, 9);
+
 /**
  * File operations
  *
@@ -188,7 +265,7 @@ export type ReadFileRequest = Message<"daemon.ReadFileRequest"> & {
  * Use `create(ReadFileRequestSchema)` to create a new message.
  */
 export const ReadFileRequestSchema: GenMessage<ReadFileRequest> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 7);
+  messageDesc(file_daemon_ServerFiles, 10);
 
 /**
  * @generated from message daemon.ReadFileResponse
@@ -210,7 +287,7 @@ export type ReadFileResponse = Message<"daemon.ReadFileResponse"> & {
  * Use `create(ReadFileResponseSchema)` to create a new message.
  */
 export const ReadFileResponseSchema: GenMessage<ReadFileResponse> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 8);
+  messageDesc(file_daemon_ServerFiles, 11);
 
 /**
  * @generated from message daemon.WriteFileRequest
@@ -237,7 +314,7 @@ export type WriteFileRequest = Message<"daemon.WriteFileRequest"> & {
  * Use `create(WriteFileRequestSchema)` to create a new message.
  */
 export const WriteFileRequestSchema: GenMessage<WriteFileRequest> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 9);
+  messageDesc(file_daemon_ServerFiles, 12);
 
 /**
  * @generated from message daemon.WriteFileResponse
@@ -254,7 +331,7 @@ export type WriteFileResponse = Message<"daemon.WriteFileResponse"> & {
  * Use `create(WriteFileResponseSchema)` to create a new message.
  */
 export const WriteFileResponseSchema: GenMessage<WriteFileResponse> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 10);
+  messageDesc(file_daemon_ServerFiles, 13);
 
 /**
  * @generated from message daemon.DeleteFileRequest
@@ -276,7 +353,7 @@ export type DeleteFileRequest = Message<"daemon.DeleteFileRequest"> & {
  * Use `create(DeleteFileRequestSchema)` to create a new message.
  */
 export const DeleteFileRequestSchema: GenMessage<DeleteFileRequest> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 11);
+  messageDesc(file_daemon_ServerFiles, 14);
 
 /**
  * @generated from message daemon.DeleteFileResponse
@@ -293,7 +370,7 @@ export type DeleteFileResponse = Message<"daemon.DeleteFileResponse"> & {
  * Use `create(DeleteFileResponseSchema)` to create a new message.
  */
 export const DeleteFileResponseSchema: GenMessage<DeleteFileResponse> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 12);
+  messageDesc(file_daemon_ServerFiles, 15);
 
 /**
  * Movement operations
@@ -322,7 +399,7 @@ export type MoveFileRequest = Message<"daemon.MoveFileRequest"> & {
  * Use `create(MoveFileRequestSchema)` to create a new message.
  */
 export const MoveFileRequestSchema: GenMessage<MoveFileRequest> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 13);
+  messageDesc(file_daemon_ServerFiles, 16);
 
 /**
  * @generated from message daemon.MoveFileResponse
@@ -339,7 +416,7 @@ export type MoveFileResponse = Message<"daemon.MoveFileResponse"> & {
  * Use `create(MoveFileResponseSchema)` to create a new message.
  */
 export const MoveFileResponseSchema: GenMessage<MoveFileResponse> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 14);
+  messageDesc(file_daemon_ServerFiles, 17);
 
 /**
  * @generated from message daemon.CopyFileRequest
@@ -366,7 +443,7 @@ export type CopyFileRequest = Message<"daemon.CopyFileRequest"> & {
  * Use `create(CopyFileRequestSchema)` to create a new message.
  */
 export const CopyFileRequestSchema: GenMessage<CopyFileRequest> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 15);
+  messageDesc(file_daemon_ServerFiles, 18);
 
 /**
  * @generated from message daemon.CopyFileResponse
@@ -383,7 +460,7 @@ export type CopyFileResponse = Message<"daemon.CopyFileResponse"> & {
  * Use `create(CopyFileResponseSchema)` to create a new message.
  */
 export const CopyFileResponseSchema: GenMessage<CopyFileResponse> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 16);
+  messageDesc(file_daemon_ServerFiles, 19);
 
 /**
  * @generated from message daemon.CompressFileRequest
@@ -415,7 +492,7 @@ export type CompressFileRequest = Message<"daemon.CompressFileRequest"> & {
  * Use `create(CompressFileRequestSchema)` to create a new message.
  */
 export const CompressFileRequestSchema: GenMessage<CompressFileRequest> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 17);
+  messageDesc(file_daemon_ServerFiles, 20);
 
 /**
  * @generated from message daemon.CompressFileResponse
@@ -432,7 +509,7 @@ export type CompressFileResponse = Message<"daemon.CompressFileResponse"> & {
  * Use `create(CompressFileResponseSchema)` to create a new message.
  */
 export const CompressFileResponseSchema: GenMessage<CompressFileResponse> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 18);
+  messageDesc(file_daemon_ServerFiles, 21);
 
 /**
  * @generated from message daemon.DecompressFileRequest
@@ -459,7 +536,7 @@ export type DecompressFileRequest = Message<"daemon.DecompressFileRequest"> & {
  * Use `create(DecompressFileRequestSchema)` to create a new message.
  */
 export const DecompressFileRequestSchema: GenMessage<DecompressFileRequest> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 19);
+  messageDesc(file_daemon_ServerFiles, 22);
 
 /**
  * @generated from message daemon.DecompressFileResponse
@@ -476,7 +553,7 @@ export type DecompressFileResponse = Message<"daemon.DecompressFileResponse"> &
  * Use `create(DecompressFileResponseSchema)` to create a new message.
  */
 export const DecompressFileResponseSchema: GenMessage<DecompressFileResponse> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 20);
+  messageDesc(file_daemon_ServerFiles, 23);
 
 /**
  * File permissions operations
@@ -505,7 +582,7 @@ export type ChangeFilePermissionsRequest = Message<"daemon.ChangeFilePermissions
  * Use `create(ChangeFilePermissionsRequestSchema)` to create a new message.
  */
 export const ChangeFilePermissionsRequestSchema: GenMessage<ChangeFilePermissionsRequest> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 21);
+  messageDesc(file_daemon_ServerFiles, 24);
 
 /**
  * @generated from message daemon.ChangeFilePermissionsResponse
@@ -522,7 +599,7 @@ export type ChangeFilePermissionsResponse = Message<"daemon.ChangeFilePermission
  * Use `create(ChangeFilePermissionsResponseSchema)` to create a new message.
  */
 export const ChangeFilePermissionsResponseSchema: GenMessage<ChangeFilePermissionsResponse> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 22);
+  messageDesc(file_daemon_ServerFiles, 25);
 
 /**
  * @generated from message daemon.GetFilePermissionsRequest
@@ -544,7 +621,7 @@ export type GetFilePermissionsRequest = Message<"daemon.GetFilePermissionsReques
  * Use `create(GetFilePermissionsRequestSchema)` to create a new message.
  */
 export const GetFilePermissionsRequestSchema: GenMessage<GetFilePermissionsRequest> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 23);
+  messageDesc(file_daemon_ServerFiles, 26);
 
 /**
  * @generated from message daemon.GetFilePermissionsResponse
@@ -561,7 +638,7 @@ export type GetFilePermissionsResponse = Message<"daemon.GetFilePermissionsRespo
  * Use `create(GetFilePermissionsResponseSchema)` to create a new message.
  */
 export const GetFilePermissionsResponseSchema: GenMessage<GetFilePermissionsResponse> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 24);
+  messageDesc(file_daemon_ServerFiles, 27);
 
 /**
  * @generated from message daemon.SearchFilesRequest
@@ -588,7 +665,7 @@ export type SearchFilesRequest = Message<"daemon.SearchFilesRequest"> & {
  * Use `create(SearchFilesRequestSchema)` to create a new message.
  */
 export const SearchFilesRequestSchema: GenMessage<SearchFilesRequest> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 25);
+  messageDesc(file_daemon_ServerFiles, 28);
 
 /**
  * @generated from message daemon.SearchFilesResponse
@@ -605,7 +682,7 @@ export type SearchFilesResponse = Message<"daemon.SearchFilesResponse"> & {
  * Use `create(SearchFilesResponseSchema)` to create a new message.
  */
 export const SearchFilesResponseSchema: GenMessage<SearchFilesResponse> = /*@__PURE__*/
-  messageDesc(file_daemon_ServerFiles, 26);
+  messageDesc(file_daemon_ServerFiles, 29);
 
 /**
  * Compression operations
@@ -672,6 +749,14 @@ export const ServerFilesService: GenService<{
     input: typeof GetDirectorySizeRequestSchema;
     output: typeof GetDirectorySizeResponseSchema;
   },
+  /**
+   * @generated from rpc daemon.ServerFilesService.GetDiskUsage
+   */
+  getDiskUsage: {
+    methodKind: "unary";
+    input: typeof GetDiskUsageRequestSchema;
+    output: typeof GetDiskUsageResponseSchema;
+  },
   /**
    * File operations
    *
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file daemon/Server.proto.
  */
 export const file_daemon_Server: GenFile = /*@__PURE__*/
-  fileDesc("ChNkYWVtb24vU2VydmVyLnByb3RvEgZkYWVtb24inQIKDFNlcnZlclN0YXR1cxIoCgZzdGF0dXMYASABKA4yGC5kYWVtb24uU2VydmVyU3RhdHVzVHlwZRI4Cg90aW1lc3RhbXBfc3RhcnQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESNgoNdGltZXN0YW1wX2VuZBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBARI4Cg5vZmZsaW5lX3JlYXNvbhgEIAEoDjIbLmRhZW1vbi5TZXJ2ZXJPZmZsaW5lUmVhc29uSAKIAQFCEgoQX3RpbWVzdGFtcF9zdGFydEIQCg5fdGltZXN0YW1wX2VuZEIRCg9fb2ZmbGluZV9yZWFzb24iTAoSUG93ZXJBY3Rpb25NZXNzYWdlEhEKCXNlcnZlcl9pZBgBIAEoCRIjCgZhY3Rpb24YAiABKA4yEy5kYWVtb24uUG93ZXJBY3Rpb24iPAoUUmVzb3VyY2VVc2FnZU1lc3NhZ2USJAoFdXNhZ2UYASABKAsyFS5jb21tb24uUmVzb3VyY2VVc2FnZSrWAQoQU2VydmVyU3RhdHVzVHlwZRIeChpTRVJWRVJfU1RBVFVTX1RZUEVfVU5LTk9XThAAEh8KG1NFUlZFUl9TVEFUVVNfVFlQRV9TVEFSVElORxABEh0KGVNFUlZFUl9TVEFUVVNfVFlQRV9PTkxJTkUQAhIfChtTRVJWRVJfU1RBVFVTX1RZUEVfU1RPUFBJTkcQAxIeChpTRVJWRVJfU1RBVFVTX1RZUEVfT0ZGTElORRAEEiEKHVNFUlZFUl9TVEFUVVNfVFlQRV9JTlNUQUxMSU5HEAUqwQEKE1NlcnZlck9mZmxpbmVSZWFzb24SIQodU0VSVkVSX09GRkxJTkVfUkVBU09OX1VOS05PV04QABIhCh1TRVJWRVJfT0ZGTElORV9SRUFTT05fQ1JFQVRFRBABEiEKHVNFUlZFUl9PRkZMSU5FX1JFQVNPTl9TVE9QUEVEEAISIAocU0VSVkVSX09GRkxJTkVfUkVBU09OX0tJTExFRBADEh8KG1NFUlZFUl9PRkZMSU5FX1JFQVNPTl9FUlJPUhAEKosBCgtQb3dlckFjdGlvbhIcChhQT1dFUl9BQ1RJT05fVU5TUEVDSUZJRUQQABIWChJQT1dFUl9BQ1RJT05fU1RBUlQQARIYChRQT1dFUl9BQ1RJT05fUkVTVEFSVBACEhUKEVBPV0VSX0FDVElPTl9TVE9QEAMSFQoRUE9XRVJfQUNUSU9OX0tJTEwQBDL1AwoNU2VydmVyU2VydmljZRI7CgdDb25zb2xlEhcuY29tbW9uLlNpbXBsZUlETWVzc2FnZRoVLmNvbW1vbi5TaW1wbGVNZXNzYWdlMAESMgoOQ29uc29sZUNvbW1hbmQSES5jb21tb24uSURNZXNzYWdlGg0uY29tbW9uLkVtcHR5EjwKCFRlcm1pbmFsEhcuY29tbW9uLlNpbXBsZUlETWVzc2FnZRoVLmNvbW1vbi5TaW1wbGVNZXNzYWdlMAESMwoPVGVybWluYWxDb21tYW5kEhEuY29tbW9uLklETWVzc2FnZRoNLmNvbW1vbi5FbXB0eRI3CgZTdGF0dXMSFy5jb21tb24uU2ltcGxlSURNZXNzYWdlGhQuZGFlbW9uLlNlcnZlclN0YXR1cxJICg1SZXNvdXJjZVVzYWdlEhcuY29tbW9uLlNpbXBsZUlETWVzc2FnZRocLmRhZW1vbi5SZXNvdXJjZVVzYWdlTWVzc2FnZTABEkEKC1Bvd2VyQWN0aW9uEhouZGFlbW9uLlBvd2VyQWN0aW9uTWVzc2FnZRoWLmNvbW1vbi5TdWNjZXNzTWVzc2FnZRI6CgdJbnN0YWxsEhcuY29tbW9uLlNpbXBsZUlETWVzc2FnZRoWLmNvbW1vbi5TdWNjZXNzTWVzc2FnZUIeWhxwYW5lbGl1bS9wcm90b19nZW5fZ28vZGFlbW9uYgZwcm90bzM", [file_common, file_google_protobuf_timestamp]);
+  fileDesc("ChNkYWVtb24vU2VydmVyLnByb3RvEgZkYWVtb24iwwIKDFNlcnZlclN0YXR1cxIoCgZzdGF0dXMYASABKA4yGC5kYWVtb24uU2VydmVyU3RhdHVzVHlwZRI4Cg90aW1lc3RhbXBfc3RhcnQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESNgoNdGltZXN0YW1wX2VuZBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBARI4Cg5vZmZsaW5lX3JlYXNvbhgEIAEoDjIbLmRhZW1vbi5TZXJ2ZXJPZmZsaW5lUmVhc29uSAKIAQESFgoJZXhpdF9jb2RlGAUgASgFSAOIAQFCEgoQX3RpbWVzdGFtcF9zdGFydEIQCg5fdGltZXN0YW1wX2VuZEIRCg9fb2ZmbGluZV9yZWFzb25CDAoKX2V4aXRfY29kZSJoChFTZXJ2ZXJTdGF0dXNFdmVudBIkCgZzdGF0dXMYASABKAsyFC5kYWVtb24uU2VydmVyU3RhdHVzEi0KCXRpbWVzdGFtcBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiTAoSUG93ZXJBY3Rpb25NZXNzYWdlEhEKCXNlcnZlcl9pZBgBIAEoCRIjCgZhY3Rpb24YAiABKA4yEy5kYWVtb24uUG93ZXJBY3Rpb24ifgoUUmVzb3VyY2VVc2FnZU1lc3NhZ2USJAoFdXNhZ2UYASABKAsyFS5jb21tb24uUmVzb3VyY2VVc2FnZRIyCgl0aW1lc3RhbXAYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQFCDAoKX3RpbWVzdGFtcCLDAQoZR2V0UmVzb3VyY2VIaXN0b3J5UmVxdWVzdBIRCglzZXJ2ZXJfaWQYASABKAkSKAoEZnJvbRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKwoCdG8YAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESNQoKcmVzb2x1dGlvbhgEIAEoDjIhLmRhZW1vbi5SZXNvdXJjZUhpc3RvcnlSZXNvbHV0aW9uQgUKA190byJ3Cg9SZXNvdXJjZUhpc3RvcnkSNQoKcmVzb2x1dGlvbhgBIAEoDjIhLmRhZW1vbi5SZXNvdXJjZUhpc3RvcnlSZXNvbHV0aW9uEi0KB3NhbXBsZXMYAiADKAsyHC5kYWVtb24uUmVzb3VyY2VVc2FnZU1lc3NhZ2UiWAoOSW5zdGFsbFJlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEiEKBG1vZGUYAiABKA4yEy5kYWVtb24uSW5zdGFsbE1vZGUSEAoIcHJlc2VydmUYAyADKAki0QEKFEluc3RhbGxQcm9ncmVzc0V2ZW50Ei0KCXRpbWVzdGFtcBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLwoKaW1hZ2VfcHVsbBgCIAEoCzIZLmRhZW1vbi5JbWFnZVB1bGxQcm9ncmVzc0gAEicKBm91dHB1dBgDIAEoCzIVLmRhZW1vbi5JbnN0YWxsT3V0cHV0SAASJwoGcmVzdWx0GAQgASgLMhUuZGFlbW9uLkluc3RhbGxSZXN1bHRIAEIHCgVldmVudCJhChFJbWFnZVB1bGxQcm9ncmVzcxINCgVpbWFnZRgBIAEoCRINCgVsYXllchgCIAEoCRIOCgZzdGF0dXMYAyABKAkSDwoHY3VycmVudBgEIAEoAxINCgV0b3RhbBgFIAEoAyIdCg1JbnN0YWxsT3V0cHV0EgwKBHRleHQYASABKAkiVQoNSW5zdGFsbFJlc3VsdBIPCgdzdWNjZXNzGAEgASgIEhYKCWV4aXRfY29kZRgCIAEoBUgAiAEBEg0KBWVycm9yGAMgASgJQgwKCl9leGl0X2NvZGUi8QIKCkluc3RhbGxSdW4SCgoCaWQYASABKA0SCwoDYmlkGAIgASgJEhkKEWJsdWVwcmludF92ZXJzaW9uGAMgASgNEhoKEnNldHVwX2RvY2tlcl9pbWFnZRgEIAEoCRIUCgxkb2NrZXJfaW1hZ2UYBSABKAkSMwoPdGltZXN0YW1wX3N0YXJ0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI2Cg10aW1lc3RhbXBfZW5kGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEg8KB3N1Y2Nlc3MYCCABKAgSFgoJZXhpdF9jb2RlGAkgASgFSAGIAQESDQoFZXJyb3IYCiABKAkSFQoNbG9nX3RydW5jYXRlZBgLIAEoCBIhCgRtb2RlGAwgASgOMhMuZGFlbW9uLkluc3RhbGxNb2RlQhAKDl90aW1lc3RhbXBfZW5kQgwKCl9leGl0X2NvZGUiOwoXTGlzdEluc3RhbGxSdW5zUmVzcG9uc2USIAoEcnVucxgBIAMoCzISLmRhZW1vbi5JbnN0YWxsUnVuIjkKFEdldEluc3RhbGxMb2dSZXF1ZXN0EhEKCXNlcnZlcl9pZBgBIAEoCRIOCgZydW5faWQYAiABKA0iiwEKCkV1bGFTdGF0dXMSEAoIcmVxdWlyZWQYASABKAgSEAoIYWNjZXB0ZWQYAiABKAgSEwoLYWNjZXB0ZWRfYnkYAyABKAkSNAoLYWNjZXB0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQFCDgoMX2FjY2VwdGVkX2F0IloKDFN0b3JhZ2VVc2FnZRISCgp1c2VkX2J5dGVzGAEgASgDEhMKC2xpbWl0X2J5dGVzGAIgASgDEhAKCGV4Y2VlZGVkGAMgASgIEg8KB2JhY2tlbmQYBCABKAkipAEKDkNvbnNvbGVMb2dGaWxlEgwKBG5hbWUYASABKAkSMwoPdGltZXN0YW1wX3N0YXJ0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIxCg10aW1lc3RhbXBfZW5kGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIMCgRzaXplGAQgASgDEg4KBmFjdGl2ZRgFIAEoCCJAChdMaXN0Q29uc29sZUxvZ3NSZXNwb25zZRIlCgVmaWxlcxgBIAMoCzIWLmRhZW1vbi5Db25zb2xlTG9nRmlsZSK4AQoYU2VhcmNoQ29uc29sZUxvZ3NSZXF1ZXN0EhEKCXNlcnZlcl9pZBgBIAEoCRIoCgRmcm9tGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIrCgJ0bxgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARINCgVxdWVyeRgEIAEoCRINCgVyZWdleBgFIAEoCBINCgVsaW1pdBgGIAEoDUIFCgNfdG8iWwoOQ29uc29sZUxvZ0xpbmUSLQoJdGltZXN0YW1wGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIMCgR0ZXh0GAIgASgJEgwKBGZpbGUYAyABKAkiNwoUR2V0Q29uc29sZUxvZ1JlcXVlc3QSEQoJc2VydmVyX2lkGAEgASgJEgwKBG5hbWUYAiABKAkiZwoIUHR5SW5wdXQSHwoEb3BlbhgBIAEoCzIPLmRhZW1vbi5QdHlPcGVuSAASDgoEZGF0YRgCIAEoDEgAEiEKBnJlc2l6ZRgDIAEoCzIPLmRhZW1vbi5QdHlTaXplSABCBwoFaW5wdXQiYwoHUHR5T3BlbhIRCglzZXJ2ZXJfaWQYASABKAkSHQoEc2l6ZRgCIAEoCzIPLmRhZW1vbi5QdHlTaXplEhcKCnNlc3Npb25faWQYAyABKAlIAIgBAUINCgtfc2Vzc2lvbl9pZCIlCgdQdHlTaXplEgwKBGNvbHMYASABKA0SDAoEcm93cxgCIAEoDSJyCglQdHlPdXRwdXQSDgoEZGF0YRgBIAEoDEgAEh8KBGV4aXQYAiABKAsyDy5kYWVtb24uUHR5RXhpdEgAEioKB3Nlc3Npb24YAyABKAsyFy5kYWVtb24uVGVybWluYWxTZXNzaW9uSABCCAoGb3V0cHV0Ii8KB1B0eUV4aXQSFgoJZXhpdF9jb2RlGAEgASgFSACIAQFCDAoKX2V4aXRfY29kZSLDAQoPVGVybWluYWxTZXNzaW9uEgoKAmlkGAEgASgJEhEKCXNlcnZlcl9pZBgCIAEoCRIQCghvd25lcl9pZBgDIAEoCRINCgVzaGVsbBgEIAEoCRIrCgdjcmVhdGVkGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIxCg1sYXN0X2FjdGl2aXR5GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghhdHRhY2hlZBgHIAEoDSJJChxMaXN0VGVybWluYWxTZXNzaW9uc1Jlc3BvbnNlEikKCHNlc3Npb25zGAEgAygLMhcuZGFlbW9uLlRlcm1pbmFsU2Vzc2lvbirWAQoQU2VydmVyU3RhdHVzVHlwZRIeChpTRVJWRVJfU1RBVFVTX1RZUEVfVU5LTk9XThAAEh8KG1NFUlZFUl9TVEFUVVNfVFlQRV9TVEFSVElORxABEh0KGVNFUlZFUl9TVEFUVVNfVFlQRV9PTkxJTkUQAhIfChtTRVJWRVJfU1RBVFVTX1RZUEVfU1RPUFBJTkcQAxIeChpTRVJWRVJfU1RBVFVTX1RZUEVfT0ZGTElORRAEEiEKHVNFUlZFUl9TVEFUVVNfVFlQRV9JTlNUQUxMSU5HEAUq5wEKE1NlcnZlck9mZmxpbmVSZWFzb24SIQodU0VSVkVSX09GRkxJTkVfUkVBU09OX1VOS05PV04QABIhCh1TRVJWRVJfT0ZGTElORV9SRUFTT05fQ1JFQVRFRBABEiEKHVNFUlZFUl9PRkZMSU5FX1JFQVNPTl9TVE9QUEVEEAISIAocU0VSVkVSX09GRkxJTkVfUkVBU09OX0tJTExFRBADEh8KG1NFUlZFUl9PRkZMSU5FX1JFQVNPTl9FUlJPUhAEEiQKIFNFUlZFUl9PRkZMSU5FX1JFQVNPTl9URVJNSU5BVEVEEAUqiwEKC1Bvd2VyQWN0aW9uEhwKGFBPV0VSX0FDVElPTl9VTlNQRUNJRklFRBAAEhYKElBPV0VSX0FDVElPTl9TVEFSVBABEhgKFFBPV0VSX0FDVElPTl9SRVNUQVJUEAISFQoRUE9XRVJfQUNUSU9OX1NUT1AQAxIVChFQT1dFUl9BQ1RJT05fS0lMTBAEKpsBChlSZXNvdXJjZUhpc3RvcnlSZXNvbHV0aW9uEiQKIFJFU09VUkNFX0hJU1RPUllfUkVTT0xVVElPTl9BVVRPEAASLAooUkVTT1VSQ0VfSElTVE9SWV9SRVNPTFVUSU9OX0ZJVkVfU0VDT05EUxABEioKJlJFU09VUkNFX0hJU1RPUllfUkVTT0xVVElPTl9PTkVfTUlOVVRFEAIqZwoLSW5zdGFsbE1vZGUSGwoXSU5TVEFMTF9NT0RFX0tFRVBfRklMRVMQABIWChJJTlNUQUxMX01PREVfQ0xFQU4QARIjCh9JTlNUQUxMX01PREVfUkVDUkVBVEVfQ09OVEFJTkVSEAIyoAsKDVNlcnZlclNlcnZpY2USOwoHQ29uc29sZRIXLmNvbW1vbi5TaW1wbGVJRE1lc3NhZ2UaFS5jb21tb24uU2ltcGxlTWVzc2FnZTABEjIKDkNvbnNvbGVDb21tYW5kEhEuY29tbW9uLklETWVzc2FnZRoNLmNvbW1vbi5FbXB0eRJLCg9MaXN0Q29uc29sZUxvZ3MSFy5jb21tb24uU2ltcGxlSURNZXNzYWdlGh8uZGFlbW9uLkxpc3RDb25zb2xlTG9nc1Jlc3BvbnNlEk8KEVNlYXJjaENvbnNvbGVMb2dzEiAuZGFlbW9uLlNlYXJjaENvbnNvbGVMb2dzUmVxdWVzdBoWLmRhZW1vbi5Db25zb2xlTG9nTGluZTABEkYKDUdldENvbnNvbGVMb2cSHC5kYWVtb24uR2V0Q29uc29sZUxvZ1JlcXVlc3QaFS5jb21tb24uU2ltcGxlTWVzc2FnZTABEjwKCFRlcm1pbmFsEhcuY29tbW9uLlNpbXBsZUlETWVzc2FnZRoVLmNvbW1vbi5TaW1wbGVNZXNzYWdlMAESMwoPVGVybWluYWxDb21tYW5kEhEuY29tbW9uLklETWVzc2FnZRoNLmNvbW1vbi5FbXB0eRI2CgtQdHlUZXJtaW5hbBIQLmRhZW1vbi5QdHlJbnB1dBoRLmRhZW1vbi5QdHlPdXRwdXQoATABElUKFExpc3RUZXJtaW5hbFNlc3Npb25zEhcuY29tbW9uLlNpbXBsZUlETWVzc2FnZRokLmRhZW1vbi5MaXN0VGVybWluYWxTZXNzaW9uc1Jlc3BvbnNlEjcKBlN0YXR1cxIXLmNvbW1vbi5TaW1wbGVJRE1lc3NhZ2UaFC5kYWVtb24uU2VydmVyU3RhdHVzEkMKC1dhdGNoU3RhdHVzEhcuY29tbW9uLlNpbXBsZUlETWVzc2FnZRoZLmRhZW1vbi5TZXJ2ZXJTdGF0dXNFdmVudDABEkgKDVJlc291cmNlVXNhZ2USFy5jb21tb24uU2ltcGxlSURNZXNzYWdlGhwuZGFlbW9uLlJlc291cmNlVXNhZ2VNZXNzYWdlMAESUAoSR2V0UmVzb3VyY2VIaXN0b3J5EiEuZGFlbW9uLkdldFJlc291cmNlSGlzdG9yeVJlcXVlc3QaFy5kYWVtb24uUmVzb3VyY2VIaXN0b3J5EkEKC1Bvd2VyQWN0aW9uEhouZGFlbW9uLlBvd2VyQWN0aW9uTWVzc2FnZRoWLmNvbW1vbi5TdWNjZXNzTWVzc2FnZRI5CgdJbnN0YWxsEhYuZGFlbW9uLkluc3RhbGxSZXF1ZXN0GhYuY29tbW9uLlN1Y2Nlc3NNZXNzYWdlEkoKD0luc3RhbGxQcm9ncmVzcxIXLmNvbW1vbi5TaW1wbGVJRE1lc3NhZ2UaHC5kYWVtb24uSW5zdGFsbFByb2dyZXNzRXZlbnQwARJLCg9MaXN0SW5zdGFsbFJ1bnMSFy5jb21tb24uU2ltcGxlSURNZXNzYWdlGh8uZGFlbW9uLkxpc3RJbnN0YWxsUnVuc1Jlc3BvbnNlEkYKDUdldEluc3RhbGxMb2cSHC5kYWVtb24uR2V0SW5zdGFsbExvZ1JlcXVlc3QaFS5jb21tb24uU2ltcGxlTWVzc2FnZTABEjwKDUdldEV1bGFTdGF0dXMSFy5jb21tb24uU2ltcGxlSURNZXNzYWdlGhIuZGFlbW9uLkV1bGFTdGF0dXMSPQoKQWNjZXB0RXVsYRIXLmNvbW1vbi5TaW1wbGVJRE1lc3NhZ2UaFi5jb21tb24uU3VjY2Vzc01lc3NhZ2USQAoPR2V0U3RvcmFnZVVzYWdlEhcuY29tbW9uLlNpbXBsZUlETWVzc2FnZRoULmRhZW1vbi5TdG9yYWdlVXNhZ2VCHloccGFuZWxpdW0vcHJvdG9fZ2VuX2dvL2RhZW1vbmIGcHJvdG8z", [file_common, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message daemon.ServerStatus
@@ -39,53 +39,883 @@ export type ServerStatus = Message<"daemon.ServerStatus"> & {
    * @generated from field: optional daemon.ServerOfflineReason offline_reason = 4;
    */
   offlineReason?: ServerOfflineReason;
+
+  /**
+   * set when the server exited on its own with a non-zero exit code
+   *
+   * @generated from field: optional int32 exit_code = 5;
+   */
+  exitCode?: number;
+};
+
+/**
+ * Describes the message daemon.ServerStatus.
+ * Use `create(ServerStatusSchema)` to create a new message.
+ */
+export const ServerStatusSchema: GenMessage<ServerStatus> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 0);
+
+/**
+ * @generated from message daemon.ServerStatusEvent
+ */
+export type ServerStatusEvent = Message<"daemon.ServerStatusEvent"> & {
+  /**
+   * @generated from field: daemon.ServerStatus status = 1;
+   */
+  status?: ServerStatus;
+
+  /**
+   * when the transition happened
+   *
+   * @generated from field: google.protobuf.Timestamp timestamp = 2;
+   */
+  timestamp?: Timestamp;
+};
+
+/**
+ * Describes the message daemon.ServerStatusEvent.
+ * Use `create(ServerStatusEventSchema)` to create a new message.
+ */
+export const ServerStatusEventSchema: GenMessage<ServerStatusEvent> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 1);
+
+/**
+ * @generated from message daemon.PowerActionMessage
+ */
+export type PowerActionMessage = Message<"daemon.PowerActionMessage"> & {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: daemon.PowerAction action = 2;
+   */
+  action: PowerAction;
+};
+
+/**
+ * Describes the message daemon.PowerActionMessage.
+ * Use `create(PowerActionMessageSchema)` to create a new message.
+ */
+export const PowerActionMessageSchema: GenMessage<PowerActionMessage> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 2);
+
+/**
+ * @generated from message daemon.ResourceUsageMessage
+ */
+export type ResourceUsageMessage = Message<"daemon.ResourceUsageMessage"> & {
+  /**
+   * @generated from field: common.ResourceUsage usage = 1;
+   */
+  usage?: ResourceUsage;
+
+  /**
+   * when the sample was taken, for history the start of the averaged interval
+   *
+   * @generated from field: optional google.protobuf.Timestamp timestamp = 2;
+   */
+  timestamp?: Timestamp;
+};
+
+/**
+ * Describes the message daemon.ResourceUsageMessage.
+ * Use `create(ResourceUsageMessageSchema)` to create a new message.
+ */
+export const ResourceUsageMessageSchema: GenMessage<ResourceUsageMessage> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 3);
+
+/**
+ * @generated from message daemon.GetResourceHistoryRequest
+ */
+export type GetResourceHistoryRequest = Message<"daemon.GetResourceHistoryRequest"> & {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp from = 2;
+   */
+  from?: Timestamp;
+
+  /**
+   * now if not set
+   *
+   * @generated from field: optional google.protobuf.Timestamp to = 3;
+   */
+  to?: Timestamp;
+
+  /**
+   * @generated from field: daemon.ResourceHistoryResolution resolution = 4;
+   */
+  resolution: ResourceHistoryResolution;
+};
+
+/**
+ * Describes the message daemon.GetResourceHistoryRequest.
+ * Use `create(GetResourceHistoryRequestSchema)` to create a new message.
+ */
+export const GetResourceHistoryRequestSchema: GenMessage<GetResourceHistoryRequest> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 4);
+
+/**
+ * @generated from message daemon.ResourceHistory
+ */
+export type ResourceHistory = Message<"daemon.ResourceHistory"> & {
+  /**
+   * @generated from field: daemon.ResourceHistoryResolution resolution = 1;
+   */
+  resolution: ResourceHistoryResolution;
+
+  /**
+   * oldest first, intervals without samples, e.g. while the server was offline, are left out
+   *
+   * @generated from field: repeated daemon.ResourceUsageMessage samples = 2;
+   */
+  samples: ResourceUsageMessage[];
+};
+
+/**
+ * Describes the message daemon.ResourceHistory.
+ * Use `create(ResourceHistorySchema)` to create a new message.
+ */
+export const ResourceHistorySchema: GenMessage<ResourceHistory> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 5);
+
+/**
+ * @generated from message daemon.InstallRequest
+ */
+export type InstallRequest = Message<"daemon.InstallRequest"> & {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: daemon.InstallMode mode = 2;
+   */
+  mode: InstallMode;
+
+  /**
+   * paths relative to the server root kept by a clean install
+   *
+   * @generated from field: repeated string preserve = 3;
+   */
+  preserve: string[];
+};
+
+/**
+ * Describes the message daemon.InstallRequest.
+ * Use `create(InstallRequestSchema)` to create a new message.
+ */
+export const InstallRequestSchema: GenMessage<InstallRequest> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 6);
+
+/**
+ * @generated from message daemon.InstallProgressEvent
+ */
+export type InstallProgressEvent = Message<"daemon.InstallProgressEvent"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 1;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * @generated from oneof daemon.InstallProgressEvent.event
+   */
+  event: {
+    /**
+     * @generated from field: daemon.ImagePullProgress image_pull = 2;
+     */
+    value: ImagePullProgress;
+    case: "imagePull";
+  } | {
+    /**
+     * @generated from field: daemon.InstallOutput output = 3;
+     */
+    value: InstallOutput;
+    case: "output";
+  } | {
+    /**
+     * always the last event of an install
+     *
+     * @generated from field: daemon.InstallResult result = 4;
+     */
+    value: InstallResult;
+    case: "result";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message daemon.InstallProgressEvent.
+ * Use `create(InstallProgressEventSchema)` to create a new message.
+ */
+export const InstallProgressEventSchema: GenMessage<InstallProgressEvent> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 7);
+
+/**
+ * @generated from message daemon.ImagePullProgress
+ */
+export type ImagePullProgress = Message<"daemon.ImagePullProgress"> & {
+  /**
+   * @generated from field: string image = 1;
+   */
+  image: string;
+
+  /**
+   * layer ID, empty for messages about the whole image
+   *
+   * @generated from field: string layer = 2;
+   */
+  layer: string;
+
+  /**
+   * as reported by docker, e.g. "Downloading", "Extracting" or "Pull complete"
+   *
+   * @generated from field: string status = 3;
+   */
+  status: string;
+
+  /**
+   * bytes
+   *
+   * @generated from field: int64 current = 4;
+   */
+  current: bigint;
+
+  /**
+   * bytes, 0 if unknown
+   *
+   * @generated from field: int64 total = 5;
+   */
+  total: bigint;
+};
+
+/**
+ * Describes the message daemon.ImagePullProgress.
+ * Use `create(ImagePullProgressSchema)` to create a new message.
+ */
+export const ImagePullProgressSchema: GenMessage<ImagePullProgress> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 8);
+
+/**
+ * @generated from message daemon.InstallOutput
+ */
+export type InstallOutput = Message<"daemon.InstallOutput"> & {
+  /**
+   * a line of setup script stdout or stderr
+   *
+   * @generated from field: string text = 1;
+   */
+  text: string;
+};
+
+/**
+ * Describes the message daemon.InstallOutput.
+ * Use `create(InstallOutputSchema)` to create a new message.
+ */
+export const InstallOutputSchema: GenMessage<InstallOutput> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 9);
+
+/**
+ * @generated from message daemon.InstallResult
+ */
+export type InstallResult = Message<"daemon.InstallResult"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * exit code of the setup script, unset if the install failed before it ran
+   *
+   * @generated from field: optional int32 exit_code = 2;
+   */
+  exitCode?: number;
+
+  /**
+   * @generated from field: string error = 3;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message daemon.InstallResult.
+ * Use `create(InstallResultSchema)` to create a new message.
+ */
+export const InstallResultSchema: GenMessage<InstallResult> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 10);
+
+/**
+ * @generated from message daemon.InstallRun
+ */
+export type InstallRun = Message<"daemon.InstallRun"> & {
+  /**
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string bid = 2;
+   */
+  bid: string;
+
+  /**
+   * @generated from field: uint32 blueprint_version = 3;
+   */
+  blueprintVersion: number;
+
+  /**
+   * @generated from field: string setup_docker_image = 4;
+   */
+  setupDockerImage: string;
+
+  /**
+   * @generated from field: string docker_image = 5;
+   */
+  dockerImage: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp_start = 6;
+   */
+  timestampStart?: Timestamp;
+
+  /**
+   * unset while the install is running
+   *
+   * @generated from field: optional google.protobuf.Timestamp timestamp_end = 7;
+   */
+  timestampEnd?: Timestamp;
+
+  /**
+   * @generated from field: bool success = 8;
+   */
+  success: boolean;
+
+  /**
+   * exit code of the setup script, unset if it did not run to completion
+   *
+   * @generated from field: optional int32 exit_code = 9;
+   */
+  exitCode?: number;
+
+  /**
+   * @generated from field: string error = 10;
+   */
+  error: string;
+
+  /**
+   * the output exceeded the maximum log size, the rest was dropped
+   *
+   * @generated from field: bool log_truncated = 11;
+   */
+  logTruncated: boolean;
+
+  /**
+   * @generated from field: daemon.InstallMode mode = 12;
+   */
+  mode: InstallMode;
+};
+
+/**
+ * Describes the message daemon.InstallRun.
+ * Use `create(InstallRunSchema)` to create a new message.
+ */
+export const InstallRunSchema: GenMessage<InstallRun> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 11);
+
+/**
+ * @generated from message daemon.ListInstallRunsResponse
+ */
+export type ListInstallRunsResponse = Message<"daemon.ListInstallRunsResponse"> & {
+  /**
+   * newest first
+   *
+   * @generated from field: repeated daemon.InstallRun runs = 1;
+   */
+  runs: InstallRun[];
+};
+
+/**
+ * Describes the message daemon.ListInstallRunsResponse.
+ * Use `create(ListInstallRunsResponseSchema)` to create a new message.
+ */
+export const ListInstallRunsResponseSchema: GenMessage<ListInstallRunsResponse> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 12);
+
+/**
+ * @generated from message daemon.GetInstallLogRequest
+ */
+export type GetInstallLogRequest = Message<"daemon.GetInstallLogRequest"> & {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: uint32 run_id = 2;
+   */
+  runId: number;
+};
+
+/**
+ * Describes the message daemon.GetInstallLogRequest.
+ * Use `create(GetInstallLogRequestSchema)` to create a new message.
+ */
+export const GetInstallLogRequestSchema: GenMessage<GetInstallLogRequest> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 13);
+
+/**
+ * @generated from message daemon.EulaStatus
+ */
+export type EulaStatus = Message<"daemon.EulaStatus"> & {
+  /**
+   * whether the blueprint has the eula flag
+   *
+   * @generated from field: bool required = 1;
+   */
+  required: boolean;
+
+  /**
+   * @generated from field: bool accepted = 2;
+   */
+  accepted: boolean;
+
+  /**
+   * user ID of the owner who accepted
+   *
+   * @generated from field: string accepted_by = 3;
+   */
+  acceptedBy: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp accepted_at = 4;
+   */
+  acceptedAt?: Timestamp;
+};
+
+/**
+ * Describes the message daemon.EulaStatus.
+ * Use `create(EulaStatusSchema)` to create a new message.
+ */
+export const EulaStatusSchema: GenMessage<EulaStatus> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 14);
+
+/**
+ * @generated from message daemon.StorageUsage
+ */
+export type StorageUsage = Message<"daemon.StorageUsage"> & {
+  /**
+   * @generated from field: int64 used_bytes = 1;
+   */
+  usedBytes: bigint;
+
+  /**
+   * 0 if the server has no storage limit
+   *
+   * @generated from field: int64 limit_bytes = 2;
+   */
+  limitBytes: bigint;
+
+  /**
+   * @generated from field: bool exceeded = 3;
+   */
+  exceeded: boolean;
+
+  /**
+   * project_quota or watcher, the watcher stops servers above their limit on its next check
+   *
+   * @generated from field: string backend = 4;
+   */
+  backend: string;
+};
+
+/**
+ * Describes the message daemon.StorageUsage.
+ * Use `create(StorageUsageSchema)` to create a new message.
+ */
+export const StorageUsageSchema: GenMessage<StorageUsage> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 15);
+
+/**
+ * @generated from message daemon.ConsoleLogFile
+ */
+export type ConsoleLogFile = Message<"daemon.ConsoleLogFile"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp_start = 2;
+   */
+  timestampStart?: Timestamp;
+
+  /**
+   * last write
+   *
+   * @generated from field: google.protobuf.Timestamp timestamp_end = 3;
+   */
+  timestampEnd?: Timestamp;
+
+  /**
+   * bytes on disk, archived files are compressed
+   *
+   * @generated from field: int64 size = 4;
+   */
+  size: bigint;
+
+  /**
+   * still written to
+   *
+   * @generated from field: bool active = 5;
+   */
+  active: boolean;
+};
+
+/**
+ * Describes the message daemon.ConsoleLogFile.
+ * Use `create(ConsoleLogFileSchema)` to create a new message.
+ */
+export const ConsoleLogFileSchema: GenMessage<ConsoleLogFile> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 16);
+
+/**
+ * @generated from message daemon.ListConsoleLogsResponse
+ */
+export type ListConsoleLogsResponse = Message<"daemon.ListConsoleLogsResponse"> & {
+  /**
+   * @generated from field: repeated daemon.ConsoleLogFile files = 1;
+   */
+  files: ConsoleLogFile[];
 };
 
 /**
- * Describes the message daemon.ServerStatus.
- * Use `create(ServerStatusSchema)` to create a new message.
+ * Describes the message daemon.ListConsoleLogsResponse.
+ * Use `create(ListConsoleLogsResponseSchema)` to create a new message.
  */
-export const ServerStatusSchema: GenMessage<ServerStatus> = /*@__PURE__*/
-  messageDesc(file_daemon_Server, 0);
+export const ListConsoleLogsResponseSchema: GenMessage<ListConsoleLogsResponse> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 17);
 
 /**
- * @generated from message daemon.PowerActionMessage
+ * @generated from message daemon.SearchConsoleLogsRequest
  */
-export type PowerActionMessage = Message<"daemon.PowerActionMessage"> & {
+export type SearchConsoleLogsRequest = Message<"daemon.SearchConsoleLogsRequest"> & {
   /**
    * @generated from field: string server_id = 1;
    */
   serverId: string;
 
   /**
-   * @generated from field: daemon.PowerAction action = 2;
+   * @generated from field: google.protobuf.Timestamp from = 2;
    */
-  action: PowerAction;
+  from?: Timestamp;
+
+  /**
+   * now if not set
+   *
+   * @generated from field: optional google.protobuf.Timestamp to = 3;
+   */
+  to?: Timestamp;
+
+  /**
+   * substring, or a regular expression if regex is set, empty matches every line
+   *
+   * @generated from field: string query = 4;
+   */
+  query: string;
+
+  /**
+   * @generated from field: bool regex = 5;
+   */
+  regex: boolean;
+
+  /**
+   * maximum number of lines, defaults to 1000 and is capped at 10000
+   *
+   * @generated from field: uint32 limit = 6;
+   */
+  limit: number;
 };
 
 /**
- * Describes the message daemon.PowerActionMessage.
- * Use `create(PowerActionMessageSchema)` to create a new message.
+ * Describes the message daemon.SearchConsoleLogsRequest.
+ * Use `create(SearchConsoleLogsRequestSchema)` to create a new message.
  */
-export const PowerActionMessageSchema: GenMessage<PowerActionMessage> = /*@__PURE__*/
-  messageDesc(file_daemon_Server, 1);
+export const SearchConsoleLogsRequestSchema: GenMessage<SearchConsoleLogsRequest> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 18);
 
 /**
- * @generated from message daemon.ResourceUsageMessage
+ * @generated from message daemon.ConsoleLogLine
  */
-export type ResourceUsageMessage = Message<"daemon.ResourceUsageMessage"> & {
+export type ConsoleLogLine = Message<"daemon.ConsoleLogLine"> & {
   /**
-   * @generated from field: common.ResourceUsage usage = 1;
+   * @generated from field: google.protobuf.Timestamp timestamp = 1;
    */
-  usage?: ResourceUsage;
+  timestamp?: Timestamp;
+
+  /**
+   * @generated from field: string text = 2;
+   */
+  text: string;
+
+  /**
+   * name of the log file the line is in
+   *
+   * @generated from field: string file = 3;
+   */
+  file: string;
 };
 
 /**
- * Describes the message daemon.ResourceUsageMessage.
- * Use `create(ResourceUsageMessageSchema)` to create a new message.
+ * Describes the message daemon.ConsoleLogLine.
+ * Use `create(ConsoleLogLineSchema)` to create a new message.
  */
-export const ResourceUsageMessageSchema: GenMessage<ResourceUsageMessage> = /*@__PURE__*/
-  messageDesc(file_daemon_Server, 2);
+export const ConsoleLogLineSchema: GenMessage<ConsoleLogLine> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 19);
+
+/**
+ * @generated from message daemon.GetConsoleLogRequest
+ */
+export type GetConsoleLogRequest = Message<"daemon.GetConsoleLogRequest"> & {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message daemon.GetConsoleLogRequest.
+ * Use `create(GetConsoleLogRequestSchema)` to create a new message.
+ */
+export const GetConsoleLogRequestSchema: GenMessage<GetConsoleLogRequest> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 20);
+
+/**
+ * @generated from message daemon.PtyInput
+ */
+export type PtyInput = Message<"daemon.PtyInput"> & {
+  /**
+   * @generated from oneof daemon.PtyInput.input
+   */
+  input: {
+    /**
+     * first message only
+     *
+     * @generated from field: daemon.PtyOpen open = 1;
+     */
+    value: PtyOpen;
+    case: "open";
+  } | {
+    /**
+     * raw input as received from xterm.js onData or onBinary
+     *
+     * @generated from field: bytes data = 2;
+     */
+    value: Uint8Array;
+    case: "data";
+  } | {
+    /**
+     * e.g. after xterm.js onResize
+     *
+     * @generated from field: daemon.PtySize resize = 3;
+     */
+    value: PtySize;
+    case: "resize";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message daemon.PtyInput.
+ * Use `create(PtyInputSchema)` to create a new message.
+ */
+export const PtyInputSchema: GenMessage<PtyInput> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 21);
+
+/**
+ * @generated from message daemon.PtyOpen
+ */
+export type PtyOpen = Message<"daemon.PtyOpen"> & {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId: string;
+
+  /**
+   * @generated from field: daemon.PtySize size = 2;
+   */
+  size?: PtySize;
+
+  /**
+   * attaches to a running session of the caller, a new one is started if not set
+   *
+   * @generated from field: optional string session_id = 3;
+   */
+  sessionId?: string;
+};
+
+/**
+ * Describes the message daemon.PtyOpen.
+ * Use `create(PtyOpenSchema)` to create a new message.
+ */
+export const PtyOpenSchema: GenMessage<PtyOpen> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 22);
+
+/**
+ * @generated from message daemon.PtySize
+ */
+export type PtySize = Message<"daemon.PtySize"> & {
+  /**
+   * @generated from field: uint32 cols = 1;
+   */
+  cols: number;
+
+  /**
+   * @generated from field: uint32 rows = 2;
+   */
+  rows: number;
+};
+
+/**
+ * Describes the message daemon.PtySize.
+ * Use `create(PtySizeSchema)` to create a new message.
+ */
+export const PtySizeSchema: GenMessage<PtySize> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 23);
+
+/**
+ * @generated from message daemon.PtyOutput
+ */
+export type PtyOutput = Message<"daemon.PtyOutput"> & {
+  /**
+   * @generated from oneof daemon.PtyOutput.output
+   */
+  output: {
+    /**
+     * raw output, chunks can split characters and escape sequences, so pass them to xterm.js write as they are
+     *
+     * @generated from field: bytes data = 1;
+     */
+    value: Uint8Array;
+    case: "data";
+  } | {
+    /**
+     * always the last message once the shell exited
+     *
+     * @generated from field: daemon.PtyExit exit = 2;
+     */
+    value: PtyExit;
+    case: "exit";
+  } | {
+    /**
+     * always the first message, followed by the recent output of an attached session
+     *
+     * @generated from field: daemon.TerminalSession session = 3;
+     */
+    value: TerminalSession;
+    case: "session";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message daemon.PtyOutput.
+ * Use `create(PtyOutputSchema)` to create a new message.
+ */
+export const PtyOutputSchema: GenMessage<PtyOutput> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 24);
+
+/**
+ * @generated from message daemon.PtyExit
+ */
+export type PtyExit = Message<"daemon.PtyExit"> & {
+  /**
+   * @generated from field: optional int32 exit_code = 1;
+   */
+  exitCode?: number;
+};
+
+/**
+ * Describes the message daemon.PtyExit.
+ * Use `create(PtyExitSchema)` to create a new message.
+ */
+export const PtyExitSchema: GenMessage<PtyExit> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 25);
+
+/**
+ * @generated from message daemon.TerminalSession
+ */
+export type TerminalSession = Message<"daemon.TerminalSession"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string server_id = 2;
+   */
+  serverId: string;
+
+  /**
+   * empty as long as users are not authenticated
+   *
+   * @generated from field: string owner_id = 3;
+   */
+  ownerId: string;
+
+  /**
+   * @generated from field: string shell = 4;
+   */
+  shell: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created = 5;
+   */
+  created?: Timestamp;
+
+  /**
+   * last input or output, the session is closed once it was idle for too long
+   *
+   * @generated from field: google.protobuf.Timestamp last_activity = 6;
+   */
+  lastActivity?: Timestamp;
+
+  /**
+   * streams currently attached
+   *
+   * @generated from field: uint32 attached = 7;
+   */
+  attached: number;
+};
+
+/**
+ * Describes the message daemon.TerminalSession.
+ * Use `create(TerminalSessionSchema)` to create a new message.
+ */
+export const TerminalSessionSchema: GenMessage<TerminalSession> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 26);
+
+/**
+ * @generated from message daemon.ListTerminalSessionsResponse
+ */
+export type ListTerminalSessionsResponse = Message<"daemon.ListTerminalSessionsResponse"> & {
+  /**
+   * @generated from field: repeated daemon.TerminalSession sessions = 1;
+   */
+  sessions: TerminalSession[];
+};
+
+/**
+ * Describes the message daemon.ListTerminalSessionsResponse.
+ * Use `create(ListTerminalSessionsResponseSchema)` to create a new message.
+ */
+export const ListTerminalSessionsResponseSchema: GenMessage<ListTerminalSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_daemon_Server, 27);
 
 /**
  * @generated from enum daemon.ServerStatusType
@@ -158,6 +988,13 @@ export enum ServerOfflineReason {
    * @generated from enum value: SERVER_OFFLINE_REASON_ERROR = 4;
    */
   ERROR = 4,
+
+  /**
+   * did not exit after the stop command, stopped with SIGTERM
+   *
+   * @generated from enum value: SERVER_OFFLINE_REASON_TERMINATED = 5;
+   */
+  TERMINATED = 5,
 }
 
 /**
@@ -204,6 +1041,70 @@ export enum PowerAction {
 export const PowerActionSchema: GenEnum<PowerAction> = /*@__PURE__*/
   enumDesc(file_daemon_Server, 2);
 
+/**
+ * @generated from enum daemon.ResourceHistoryResolution
+ */
+export enum ResourceHistoryResolution {
+  /**
+   * 5 seconds if the range starts within the last hour, otherwise 1 minute
+   *
+   * @generated from enum value: RESOURCE_HISTORY_RESOLUTION_AUTO = 0;
+   */
+  AUTO = 0,
+
+  /**
+   * kept for the last hour
+   *
+   * @generated from enum value: RESOURCE_HISTORY_RESOLUTION_FIVE_SECONDS = 1;
+   */
+  FIVE_SECONDS = 1,
+
+  /**
+   * kept for the last day
+   *
+   * @generated from enum value: RESOURCE_HISTORY_RESOLUTION_ONE_MINUTE = 2;
+   */
+  ONE_MINUTE = 2,
+}
+
+/**
+ * Describes the enum daemon.ResourceHistoryResolution.
+ */
+export const ResourceHistoryResolutionSchema: GenEnum<ResourceHistoryResolution> = /*@__PURE__*/
+  enumDesc(file_daemon_Server, 3);
+
+/**
+ * @generated from enum daemon.InstallMode
+ */
+export enum InstallMode {
+  /**
+   * run the setup script over the existing data and recreate the container
+   *
+   * @generated from enum value: INSTALL_MODE_KEEP_FILES = 0;
+   */
+  KEEP_FILES = 0,
+
+  /**
+   * wipe the data except the preserved paths, then run the setup script and recreate the container
+   *
+   * @generated from enum value: INSTALL_MODE_CLEAN = 1;
+   */
+  CLEAN = 1,
+
+  /**
+   * only recreate the container, e.g. to apply image or limit changes, the data is not touched
+   *
+   * @generated from enum value: INSTALL_MODE_RECREATE_CONTAINER = 2;
+   */
+  RECREATE_CONTAINER = 2,
+}
+
+/**
+ * Describes the enum daemon.InstallMode.
+ */
+export const InstallModeSchema: GenEnum<InstallMode> = /*@__PURE__*/
+  enumDesc(file_daemon_Server, 4);
+
 /**
  * @generated from service daemon.ServerService
  */
@@ -224,6 +1125,30 @@ export const ServerService: GenService<{
     input: typeof IDMessageSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * @generated from rpc daemon.ServerService.ListConsoleLogs
+   */
+  listConsoleLogs: {
+    methodKind: "unary";
+    input: typeof SimpleIDMessageSchema;
+    output: typeof ListConsoleLogsResponseSchema;
+  },
+  /**
+   * @generated from rpc daemon.ServerService.SearchConsoleLogs
+   */
+  searchConsoleLogs: {
+    methodKind: "server_streaming";
+    input: typeof SearchConsoleLogsRequestSchema;
+    output: typeof ConsoleLogLineSchema;
+  },
+  /**
+   * @generated from rpc daemon.ServerService.GetConsoleLog
+   */
+  getConsoleLog: {
+    methodKind: "server_streaming";
+    input: typeof GetConsoleLogRequestSchema;
+    output: typeof SimpleMessageSchema;
+  },
   /**
    * @generated from rpc daemon.ServerService.Terminal
    */
@@ -240,6 +1165,22 @@ export const ServerService: GenService<{
     input: typeof IDMessageSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * @generated from rpc daemon.ServerService.PtyTerminal
+   */
+  ptyTerminal: {
+    methodKind: "bidi_streaming";
+    input: typeof PtyInputSchema;
+    output: typeof PtyOutputSchema;
+  },
+  /**
+   * @generated from rpc daemon.ServerService.ListTerminalSessions
+   */
+  listTerminalSessions: {
+    methodKind: "unary";
+    input: typeof SimpleIDMessageSchema;
+    output: typeof ListTerminalSessionsResponseSchema;
+  },
   /**
    * @generated from rpc daemon.ServerService.Status
    */
@@ -248,6 +1189,14 @@ export const ServerService: GenService<{
     input: typeof SimpleIDMessageSchema;
     output: typeof ServerStatusSchema;
   },
+  /**
+   * @generated from rpc daemon.ServerService.WatchStatus
+   */
+  watchStatus: {
+    methodKind: "server_streaming";
+    input: typeof SimpleIDMessageSchema;
+    output: typeof ServerStatusEventSchema;
+  },
   /**
    * @generated from rpc daemon.ServerService.ResourceUsage
    */
@@ -256,6 +1205,14 @@ export const ServerService: GenService<{
     input: typeof SimpleIDMessageSchema;
     output: typeof ResourceUsageMessageSchema;
   },
+  /**
+   * @generated from rpc daemon.ServerService.GetResourceHistory
+   */
+  getResourceHistory: {
+    methodKind: "unary";
+    input: typeof GetResourceHistoryRequestSchema;
+    output: typeof ResourceHistorySchema;
+  },
   /**
    * @generated from rpc daemon.ServerService.PowerAction
    */
@@ -268,10 +1225,58 @@ export const ServerService: GenService<{
    * @generated from rpc daemon.ServerService.Install
    */
   install: {
+    methodKind: "unary";
+    input: typeof InstallRequestSchema;
+    output: typeof SuccessMessageSchema;
+  },
+  /**
+   * @generated from rpc daemon.ServerService.InstallProgress
+   */
+  installProgress: {
+    methodKind: "server_streaming";
+    input: typeof SimpleIDMessageSchema;
+    output: typeof InstallProgressEventSchema;
+  },
+  /**
+   * @generated from rpc daemon.ServerService.ListInstallRuns
+   */
+  listInstallRuns: {
+    methodKind: "unary";
+    input: typeof SimpleIDMessageSchema;
+    output: typeof ListInstallRunsResponseSchema;
+  },
+  /**
+   * @generated from rpc daemon.ServerService.GetInstallLog
+   */
+  getInstallLog: {
+    methodKind: "server_streaming";
+    input: typeof GetInstallLogRequestSchema;
+    output: typeof SimpleMessageSchema;
+  },
+  /**
+   * @generated from rpc daemon.ServerService.GetEulaStatus
+   */
+  getEulaStatus: {
+    methodKind: "unary";
+    input: typeof SimpleIDMessageSchema;
+    output: typeof EulaStatusSchema;
+  },
+  /**
+   * @generated from rpc daemon.ServerService.AcceptEula
+   */
+  acceptEula: {
     methodKind: "unary";
     input: typeof SimpleIDMessageSchema;
     output: typeof SuccessMessageSchema;
   },
+  /**
+   * @generated from rpc daemon.ServerService.GetStorageUsage
+   */
+  getStorageUsage: {
+    methodKind: "unary";
+    input: typeof SimpleIDMessageSchema;
+    output: typeof StorageUsageSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_daemon_Server, 0);
 